@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+	"io"
 	"log"
 	"os"
 
@@ -9,6 +11,8 @@ import (
 	database "github.com/okzmo/kyob/db"
 	"github.com/okzmo/kyob/internal/api/actors"
 	"github.com/okzmo/kyob/internal/api/router"
+	"github.com/okzmo/kyob/internal/mailer"
+	services "github.com/okzmo/kyob/internal/service"
 	"github.com/okzmo/kyob/internal/utils"
 )
 
@@ -18,10 +22,27 @@ func main() {
 		log.Fatal("Error loading .env file")
 	}
 
+	// sign a webhook payload read from stdin, to replay livekit events locally
+	if len(os.Args) > 1 && os.Args[1] == "sign_webhook" {
+		body, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		token, err := services.SignLivekitWebhook(body)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Println(token)
+		return
+	}
+
 	db := database.Setup()
 	defer db.Close()
 
 	utils.SetupSnowflake()
+	mailer.Setup()
 
 	if len(os.Args) > 1 && os.Args[1] == "seed" {
 		database.RunSeeder()
@@ -31,6 +52,8 @@ func main() {
 	vips.Startup(nil)
 	defer vips.Shutdown()
 
+	go services.PurgeExpiredTokens()
+
 	actors.SetupServersEngine()
 	actors.SetupUsersEngine()
 	router.Setup()
@@ -0,0 +1,103 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: audit_logs.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditLog = `-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (
+  id, server_id, actor_id, action, target_type, target_id, changes, reason
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+)
+`
+
+type CreateAuditLogParams struct {
+	ID         string          `json:"id"`
+	ServerID   string          `json:"server_id"`
+	ActorID    string          `json:"actor_id"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	Changes    json.RawMessage `json:"changes"`
+	Reason     string          `json:"reason"`
+}
+
+func (q *Queries) CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error {
+	_, err := q.db.Exec(ctx, createAuditLog,
+		arg.ID,
+		arg.ServerID,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Changes,
+		arg.Reason,
+	)
+	return err
+}
+
+const getAuditLogs = `-- name: GetAuditLogs :many
+SELECT id, server_id, actor_id, action, target_type, target_id, changes, reason, created_at FROM audit_logs
+WHERE server_id = $1
+  AND ($2::text IS NULL OR action = $2)
+  AND ($3::text IS NULL OR actor_id = $3)
+  AND ($4::text IS NULL OR target_id = $4)
+  AND ($5::text IS NULL OR id < $5)
+ORDER BY id DESC
+LIMIT $6
+`
+
+type GetAuditLogsParams struct {
+	ServerID string      `json:"server_id"`
+	Action   pgtype.Text `json:"action"`
+	ActorID  pgtype.Text `json:"actor_id"`
+	TargetID pgtype.Text `json:"target_id"`
+	Before   pgtype.Text `json:"before"`
+	Limit    int32       `json:"limit"`
+}
+
+func (q *Queries) GetAuditLogs(ctx context.Context, arg GetAuditLogsParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, getAuditLogs,
+		arg.ServerID,
+		arg.Action,
+		arg.ActorID,
+		arg.TargetID,
+		arg.Before,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.ActorID,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Changes,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
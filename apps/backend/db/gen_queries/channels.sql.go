@@ -8,16 +8,17 @@ package db
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createChannel = `-- name: CreateChannel :one
 INSERT INTO channels (
-  id, server_id, name, type, description, users, roles, x, y
+  id, server_id, name, type, description, users, roles, x, y, user_limit
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 )
-RETURNING id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at
+RETURNING id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at, user_limit
 `
 
 type CreateChannelParams struct {
@@ -30,6 +31,7 @@ type CreateChannelParams struct {
 	Roles       []string    `json:"roles"`
 	X           int32       `json:"x"`
 	Y           int32       `json:"y"`
+	UserLimit   pgtype.Int4 `json:"user_limit"`
 }
 
 func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (Channel, error) {
@@ -43,6 +45,7 @@ func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (C
 		arg.Roles,
 		arg.X,
 		arg.Y,
+		arg.UserLimit,
 	)
 	var i Channel
 	err := row.Scan(
@@ -58,6 +61,7 @@ func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (C
 		&i.Active,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.UserLimit,
 	)
 	return i, err
 }
@@ -68,7 +72,7 @@ WHERE type = 'dm'
   AND array_length(users, 1) = 2
   AND $1::varchar = ANY(users) 
   AND $2::varchar = ANY(users)
-RETURNING id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at
+RETURNING id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at, user_limit
 `
 
 type DeactivateChannelParams struct {
@@ -92,21 +96,40 @@ func (q *Queries) DeactivateChannel(ctx context.Context, arg DeactivateChannelPa
 		&i.Active,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.UserLimit,
 	)
 	return i, err
 }
 
 const deleteChannel = `-- name: DeleteChannel :exec
-DELETE FROM channels WHERE id = $1
+DELETE FROM channels WHERE id = $1 AND server_id = $2
 `
 
-func (q *Queries) DeleteChannel(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, deleteChannel, id)
+type DeleteChannelParams struct {
+	ID       string `json:"id"`
+	ServerID string `json:"server_id"`
+}
+
+func (q *Queries) DeleteChannel(ctx context.Context, arg DeleteChannelParams) error {
+	_, err := q.db.Exec(ctx, deleteChannel, arg.ID, arg.ServerID)
 	return err
 }
 
+const deleteChannelOverwrite = `-- name: DeleteChannelOverwrite :execresult
+DELETE FROM channel_overwrites WHERE channel_id = $1 AND target_id = $2
+`
+
+type DeleteChannelOverwriteParams struct {
+	ChannelID string `json:"channel_id"`
+	TargetID  string `json:"target_id"`
+}
+
+func (q *Queries) DeleteChannelOverwrite(ctx context.Context, arg DeleteChannelOverwriteParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, deleteChannelOverwrite, arg.ChannelID, arg.TargetID)
+}
+
 const getChannel = `-- name: GetChannel :one
-SELECT id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at FROM channels WHERE id = $1
+SELECT id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at, user_limit FROM channels WHERE id = $1
 `
 
 func (q *Queries) GetChannel(ctx context.Context, id string) (Channel, error) {
@@ -125,12 +148,46 @@ func (q *Queries) GetChannel(ctx context.Context, id string) (Channel, error) {
 		&i.Active,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.UserLimit,
 	)
 	return i, err
 }
 
+const getChannelOverwrites = `-- name: GetChannelOverwrites :many
+SELECT id, channel_id, target_id, type, allow, deny, created_at, updated_at FROM channel_overwrites WHERE channel_id = $1
+`
+
+func (q *Queries) GetChannelOverwrites(ctx context.Context, channelID string) ([]ChannelOverwrite, error) {
+	rows, err := q.db.Query(ctx, getChannelOverwrites, channelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChannelOverwrite
+	for rows.Next() {
+		var i ChannelOverwrite
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.TargetID,
+			&i.Type,
+			&i.Allow,
+			&i.Deny,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getChannelsFromServer = `-- name: GetChannelsFromServer :many
-SELECT id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at
+SELECT id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at, user_limit
 FROM channels
 WHERE server_id = $1 AND active = true
 `
@@ -157,6 +214,7 @@ func (q *Queries) GetChannelsFromServer(ctx context.Context, serverID string) ([
 			&i.Active,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.UserLimit,
 		); err != nil {
 			return nil, err
 		}
@@ -169,7 +227,7 @@ func (q *Queries) GetChannelsFromServer(ctx context.Context, serverID string) ([
 }
 
 const getChannelsFromServers = `-- name: GetChannelsFromServers :many
-SELECT id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at
+SELECT id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at, user_limit
 FROM channels
 WHERE server_id = ANY($1::text[]) AND active = true
 `
@@ -196,6 +254,40 @@ func (q *Queries) GetChannelsFromServers(ctx context.Context, dollar_1 []string)
 			&i.Active,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.UserLimit,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChannelsOverwrites = `-- name: GetChannelsOverwrites :many
+SELECT id, channel_id, target_id, type, allow, deny, created_at, updated_at FROM channel_overwrites WHERE channel_id = ANY($1::text[])
+`
+
+func (q *Queries) GetChannelsOverwrites(ctx context.Context, dollar_1 []string) ([]ChannelOverwrite, error) {
+	rows, err := q.db.Query(ctx, getChannelsOverwrites, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChannelOverwrite
+	for rows.Next() {
+		var i ChannelOverwrite
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.TargetID,
+			&i.Type,
+			&i.Allow,
+			&i.Deny,
+			&i.CreatedAt,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -208,7 +300,7 @@ func (q *Queries) GetChannelsFromServers(ctx context.Context, dollar_1 []string)
 }
 
 const getFriendChannels = `-- name: GetFriendChannels :many
-SELECT id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at
+SELECT id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at, user_limit
 FROM channels
 WHERE server_id = 'global' AND $1::text = ANY(users) AND active = true
 `
@@ -235,6 +327,7 @@ func (q *Queries) GetFriendChannels(ctx context.Context, dollar_1 string) ([]Cha
 			&i.Active,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.UserLimit,
 		); err != nil {
 			return nil, err
 		}
@@ -247,29 +340,89 @@ func (q *Queries) GetFriendChannels(ctx context.Context, dollar_1 string) ([]Cha
 }
 
 const updateChannelDescription = `-- name: UpdateChannelDescription :exec
-UPDATE channels SET description = $1 WHERE id = $2
+UPDATE channels SET description = $1 WHERE id = $2 AND server_id = $3
 `
 
 type UpdateChannelDescriptionParams struct {
 	Description pgtype.Text `json:"description"`
 	ID          string      `json:"id"`
+	ServerID    string      `json:"server_id"`
 }
 
 func (q *Queries) UpdateChannelDescription(ctx context.Context, arg UpdateChannelDescriptionParams) error {
-	_, err := q.db.Exec(ctx, updateChannelDescription, arg.Description, arg.ID)
+	_, err := q.db.Exec(ctx, updateChannelDescription, arg.Description, arg.ID, arg.ServerID)
 	return err
 }
 
 const updateChannelName = `-- name: UpdateChannelName :exec
-UPDATE channels SET name = $1 WHERE id = $2
+UPDATE channels SET name = $1 WHERE id = $2 AND server_id = $3
 `
 
 type UpdateChannelNameParams struct {
-	Name string `json:"name"`
-	ID   string `json:"id"`
+	Name     string `json:"name"`
+	ID       string `json:"id"`
+	ServerID string `json:"server_id"`
 }
 
 func (q *Queries) UpdateChannelName(ctx context.Context, arg UpdateChannelNameParams) error {
-	_, err := q.db.Exec(ctx, updateChannelName, arg.Name, arg.ID)
+	_, err := q.db.Exec(ctx, updateChannelName, arg.Name, arg.ID, arg.ServerID)
+	return err
+}
+
+const updateChannelUserLimit = `-- name: UpdateChannelUserLimit :exec
+UPDATE channels SET user_limit = $1 WHERE id = $2 AND server_id = $3
+`
+
+type UpdateChannelUserLimitParams struct {
+	UserLimit pgtype.Int4 `json:"user_limit"`
+	ID        string      `json:"id"`
+	ServerID  string      `json:"server_id"`
+}
+
+func (q *Queries) UpdateChannelUserLimit(ctx context.Context, arg UpdateChannelUserLimitParams) error {
+	_, err := q.db.Exec(ctx, updateChannelUserLimit, arg.UserLimit, arg.ID, arg.ServerID)
 	return err
 }
+
+const upsertChannelOverwrite = `-- name: UpsertChannelOverwrite :one
+INSERT INTO channel_overwrites (
+  id, channel_id, target_id, type, allow, deny
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (channel_id, target_id) DO UPDATE
+SET type = EXCLUDED.type, allow = EXCLUDED.allow, deny = EXCLUDED.deny, updated_at = NOW()
+RETURNING id, channel_id, target_id, type, allow, deny, created_at, updated_at
+`
+
+type UpsertChannelOverwriteParams struct {
+	ID        string        `json:"id"`
+	ChannelID string        `json:"channel_id"`
+	TargetID  string        `json:"target_id"`
+	Type      OverwriteType `json:"type"`
+	Allow     []string      `json:"allow"`
+	Deny      []string      `json:"deny"`
+}
+
+func (q *Queries) UpsertChannelOverwrite(ctx context.Context, arg UpsertChannelOverwriteParams) (ChannelOverwrite, error) {
+	row := q.db.QueryRow(ctx, upsertChannelOverwrite,
+		arg.ID,
+		arg.ChannelID,
+		arg.TargetID,
+		arg.Type,
+		arg.Allow,
+		arg.Deny,
+	)
+	var i ChannelOverwrite
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.TargetID,
+		&i.Type,
+		&i.Allow,
+		&i.Deny,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -43,3 +43,75 @@ func (r iteratorForCreateEmoji) Err() error {
 func (q *Queries) CreateEmoji(ctx context.Context, arg []CreateEmojiParams) (int64, error) {
 	return q.db.CopyFrom(ctx, []string{"emojis"}, []string{"id", "user_id", "url", "shortcode"}, &iteratorForCreateEmoji{rows: arg})
 }
+
+// iteratorForCreateNotifications implements pgx.CopyFromSource.
+type iteratorForCreateNotifications struct {
+	rows                 []CreateNotificationsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateNotifications) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateNotifications) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].UserID,
+		r.rows[0].MessageID,
+		r.rows[0].ServerID,
+		r.rows[0].ChannelID,
+		r.rows[0].AuthorID,
+		r.rows[0].Type,
+	}, nil
+}
+
+func (r iteratorForCreateNotifications) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateNotifications(ctx context.Context, arg []CreateNotificationsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"notifications"}, []string{"id", "user_id", "message_id", "server_id", "channel_id", "author_id", "type"}, &iteratorForCreateNotifications{rows: arg})
+}
+
+// iteratorForCreateRecoveryCodes implements pgx.CopyFromSource.
+type iteratorForCreateRecoveryCodes struct {
+	rows                 []CreateRecoveryCodesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateRecoveryCodes) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateRecoveryCodes) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].UserID,
+		r.rows[0].CodeHash,
+	}, nil
+}
+
+func (r iteratorForCreateRecoveryCodes) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateRecoveryCodes(ctx context.Context, arg []CreateRecoveryCodesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"recovery_codes"}, []string{"id", "user_id", "code_hash"}, &iteratorForCreateRecoveryCodes{rows: arg})
+}
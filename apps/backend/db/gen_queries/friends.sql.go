@@ -69,7 +69,7 @@ WHERE type = 'dm'
   AND array_length(users, 1) = 2
   AND $1::varchar = ANY(users) 
   AND $2::varchar = ANY(users)
-RETURNING id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at
+RETURNING id, server_id, name, type, description, users, roles, x, y, active, created_at, updated_at, user_limit
 `
 
 type GetExistingChannelParams struct {
@@ -93,6 +93,7 @@ func (q *Queries) GetExistingChannel(ctx context.Context, arg GetExistingChannel
 		&i.Active,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.UserLimit,
 	)
 	return i, err
 }
@@ -7,13 +7,22 @@ package db
 
 import (
 	"context"
-	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const checkInvite = `-- name: CheckInvite :one
-SELECT server_id FROM invites WHERE invite_id = $1 AND expire_at >= NOW()
+
+SELECT server_id FROM invites
+WHERE (invite_id = $1 OR (vanity AND invite_id = lower($1)))
+  AND revoked_at IS NULL
+  AND (expire_at IS NULL OR expire_at >= NOW())
+  AND (max_uses = 0 OR uses < max_uses)
 `
 
+// Vanity codes are stored lowercased and match whatever the case of the
+// link, generated codes are case-sensitive.
 func (q *Queries) CheckInvite(ctx context.Context, inviteID string) (string, error) {
 	row := q.db.QueryRow(ctx, checkInvite, inviteID)
 	var server_id string
@@ -23,28 +32,219 @@ func (q *Queries) CheckInvite(ctx context.Context, inviteID string) (string, err
 
 const createInvite = `-- name: CreateInvite :one
 INSERT INTO invites (
-  id, server_id, invite_id, expire_at
+  id, server_id, invite_id, expire_at, creator_id, max_uses
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5, $6
 )
-RETURNING invite_id
+RETURNING id, server_id, invite_id, expire_at, creator_id, max_uses, uses, vanity, revoked_at, created_at
 `
 
 type CreateInviteParams struct {
-	ID       string    `json:"id"`
-	ServerID string    `json:"server_id"`
-	InviteID string    `json:"invite_id"`
-	ExpireAt time.Time `json:"expire_at"`
+	ID        string             `json:"id"`
+	ServerID  string             `json:"server_id"`
+	InviteID  string             `json:"invite_id"`
+	ExpireAt  pgtype.Timestamptz `json:"expire_at"`
+	CreatorID pgtype.Text        `json:"creator_id"`
+	MaxUses   int32              `json:"max_uses"`
 }
 
-func (q *Queries) CreateInvite(ctx context.Context, arg CreateInviteParams) (string, error) {
+func (q *Queries) CreateInvite(ctx context.Context, arg CreateInviteParams) (Invite, error) {
 	row := q.db.QueryRow(ctx, createInvite,
 		arg.ID,
 		arg.ServerID,
 		arg.InviteID,
 		arg.ExpireAt,
+		arg.CreatorID,
+		arg.MaxUses,
+	)
+	var i Invite
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.InviteID,
+		&i.ExpireAt,
+		&i.CreatorID,
+		&i.MaxUses,
+		&i.Uses,
+		&i.Vanity,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createVanityInvite = `-- name: CreateVanityInvite :one
+INSERT INTO invites (
+  id, server_id, invite_id, creator_id, vanity
+) VALUES (
+  $1, $2, $3, $4, true
+)
+RETURNING id, server_id, invite_id, expire_at, creator_id, max_uses, uses, vanity, revoked_at, created_at
+`
+
+type CreateVanityInviteParams struct {
+	ID        string      `json:"id"`
+	ServerID  string      `json:"server_id"`
+	InviteID  string      `json:"invite_id"`
+	CreatorID pgtype.Text `json:"creator_id"`
+}
+
+func (q *Queries) CreateVanityInvite(ctx context.Context, arg CreateVanityInviteParams) (Invite, error) {
+	row := q.db.QueryRow(ctx, createVanityInvite,
+		arg.ID,
+		arg.ServerID,
+		arg.InviteID,
+		arg.CreatorID,
+	)
+	var i Invite
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.InviteID,
+		&i.ExpireAt,
+		&i.CreatorID,
+		&i.MaxUses,
+		&i.Uses,
+		&i.Vanity,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteVanityInvite = `-- name: DeleteVanityInvite :execresult
+DELETE FROM invites WHERE server_id = $1 AND vanity
+`
+
+func (q *Queries) DeleteVanityInvite(ctx context.Context, serverID string) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, deleteVanityInvite, serverID)
+}
+
+const getActiveInvites = `-- name: GetActiveInvites :many
+SELECT id, server_id, invite_id, expire_at, creator_id, max_uses, uses, vanity, revoked_at, created_at FROM invites
+WHERE server_id = $1
+  AND revoked_at IS NULL
+  AND (expire_at IS NULL OR expire_at >= NOW())
+  AND (max_uses = 0 OR uses < max_uses)
+ORDER BY vanity DESC, created_at DESC
+`
+
+func (q *Queries) GetActiveInvites(ctx context.Context, serverID string) ([]Invite, error) {
+	rows, err := q.db.Query(ctx, getActiveInvites, serverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Invite
+	for rows.Next() {
+		var i Invite
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.InviteID,
+			&i.ExpireAt,
+			&i.CreatorID,
+			&i.MaxUses,
+			&i.Uses,
+			&i.Vanity,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInvite = `-- name: GetInvite :one
+SELECT id, server_id, invite_id, expire_at, creator_id, max_uses, uses, vanity, revoked_at, created_at FROM invites WHERE invite_id = $1
+`
+
+func (q *Queries) GetInvite(ctx context.Context, inviteID string) (Invite, error) {
+	row := q.db.QueryRow(ctx, getInvite, inviteID)
+	var i Invite
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.InviteID,
+		&i.ExpireAt,
+		&i.CreatorID,
+		&i.MaxUses,
+		&i.Uses,
+		&i.Vanity,
+		&i.RevokedAt,
+		&i.CreatedAt,
 	)
-	var invite_id string
-	err := row.Scan(&invite_id)
-	return invite_id, err
+	return i, err
+}
+
+const getVanityInvite = `-- name: GetVanityInvite :one
+SELECT id, server_id, invite_id, expire_at, creator_id, max_uses, uses, vanity, revoked_at, created_at FROM invites WHERE server_id = $1 AND vanity
+`
+
+func (q *Queries) GetVanityInvite(ctx context.Context, serverID string) (Invite, error) {
+	row := q.db.QueryRow(ctx, getVanityInvite, serverID)
+	var i Invite
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.InviteID,
+		&i.ExpireAt,
+		&i.CreatorID,
+		&i.MaxUses,
+		&i.Uses,
+		&i.Vanity,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const isInviteCodeTaken = `-- name: IsInviteCodeTaken :one
+SELECT EXISTS(
+  SELECT 1 FROM invites
+  WHERE lower(invite_id) = lower($1) AND NOT (server_id = $2 AND vanity)
+)
+`
+
+type IsInviteCodeTakenParams struct {
+	Code     string `json:"code"`
+	ServerID string `json:"server_id"`
+}
+
+func (q *Queries) IsInviteCodeTaken(ctx context.Context, arg IsInviteCodeTakenParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isInviteCodeTaken, arg.Code, arg.ServerID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const revokeInvite = `-- name: RevokeInvite :execresult
+UPDATE invites SET revoked_at = NOW()
+WHERE invite_id = $1 AND server_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeInviteParams struct {
+	InviteID string `json:"invite_id"`
+	ServerID string `json:"server_id"`
+}
+
+func (q *Queries) RevokeInvite(ctx context.Context, arg RevokeInviteParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, revokeInvite, arg.InviteID, arg.ServerID)
+}
+
+const useInvite = `-- name: UseInvite :execresult
+UPDATE invites SET uses = uses + 1
+WHERE (invite_id = $1 OR (vanity AND invite_id = lower($1)))
+  AND revoked_at IS NULL
+  AND (expire_at IS NULL OR expire_at >= NOW())
+  AND (max_uses = 0 OR uses < max_uses)
+`
+
+func (q *Queries) UseInvite(ctx context.Context, inviteID string) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, useInvite, inviteID)
 }
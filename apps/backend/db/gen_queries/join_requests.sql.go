@@ -0,0 +1,135 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: join_requests.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createJoinRequest = `-- name: CreateJoinRequest :one
+INSERT INTO join_requests (
+  id, server_id, user_id, invite_id, x, y
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (server_id, user_id)
+DO UPDATE SET
+    invite_id = EXCLUDED.invite_id,
+    x = EXCLUDED.x,
+    y = EXCLUDED.y
+RETURNING id, server_id, user_id, invite_id, x, y, created_at
+`
+
+type CreateJoinRequestParams struct {
+	ID       string `json:"id"`
+	ServerID string `json:"server_id"`
+	UserID   string `json:"user_id"`
+	InviteID string `json:"invite_id"`
+	X        int32  `json:"x"`
+	Y        int32  `json:"y"`
+}
+
+func (q *Queries) CreateJoinRequest(ctx context.Context, arg CreateJoinRequestParams) (JoinRequest, error) {
+	row := q.db.QueryRow(ctx, createJoinRequest,
+		arg.ID,
+		arg.ServerID,
+		arg.UserID,
+		arg.InviteID,
+		arg.X,
+		arg.Y,
+	)
+	var i JoinRequest
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.UserID,
+		&i.InviteID,
+		&i.X,
+		&i.Y,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteJoinRequest = `-- name: DeleteJoinRequest :execresult
+DELETE FROM join_requests WHERE id = $1 AND server_id = $2
+`
+
+type DeleteJoinRequestParams struct {
+	ID       string `json:"id"`
+	ServerID string `json:"server_id"`
+}
+
+func (q *Queries) DeleteJoinRequest(ctx context.Context, arg DeleteJoinRequestParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, deleteJoinRequest, arg.ID, arg.ServerID)
+}
+
+const getJoinRequest = `-- name: GetJoinRequest :one
+SELECT id, server_id, user_id, invite_id, x, y, created_at FROM join_requests WHERE id = $1
+`
+
+func (q *Queries) GetJoinRequest(ctx context.Context, id string) (JoinRequest, error) {
+	row := q.db.QueryRow(ctx, getJoinRequest, id)
+	var i JoinRequest
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.UserID,
+		&i.InviteID,
+		&i.X,
+		&i.Y,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getJoinRequests = `-- name: GetJoinRequests :many
+SELECT jr.id, jr.user_id, jr.created_at, u.username, u.display_name, u.avatar
+FROM join_requests jr
+JOIN users u ON u.id = jr.user_id
+WHERE jr.server_id = $1
+ORDER BY jr.created_at
+`
+
+type GetJoinRequestsRow struct {
+	ID          string      `json:"id"`
+	UserID      string      `json:"user_id"`
+	CreatedAt   time.Time   `json:"created_at"`
+	Username    string      `json:"username"`
+	DisplayName string      `json:"display_name"`
+	Avatar      pgtype.Text `json:"avatar"`
+}
+
+func (q *Queries) GetJoinRequests(ctx context.Context, serverID string) ([]GetJoinRequestsRow, error) {
+	rows, err := q.db.Query(ctx, getJoinRequests, serverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetJoinRequestsRow
+	for rows.Next() {
+		var i GetJoinRequestsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.Username,
+			&i.DisplayName,
+			&i.Avatar,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -13,26 +13,34 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const checkChannelMembership = `-- name: CheckChannelMembership :execresult
-SELECT c.id FROM channels c, server_membership sm WHERE c.id = $1 and c.server_id = sm.server_id and sm.user_id = $2
+const ackChannel = `-- name: AckChannel :execresult
+INSERT INTO user_channel_read_state (user_id, channel_id, last_read_message_id, unread_mention_ids, updated_at)
+VALUES ($1, $2, $3, '[]', NOW())
+ON CONFLICT (user_id, channel_id) DO UPDATE SET
+    last_read_message_id = EXCLUDED.last_read_message_id,
+    unread_mention_ids = '[]',
+    updated_at = NOW()
+WHERE user_channel_read_state.last_read_message_id IS NULL
+  OR user_channel_read_state.last_read_message_id < EXCLUDED.last_read_message_id
 `
 
-type CheckChannelMembershipParams struct {
-	ID     string `json:"id"`
-	UserID string `json:"user_id"`
+type AckChannelParams struct {
+	UserID    string      `json:"user_id"`
+	ChannelID string      `json:"channel_id"`
+	MessageID pgtype.Text `json:"message_id"`
 }
 
-func (q *Queries) CheckChannelMembership(ctx context.Context, arg CheckChannelMembershipParams) (pgconn.CommandTag, error) {
-	return q.db.Exec(ctx, checkChannelMembership, arg.ID, arg.UserID)
+func (q *Queries) AckChannel(ctx context.Context, arg AckChannelParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, ackChannel, arg.UserID, arg.ChannelID, arg.MessageID)
 }
 
 const createMessage = `-- name: CreateMessage :one
 INSERT INTO messages (
-  id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments
+  id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, reply_to, thread_id, mentions_roles
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 )
-RETURNING id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at
+RETURNING id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at, reply_to, thread_id, plain_text, mentions_roles
 `
 
 type CreateMessageParams struct {
@@ -45,6 +53,9 @@ type CreateMessageParams struct {
 	MentionsUsers    []string        `json:"mentions_users"`
 	MentionsChannels []string        `json:"mentions_channels"`
 	Attachments      []byte          `json:"attachments"`
+	ReplyTo          pgtype.Text     `json:"reply_to"`
+	ThreadID         pgtype.Text     `json:"thread_id"`
+	MentionsRoles    []string        `json:"mentions_roles"`
 }
 
 func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (Message, error) {
@@ -58,6 +69,9 @@ func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (M
 		arg.MentionsUsers,
 		arg.MentionsChannels,
 		arg.Attachments,
+		arg.ReplyTo,
+		arg.ThreadID,
+		arg.MentionsRoles,
 	)
 	var i Message
 	err := row.Scan(
@@ -72,21 +86,90 @@ func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (M
 		&i.Attachments,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReplyTo,
+		&i.ThreadID,
+		&i.PlainText,
+		&i.MentionsRoles,
 	)
 	return i, err
 }
 
 const deleteMessage = `-- name: DeleteMessage :execresult
-DELETE FROM messages WHERE id = $1 AND author_id = $2
+DELETE FROM messages WHERE id = $1 AND channel_id = $2
 `
 
 type DeleteMessageParams struct {
-	ID       string `json:"id"`
-	AuthorID string `json:"author_id"`
+	ID        string `json:"id"`
+	ChannelID string `json:"channel_id"`
 }
 
 func (q *Queries) DeleteMessage(ctx context.Context, arg DeleteMessageParams) (pgconn.CommandTag, error) {
-	return q.db.Exec(ctx, deleteMessage, arg.ID, arg.AuthorID)
+	return q.db.Exec(ctx, deleteMessage, arg.ID, arg.ChannelID)
+}
+
+const getChannelReadState = `-- name: GetChannelReadState :one
+SELECT last_read_message_id FROM user_channel_read_state WHERE user_id = $1 AND channel_id = $2
+`
+
+type GetChannelReadStateParams struct {
+	UserID    string `json:"user_id"`
+	ChannelID string `json:"channel_id"`
+}
+
+func (q *Queries) GetChannelReadState(ctx context.Context, arg GetChannelReadStateParams) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getChannelReadState, arg.UserID, arg.ChannelID)
+	var last_read_message_id pgtype.Text
+	err := row.Scan(&last_read_message_id)
+	return last_read_message_id, err
+}
+
+const getLatestMessagesFromChannel = `-- name: GetLatestMessagesFromChannel :many
+SELECT id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at, reply_to, thread_id, plain_text, mentions_roles FROM messages
+WHERE channel_id = $1 AND thread_id IS NOT DISTINCT FROM $3
+ORDER BY id DESC
+LIMIT $2
+`
+
+type GetLatestMessagesFromChannelParams struct {
+	ChannelID string      `json:"channel_id"`
+	Limit     int32       `json:"limit"`
+	ThreadID  pgtype.Text `json:"thread_id"`
+}
+
+func (q *Queries) GetLatestMessagesFromChannel(ctx context.Context, arg GetLatestMessagesFromChannelParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getLatestMessagesFromChannel, arg.ChannelID, arg.Limit, arg.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.ServerID,
+			&i.ChannelID,
+			&i.Content,
+			&i.Everyone,
+			&i.MentionsUsers,
+			&i.MentionsChannels,
+			&i.Attachments,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReplyTo,
+			&i.ThreadID,
+			&i.PlainText,
+			&i.MentionsRoles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getLatestMessagesRead = `-- name: GetLatestMessagesRead :many
@@ -120,7 +203,9 @@ func (q *Queries) GetLatestMessagesRead(ctx context.Context, userID string) ([]G
 }
 
 const getLatestMessagesSent = `-- name: GetLatestMessagesSent :many
-SELECT m.id, m.channel_id FROM messages m WHERE channel_id = ANY($1::text[]) ORDER BY created_at DESC LIMIT 1
+SELECT DISTINCT ON (m.channel_id) m.id, m.channel_id FROM messages m
+WHERE m.channel_id = ANY($1::text[]) AND m.thread_id IS NULL
+ORDER BY m.channel_id, m.id DESC
 `
 
 type GetLatestMessagesSentRow struct {
@@ -149,7 +234,7 @@ func (q *Queries) GetLatestMessagesSent(ctx context.Context, dollar_1 []string)
 }
 
 const getMessage = `-- name: GetMessage :one
-SELECT id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at FROM messages WHERE id = $1
+SELECT id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at, reply_to, thread_id, plain_text, mentions_roles FROM messages WHERE id = $1
 `
 
 func (q *Queries) GetMessage(ctx context.Context, id string) (Message, error) {
@@ -167,16 +252,90 @@ func (q *Queries) GetMessage(ctx context.Context, id string) (Message, error) {
 		&i.Attachments,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReplyTo,
+		&i.ThreadID,
+		&i.PlainText,
+		&i.MentionsRoles,
 	)
 	return i, err
 }
 
-const getMessagesFromChannel = `-- name: GetMessagesFromChannel :many
-SELECT id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at FROM messages WHERE channel_id = $1
+const getMessagesAfter = `-- name: GetMessagesAfter :many
+SELECT id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at, reply_to, thread_id, plain_text, mentions_roles FROM messages
+WHERE channel_id = $1 AND id > $2 AND thread_id IS NOT DISTINCT FROM $4
+ORDER BY id ASC
+LIMIT $3
+`
+
+type GetMessagesAfterParams struct {
+	ChannelID string      `json:"channel_id"`
+	ID        string      `json:"id"`
+	Limit     int32       `json:"limit"`
+	ThreadID  pgtype.Text `json:"thread_id"`
+}
+
+func (q *Queries) GetMessagesAfter(ctx context.Context, arg GetMessagesAfterParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getMessagesAfter,
+		arg.ChannelID,
+		arg.ID,
+		arg.Limit,
+		arg.ThreadID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.ServerID,
+			&i.ChannelID,
+			&i.Content,
+			&i.Everyone,
+			&i.MentionsUsers,
+			&i.MentionsChannels,
+			&i.Attachments,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReplyTo,
+			&i.ThreadID,
+			&i.PlainText,
+			&i.MentionsRoles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesAround = `-- name: GetMessagesAround :many
+SELECT id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at, reply_to, thread_id, plain_text, mentions_roles FROM messages
+WHERE channel_id = $1 AND id >= $2 AND thread_id IS NOT DISTINCT FROM $4
+ORDER BY id ASC
+LIMIT $3
 `
 
-func (q *Queries) GetMessagesFromChannel(ctx context.Context, channelID string) ([]Message, error) {
-	rows, err := q.db.Query(ctx, getMessagesFromChannel, channelID)
+type GetMessagesAroundParams struct {
+	ChannelID string      `json:"channel_id"`
+	ID        string      `json:"id"`
+	Limit     int32       `json:"limit"`
+	ThreadID  pgtype.Text `json:"thread_id"`
+}
+
+func (q *Queries) GetMessagesAround(ctx context.Context, arg GetMessagesAroundParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getMessagesAround,
+		arg.ChannelID,
+		arg.ID,
+		arg.Limit,
+		arg.ThreadID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +355,10 @@ func (q *Queries) GetMessagesFromChannel(ctx context.Context, channelID string)
 			&i.Attachments,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReplyTo,
+			&i.ThreadID,
+			&i.PlainText,
+			&i.MentionsRoles,
 		); err != nil {
 			return nil, err
 		}
@@ -207,6 +370,196 @@ func (q *Queries) GetMessagesFromChannel(ctx context.Context, channelID string)
 	return items, nil
 }
 
+const getMessagesBefore = `-- name: GetMessagesBefore :many
+SELECT id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at, reply_to, thread_id, plain_text, mentions_roles FROM messages
+WHERE channel_id = $1 AND id < $2 AND thread_id IS NOT DISTINCT FROM $4
+ORDER BY id DESC
+LIMIT $3
+`
+
+type GetMessagesBeforeParams struct {
+	ChannelID string      `json:"channel_id"`
+	ID        string      `json:"id"`
+	Limit     int32       `json:"limit"`
+	ThreadID  pgtype.Text `json:"thread_id"`
+}
+
+func (q *Queries) GetMessagesBefore(ctx context.Context, arg GetMessagesBeforeParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getMessagesBefore,
+		arg.ChannelID,
+		arg.ID,
+		arg.Limit,
+		arg.ThreadID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.ServerID,
+			&i.ChannelID,
+			&i.Content,
+			&i.Everyone,
+			&i.MentionsUsers,
+			&i.MentionsChannels,
+			&i.Attachments,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReplyTo,
+			&i.ThreadID,
+			&i.PlainText,
+			&i.MentionsRoles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesByIds = `-- name: GetMessagesByIds :many
+SELECT id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at, reply_to, thread_id, plain_text, mentions_roles FROM messages WHERE id = ANY($1::text[])
+`
+
+func (q *Queries) GetMessagesByIds(ctx context.Context, dollar_1 []string) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getMessagesByIds, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.ServerID,
+			&i.ChannelID,
+			&i.Content,
+			&i.Everyone,
+			&i.MentionsUsers,
+			&i.MentionsChannels,
+			&i.Attachments,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReplyTo,
+			&i.ThreadID,
+			&i.PlainText,
+			&i.MentionsRoles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnreadCounts = `-- name: GetUnreadCounts :many
+SELECT m.channel_id,
+  COUNT(*)::int AS unread_count,
+  (COUNT(*) FILTER (WHERE $1::text = ANY(m.mentions_users) OR m.mentions_roles && sm.roles))::int AS mention_count,
+  (COUNT(*) FILTER (WHERE m.everyone AND NOT ($1::text = ANY(m.mentions_users) OR COALESCE(m.mentions_roles && sm.roles, false))))::int AS everyone_count
+FROM messages m
+LEFT JOIN user_channel_read_state rs ON rs.channel_id = m.channel_id AND rs.user_id = $1
+LEFT JOIN server_membership sm ON sm.server_id = m.server_id AND sm.user_id = $1
+WHERE m.channel_id = ANY($2::text[])
+  AND m.thread_id IS NULL
+  AND m.author_id <> $1
+  AND CASE
+    WHEN rs.last_read_message_id IS NOT NULL THEN m.id > rs.last_read_message_id
+    ELSE m.created_at > COALESCE(sm.joined_at, '-infinity'::timestamptz)
+  END
+GROUP BY m.channel_id
+`
+
+type GetUnreadCountsParams struct {
+	UserID     string   `json:"user_id"`
+	ChannelIds []string `json:"channel_ids"`
+}
+
+type GetUnreadCountsRow struct {
+	ChannelID     string `json:"channel_id"`
+	UnreadCount   int32  `json:"unread_count"`
+	MentionCount  int32  `json:"mention_count"`
+	EveryoneCount int32  `json:"everyone_count"`
+}
+
+func (q *Queries) GetUnreadCounts(ctx context.Context, arg GetUnreadCountsParams) ([]GetUnreadCountsRow, error) {
+	rows, err := q.db.Query(ctx, getUnreadCounts, arg.UserID, arg.ChannelIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUnreadCountsRow
+	for rows.Next() {
+		var i GetUnreadCountsRow
+		if err := rows.Scan(
+			&i.ChannelID,
+			&i.UnreadCount,
+			&i.MentionCount,
+			&i.EveryoneCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const hasMessagesSince = `-- name: HasMessagesSince :one
+SELECT EXISTS(
+  SELECT 1 FROM messages
+  WHERE channel_id = $1 AND id >= $2 AND thread_id IS NOT DISTINCT FROM $3
+)
+`
+
+type HasMessagesSinceParams struct {
+	ChannelID string      `json:"channel_id"`
+	ID        string      `json:"id"`
+	ThreadID  pgtype.Text `json:"thread_id"`
+}
+
+func (q *Queries) HasMessagesSince(ctx context.Context, arg HasMessagesSinceParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasMessagesSince, arg.ChannelID, arg.ID, arg.ThreadID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const hasMessagesUntil = `-- name: HasMessagesUntil :one
+SELECT EXISTS(
+  SELECT 1 FROM messages
+  WHERE channel_id = $1 AND id <= $2 AND thread_id IS NOT DISTINCT FROM $3
+)
+`
+
+type HasMessagesUntilParams struct {
+	ChannelID string      `json:"channel_id"`
+	ID        string      `json:"id"`
+	ThreadID  pgtype.Text `json:"thread_id"`
+}
+
+func (q *Queries) HasMessagesUntil(ctx context.Context, arg HasMessagesUntilParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasMessagesUntil, arg.ChannelID, arg.ID, arg.ThreadID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const saveUnreadMessagesState = `-- name: SaveUnreadMessagesState :exec
 INSERT INTO user_channel_read_state (user_id, channel_id, last_read_message_id, unread_mention_ids)
 SELECT $1, unnest($2::VARCHAR[]), unnest($3::VARCHAR[]), unnest($4::JSONB[])
@@ -234,9 +587,81 @@ func (q *Queries) SaveUnreadMessagesState(ctx context.Context, arg SaveUnreadMes
 	return err
 }
 
+const searchMessages = `-- name: SearchMessages :many
+SELECT id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, created_at, updated_at, reply_to, thread_id, plain_text, mentions_roles FROM messages
+WHERE channel_id = ANY($1::text[])
+  AND to_tsvector('simple', plain_text) @@ websearch_to_tsquery('simple', $2::text)
+  AND ($3::text IS NULL OR author_id = $3)
+  AND ($4::text IS NULL OR $4 = ANY(mentions_users))
+  AND ($5::boolean IS NULL OR coalesce(attachments @> '[{}]', false) = $5)
+  AND ($6::timestamptz IS NULL OR created_at >= $6)
+  AND ($7::timestamptz IS NULL OR created_at < $7)
+  AND ($8::text IS NULL OR id < $8)
+ORDER BY id DESC
+LIMIT $9
+`
+
+type SearchMessagesParams struct {
+	ChannelIds    []string           `json:"channel_ids"`
+	Query         string             `json:"query"`
+	AuthorID      pgtype.Text        `json:"author_id"`
+	Mentions      pgtype.Text        `json:"mentions"`
+	HasAttachment pgtype.Bool        `json:"has_attachment"`
+	Since         pgtype.Timestamptz `json:"since"`
+	Until         pgtype.Timestamptz `json:"until"`
+	Cursor        pgtype.Text        `json:"cursor"`
+	Limit         int32              `json:"limit"`
+}
+
+func (q *Queries) SearchMessages(ctx context.Context, arg SearchMessagesParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, searchMessages,
+		arg.ChannelIds,
+		arg.Query,
+		arg.AuthorID,
+		arg.Mentions,
+		arg.HasAttachment,
+		arg.Since,
+		arg.Until,
+		arg.Cursor,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.ServerID,
+			&i.ChannelID,
+			&i.Content,
+			&i.Everyone,
+			&i.MentionsUsers,
+			&i.MentionsChannels,
+			&i.Attachments,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReplyTo,
+			&i.ThreadID,
+			&i.PlainText,
+			&i.MentionsRoles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateMessage = `-- name: UpdateMessage :execresult
 UPDATE messages 
-SET content = $1, mentions_users = $2, mentions_channels = $3, everyone = $4, updated_at = now()
+SET content = $1, mentions_users = $2, mentions_channels = $3, everyone = $4, mentions_roles = $7, updated_at = now()
 WHERE id = $5 AND author_id = $6
 `
 
@@ -247,6 +672,7 @@ type UpdateMessageParams struct {
 	Everyone         bool            `json:"everyone"`
 	ID               string          `json:"id"`
 	AuthorID         string          `json:"author_id"`
+	MentionsRoles    []string        `json:"mentions_roles"`
 }
 
 func (q *Queries) UpdateMessage(ctx context.Context, arg UpdateMessageParams) (pgconn.CommandTag, error) {
@@ -257,6 +683,7 @@ func (q *Queries) UpdateMessage(ctx context.Context, arg UpdateMessageParams) (p
 		arg.Everyone,
 		arg.ID,
 		arg.AuthorID,
+		arg.MentionsRoles,
 	)
 }
 
@@ -20,6 +20,7 @@ const (
 	ChannelTypeTextual ChannelType = "textual"
 	ChannelTypeDm      ChannelType = "dm"
 	ChannelTypeGroups  ChannelType = "groups"
+	ChannelTypeStage   ChannelType = "stage"
 )
 
 func (e *ChannelType) Scan(src interface{}) error {
@@ -57,6 +58,157 @@ func (ns NullChannelType) Value() (driver.Value, error) {
 	return string(ns.ChannelType), nil
 }
 
+type NotificationLevel string
+
+const (
+	NotificationLevelAll      NotificationLevel = "all"
+	NotificationLevelMentions NotificationLevel = "mentions"
+	NotificationLevelNothing  NotificationLevel = "nothing"
+)
+
+func (e *NotificationLevel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationLevel(s)
+	case string:
+		*e = NotificationLevel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationLevel: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationLevel struct {
+	NotificationLevel NotificationLevel `json:"notification_level"`
+	Valid             bool              `json:"valid"` // Valid is true if NotificationLevel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationLevel) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationLevel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationLevel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationLevel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationLevel), nil
+}
+
+type OverwriteType string
+
+const (
+	OverwriteTypeRole OverwriteType = "role"
+	OverwriteTypeUser OverwriteType = "user"
+)
+
+func (e *OverwriteType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OverwriteType(s)
+	case string:
+		*e = OverwriteType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OverwriteType: %T", src)
+	}
+	return nil
+}
+
+type NullOverwriteType struct {
+	OverwriteType OverwriteType `json:"overwrite_type"`
+	Valid         bool          `json:"valid"` // Valid is true if OverwriteType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOverwriteType) Scan(value interface{}) error {
+	if value == nil {
+		ns.OverwriteType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OverwriteType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOverwriteType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OverwriteType), nil
+}
+
+type UserStatus string
+
+const (
+	UserStatusOnline    UserStatus = "online"
+	UserStatusIdle      UserStatus = "idle"
+	UserStatusDnd       UserStatus = "dnd"
+	UserStatusInvisible UserStatus = "invisible"
+)
+
+func (e *UserStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserStatus(s)
+	case string:
+		*e = UserStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserStatus: %T", src)
+	}
+	return nil
+}
+
+type NullUserStatus struct {
+	UserStatus UserStatus `json:"user_status"`
+	Valid      bool       `json:"valid"` // Valid is true if UserStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserStatus), nil
+}
+
+type AuditLog struct {
+	ID         string          `json:"id"`
+	ServerID   string          `json:"server_id"`
+	ActorID    string          `json:"actor_id"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	Changes    json.RawMessage `json:"changes"`
+	Reason     string          `json:"reason"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type Ban struct {
+	ID          string             `json:"id"`
+	ServerID    string             `json:"server_id"`
+	UserID      string             `json:"user_id"`
+	ModeratorID string             `json:"moderator_id"`
+	Reason      string             `json:"reason"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
 type Channel struct {
 	ID          string      `json:"id"`
 	ServerID    string      `json:"server_id"`
@@ -70,6 +222,18 @@ type Channel struct {
 	Active      bool        `json:"active"`
 	CreatedAt   time.Time   `json:"created_at"`
 	UpdatedAt   time.Time   `json:"updated_at"`
+	UserLimit   pgtype.Int4 `json:"user_limit"`
+}
+
+type ChannelOverwrite struct {
+	ID        string        `json:"id"`
+	ChannelID string        `json:"channel_id"`
+	TargetID  string        `json:"target_id"`
+	Type      OverwriteType `json:"type"`
+	Allow     []string      `json:"allow"`
+	Deny      []string      `json:"deny"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
 }
 
 type Emoji struct {
@@ -89,10 +253,26 @@ type Friend struct {
 }
 
 type Invite struct {
-	ID       string    `json:"id"`
-	ServerID string    `json:"server_id"`
-	InviteID string    `json:"invite_id"`
-	ExpireAt time.Time `json:"expire_at"`
+	ID        string             `json:"id"`
+	ServerID  string             `json:"server_id"`
+	InviteID  string             `json:"invite_id"`
+	ExpireAt  pgtype.Timestamptz `json:"expire_at"`
+	CreatorID pgtype.Text        `json:"creator_id"`
+	MaxUses   int32              `json:"max_uses"`
+	Uses      int32              `json:"uses"`
+	Vanity    bool               `json:"vanity"`
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type JoinRequest struct {
+	ID        string    `json:"id"`
+	ServerID  string    `json:"server_id"`
+	UserID    string    `json:"user_id"`
+	InviteID  string    `json:"invite_id"`
+	X         int32     `json:"x"`
+	Y         int32     `json:"y"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Message struct {
@@ -107,6 +287,48 @@ type Message struct {
 	Attachments      []byte          `json:"attachments"`
 	CreatedAt        time.Time       `json:"created_at"`
 	UpdatedAt        time.Time       `json:"updated_at"`
+	ReplyTo          pgtype.Text     `json:"reply_to"`
+	ThreadID         pgtype.Text     `json:"thread_id"`
+	PlainText        pgtype.Text     `json:"plain_text"`
+	MentionsRoles    []string        `json:"mentions_roles"`
+}
+
+type Notification struct {
+	ID        string             `json:"id"`
+	UserID    string             `json:"user_id"`
+	MessageID string             `json:"message_id"`
+	ServerID  string             `json:"server_id"`
+	ChannelID string             `json:"channel_id"`
+	AuthorID  string             `json:"author_id"`
+	Type      string             `json:"type"`
+	ReadAt    pgtype.Timestamptz `json:"read_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type NotificationSetting struct {
+	UserID           string                `json:"user_id"`
+	ServerID         string                `json:"server_id"`
+	ChannelID        pgtype.Text           `json:"channel_id"`
+	Level            NullNotificationLevel `json:"level"`
+	MutedUntil       pgtype.Timestamptz    `json:"muted_until"`
+	SuppressEveryone bool                  `json:"suppress_everyone"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+}
+
+type Reaction struct {
+	ID        string      `json:"id"`
+	MessageID string      `json:"message_id"`
+	UserID    string      `json:"user_id"`
+	Emoji     pgtype.Text `json:"emoji"`
+	EmojiID   pgtype.Text `json:"emoji_id"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type RecoveryCode struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	CodeHash  string    `json:"code_hash"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Role struct {
@@ -134,41 +356,68 @@ type Server struct {
 }
 
 type ServerMembership struct {
-	ID       string    `json:"id"`
-	UserID   string    `json:"user_id"`
-	ServerID string    `json:"server_id"`
-	Roles    []string  `json:"roles"`
-	X        int32     `json:"x"`
-	Y        int32     `json:"y"`
-	JoinedAt time.Time `json:"joined_at"`
+	ID           string             `json:"id"`
+	UserID       string             `json:"user_id"`
+	ServerID     string             `json:"server_id"`
+	Roles        []string           `json:"roles"`
+	X            int32              `json:"x"`
+	Y            int32              `json:"y"`
+	JoinedAt     time.Time          `json:"joined_at"`
+	TimeoutUntil pgtype.Timestamptz `json:"timeout_until"`
+}
+
+type Thread struct {
+	ID        string    `json:"id"`
+	ServerID  string    `json:"server_id"`
+	ChannelID string    `json:"channel_id"`
+	CreatorID string    `json:"creator_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Token struct {
-	ID       string    `json:"id"`
-	UserID   string    `json:"user_id"`
-	Token    string    `json:"token"`
-	Type     string    `json:"type"`
-	ExpireAt time.Time `json:"expire_at"`
+	ID            string             `json:"id"`
+	UserID        string             `json:"user_id"`
+	Token         string             `json:"token"`
+	Type          string             `json:"type"`
+	ExpireAt      time.Time          `json:"expire_at"`
+	DeviceName    string             `json:"device_name"`
+	Ip            string             `json:"ip"`
+	CreatedAt     time.Time          `json:"created_at"`
+	LastUsedAt    time.Time          `json:"last_used_at"`
+	Attempts      int32              `json:"attempts"`
+	PreviousToken pgtype.Text        `json:"previous_token"`
+	RotatedAt     pgtype.Timestamptz `json:"rotated_at"`
 }
 
 type User struct {
-	ID          string      `json:"id"`
-	Email       string      `json:"email"`
-	Username    string      `json:"username"`
-	Password    string      `json:"password"`
-	DisplayName string      `json:"display_name"`
-	Avatar      pgtype.Text `json:"avatar"`
-	Banner      pgtype.Text `json:"banner"`
-	Body        pgtype.Text `json:"body"`
-	About       []byte      `json:"about"`
-	MainColor   pgtype.Text `json:"main_color"`
-	Links       []byte      `json:"links"`
-	Facts       []byte      `json:"facts"`
-	Experience  int32       `json:"experience"`
-	RpmID       pgtype.Text `json:"rpm_id"`
-	RpmToken    pgtype.Text `json:"rpm_token"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	ID                    string             `json:"id"`
+	Email                 string             `json:"email"`
+	Username              string             `json:"username"`
+	Password              string             `json:"password"`
+	DisplayName           string             `json:"display_name"`
+	Avatar                pgtype.Text        `json:"avatar"`
+	Banner                pgtype.Text        `json:"banner"`
+	Body                  pgtype.Text        `json:"body"`
+	About                 []byte             `json:"about"`
+	MainColor             pgtype.Text        `json:"main_color"`
+	Links                 []byte             `json:"links"`
+	Facts                 []byte             `json:"facts"`
+	Experience            int32              `json:"experience"`
+	RpmID                 pgtype.Text        `json:"rpm_id"`
+	RpmToken              pgtype.Text        `json:"rpm_token"`
+	CreatedAt             time.Time          `json:"created_at"`
+	UpdatedAt             time.Time          `json:"updated_at"`
+	Status                UserStatus         `json:"status"`
+	CustomStatusText      pgtype.Text        `json:"custom_status_text"`
+	CustomStatusEmoji     pgtype.Text        `json:"custom_status_emoji"`
+	CustomStatusExpiresAt pgtype.Timestamptz `json:"custom_status_expires_at"`
+	EmailVerified         bool               `json:"email_verified"`
+	TotpSecret            pgtype.Text        `json:"totp_secret"`
+	TotpEnabled           bool               `json:"totp_enabled"`
+	TotpLastStep          int64              `json:"totp_last_step"`
+	TotpAttempts          int32              `json:"totp_attempts"`
+	TotpAttemptsResetAt   time.Time          `json:"totp_attempts_reset_at"`
 }
 
 type UserChannelReadState struct {
@@ -0,0 +1,181 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: moderation.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createBan = `-- name: CreateBan :one
+INSERT INTO bans (
+  id, server_id, user_id, moderator_id, reason, expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (server_id, user_id)
+DO UPDATE SET
+    moderator_id = EXCLUDED.moderator_id,
+    reason = EXCLUDED.reason,
+    expires_at = EXCLUDED.expires_at,
+    created_at = NOW()
+RETURNING id, server_id, user_id, moderator_id, reason, expires_at, created_at
+`
+
+type CreateBanParams struct {
+	ID          string             `json:"id"`
+	ServerID    string             `json:"server_id"`
+	UserID      string             `json:"user_id"`
+	ModeratorID string             `json:"moderator_id"`
+	Reason      string             `json:"reason"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateBan(ctx context.Context, arg CreateBanParams) (Ban, error) {
+	row := q.db.QueryRow(ctx, createBan,
+		arg.ID,
+		arg.ServerID,
+		arg.UserID,
+		arg.ModeratorID,
+		arg.Reason,
+		arg.ExpiresAt,
+	)
+	var i Ban
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.UserID,
+		&i.ModeratorID,
+		&i.Reason,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteBan = `-- name: DeleteBan :execresult
+DELETE FROM bans WHERE server_id = $1 AND user_id = $2
+`
+
+type DeleteBanParams struct {
+	ServerID string `json:"server_id"`
+	UserID   string `json:"user_id"`
+}
+
+func (q *Queries) DeleteBan(ctx context.Context, arg DeleteBanParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, deleteBan, arg.ServerID, arg.UserID)
+}
+
+const getBans = `-- name: GetBans :many
+SELECT b.id, b.user_id, b.moderator_id, b.reason, b.expires_at, b.created_at, u.username, u.display_name, u.avatar
+FROM bans b
+JOIN users u ON u.id = b.user_id
+WHERE b.server_id = $1 AND (b.expires_at IS NULL OR b.expires_at > NOW())
+ORDER BY b.created_at DESC
+`
+
+type GetBansRow struct {
+	ID          string             `json:"id"`
+	UserID      string             `json:"user_id"`
+	ModeratorID string             `json:"moderator_id"`
+	Reason      string             `json:"reason"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Username    string             `json:"username"`
+	DisplayName string             `json:"display_name"`
+	Avatar      pgtype.Text        `json:"avatar"`
+}
+
+func (q *Queries) GetBans(ctx context.Context, serverID string) ([]GetBansRow, error) {
+	rows, err := q.db.Query(ctx, getBans, serverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBansRow
+	for rows.Next() {
+		var i GetBansRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ModeratorID,
+			&i.Reason,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.Username,
+			&i.DisplayName,
+			&i.Avatar,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getServerTimeouts = `-- name: GetServerTimeouts :many
+SELECT user_id, timeout_until FROM server_membership
+WHERE server_id = $1 AND timeout_until > NOW()
+`
+
+type GetServerTimeoutsRow struct {
+	UserID       string             `json:"user_id"`
+	TimeoutUntil pgtype.Timestamptz `json:"timeout_until"`
+}
+
+func (q *Queries) GetServerTimeouts(ctx context.Context, serverID string) ([]GetServerTimeoutsRow, error) {
+	rows, err := q.db.Query(ctx, getServerTimeouts, serverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetServerTimeoutsRow
+	for rows.Next() {
+		var i GetServerTimeoutsRow
+		if err := rows.Scan(&i.UserID, &i.TimeoutUntil); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isBanned = `-- name: IsBanned :execresult
+SELECT id FROM bans
+WHERE server_id = $1 AND user_id = $2 AND (expires_at IS NULL OR expires_at > NOW())
+`
+
+type IsBannedParams struct {
+	ServerID string `json:"server_id"`
+	UserID   string `json:"user_id"`
+}
+
+func (q *Queries) IsBanned(ctx context.Context, arg IsBannedParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, isBanned, arg.ServerID, arg.UserID)
+}
+
+const setMemberTimeout = `-- name: SetMemberTimeout :execresult
+UPDATE server_membership SET timeout_until = $1 WHERE server_id = $2 AND user_id = $3
+`
+
+type SetMemberTimeoutParams struct {
+	TimeoutUntil pgtype.Timestamptz `json:"timeout_until"`
+	ServerID     string             `json:"server_id"`
+	UserID       string             `json:"user_id"`
+}
+
+func (q *Queries) SetMemberTimeout(ctx context.Context, arg SetMemberTimeoutParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, setMemberTimeout, arg.TimeoutUntil, arg.ServerID, arg.UserID)
+}
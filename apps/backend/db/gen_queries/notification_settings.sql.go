@@ -0,0 +1,185 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: notification_settings.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteNotificationSettings = `-- name: DeleteNotificationSettings :execresult
+DELETE FROM notification_settings
+WHERE user_id = $1 AND server_id = $2 AND channel_id IS NOT DISTINCT FROM $3
+`
+
+type DeleteNotificationSettingsParams struct {
+	UserID    string      `json:"user_id"`
+	ServerID  string      `json:"server_id"`
+	ChannelID pgtype.Text `json:"channel_id"`
+}
+
+func (q *Queries) DeleteNotificationSettings(ctx context.Context, arg DeleteNotificationSettingsParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, deleteNotificationSettings, arg.UserID, arg.ServerID, arg.ChannelID)
+}
+
+const getMembersNotificationSettings = `-- name: GetMembersNotificationSettings :many
+SELECT user_id, server_id, channel_id, level, muted_until, suppress_everyone, updated_at FROM notification_settings
+WHERE user_id = ANY($1::text[])
+  AND server_id = $2
+  AND (channel_id IS NULL OR channel_id = $3)
+`
+
+type GetMembersNotificationSettingsParams struct {
+	UserIds   []string    `json:"user_ids"`
+	ServerID  string      `json:"server_id"`
+	ChannelID pgtype.Text `json:"channel_id"`
+}
+
+func (q *Queries) GetMembersNotificationSettings(ctx context.Context, arg GetMembersNotificationSettingsParams) ([]NotificationSetting, error) {
+	rows, err := q.db.Query(ctx, getMembersNotificationSettings, arg.UserIds, arg.ServerID, arg.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationSetting
+	for rows.Next() {
+		var i NotificationSetting
+		if err := rows.Scan(
+			&i.UserID,
+			&i.ServerID,
+			&i.ChannelID,
+			&i.Level,
+			&i.MutedUntil,
+			&i.SuppressEveryone,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotificationSettings = `-- name: GetNotificationSettings :many
+SELECT user_id, server_id, channel_id, level, muted_until, suppress_everyone, updated_at FROM notification_settings WHERE user_id = $1
+`
+
+func (q *Queries) GetNotificationSettings(ctx context.Context, userID string) ([]NotificationSetting, error) {
+	rows, err := q.db.Query(ctx, getNotificationSettings, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationSetting
+	for rows.Next() {
+		var i NotificationSetting
+		if err := rows.Scan(
+			&i.UserID,
+			&i.ServerID,
+			&i.ChannelID,
+			&i.Level,
+			&i.MutedUntil,
+			&i.SuppressEveryone,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertChannelNotificationSettings = `-- name: UpsertChannelNotificationSettings :one
+INSERT INTO notification_settings (user_id, server_id, channel_id, level, muted_until, suppress_everyone)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id, channel_id) WHERE channel_id IS NOT NULL
+DO UPDATE SET
+    level = EXCLUDED.level,
+    muted_until = EXCLUDED.muted_until,
+    suppress_everyone = EXCLUDED.suppress_everyone,
+    updated_at = NOW()
+RETURNING user_id, server_id, channel_id, level, muted_until, suppress_everyone, updated_at
+`
+
+type UpsertChannelNotificationSettingsParams struct {
+	UserID           string                `json:"user_id"`
+	ServerID         string                `json:"server_id"`
+	ChannelID        pgtype.Text           `json:"channel_id"`
+	Level            NullNotificationLevel `json:"level"`
+	MutedUntil       pgtype.Timestamptz    `json:"muted_until"`
+	SuppressEveryone bool                  `json:"suppress_everyone"`
+}
+
+func (q *Queries) UpsertChannelNotificationSettings(ctx context.Context, arg UpsertChannelNotificationSettingsParams) (NotificationSetting, error) {
+	row := q.db.QueryRow(ctx, upsertChannelNotificationSettings,
+		arg.UserID,
+		arg.ServerID,
+		arg.ChannelID,
+		arg.Level,
+		arg.MutedUntil,
+		arg.SuppressEveryone,
+	)
+	var i NotificationSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.ServerID,
+		&i.ChannelID,
+		&i.Level,
+		&i.MutedUntil,
+		&i.SuppressEveryone,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertServerNotificationSettings = `-- name: UpsertServerNotificationSettings :one
+INSERT INTO notification_settings (user_id, server_id, level, muted_until, suppress_everyone)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, server_id) WHERE channel_id IS NULL
+DO UPDATE SET
+    level = EXCLUDED.level,
+    muted_until = EXCLUDED.muted_until,
+    suppress_everyone = EXCLUDED.suppress_everyone,
+    updated_at = NOW()
+RETURNING user_id, server_id, channel_id, level, muted_until, suppress_everyone, updated_at
+`
+
+type UpsertServerNotificationSettingsParams struct {
+	UserID           string                `json:"user_id"`
+	ServerID         string                `json:"server_id"`
+	Level            NullNotificationLevel `json:"level"`
+	MutedUntil       pgtype.Timestamptz    `json:"muted_until"`
+	SuppressEveryone bool                  `json:"suppress_everyone"`
+}
+
+func (q *Queries) UpsertServerNotificationSettings(ctx context.Context, arg UpsertServerNotificationSettingsParams) (NotificationSetting, error) {
+	row := q.db.QueryRow(ctx, upsertServerNotificationSettings,
+		arg.UserID,
+		arg.ServerID,
+		arg.Level,
+		arg.MutedUntil,
+		arg.SuppressEveryone,
+	)
+	var i NotificationSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.ServerID,
+		&i.ChannelID,
+		&i.Level,
+		&i.MutedUntil,
+		&i.SuppressEveryone,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
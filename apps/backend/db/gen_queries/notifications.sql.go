@@ -0,0 +1,145 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: notifications.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreateNotificationsParams struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	MessageID string `json:"message_id"`
+	ServerID  string `json:"server_id"`
+	ChannelID string `json:"channel_id"`
+	AuthorID  string `json:"author_id"`
+	Type      string `json:"type"`
+}
+
+const getNotifications = `-- name: GetNotifications :many
+SELECT n.id, n.server_id, n.channel_id, n.message_id, n.author_id, n.type, n.read_at, n.created_at,
+  m.content, m.thread_id, u.username, u.display_name, u.avatar
+FROM notifications n
+JOIN messages m ON m.id = n.message_id
+JOIN users u ON u.id = n.author_id
+WHERE n.user_id = $1
+  AND ($2::text IS NULL OR n.id < $2::text)
+  AND (NOT $3::bool OR n.read_at IS NULL)
+ORDER BY n.id DESC
+LIMIT $4
+`
+
+type GetNotificationsParams struct {
+	UserID     string      `json:"user_id"`
+	Before     pgtype.Text `json:"before"`
+	UnreadOnly bool        `json:"unread_only"`
+	Limit      int32       `json:"limit"`
+}
+
+type GetNotificationsRow struct {
+	ID          string             `json:"id"`
+	ServerID    string             `json:"server_id"`
+	ChannelID   string             `json:"channel_id"`
+	MessageID   string             `json:"message_id"`
+	AuthorID    string             `json:"author_id"`
+	Type        string             `json:"type"`
+	ReadAt      pgtype.Timestamptz `json:"read_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Content     json.RawMessage    `json:"content"`
+	ThreadID    pgtype.Text        `json:"thread_id"`
+	Username    string             `json:"username"`
+	DisplayName string             `json:"display_name"`
+	Avatar      pgtype.Text        `json:"avatar"`
+}
+
+func (q *Queries) GetNotifications(ctx context.Context, arg GetNotificationsParams) ([]GetNotificationsRow, error) {
+	rows, err := q.db.Query(ctx, getNotifications,
+		arg.UserID,
+		arg.Before,
+		arg.UnreadOnly,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetNotificationsRow
+	for rows.Next() {
+		var i GetNotificationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.ChannelID,
+			&i.MessageID,
+			&i.AuthorID,
+			&i.Type,
+			&i.ReadAt,
+			&i.CreatedAt,
+			&i.Content,
+			&i.ThreadID,
+			&i.Username,
+			&i.DisplayName,
+			&i.Avatar,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getServerMembersRoles = `-- name: GetServerMembersRoles :many
+SELECT user_id, roles FROM server_membership WHERE server_id = $1
+`
+
+type GetServerMembersRolesRow struct {
+	UserID string   `json:"user_id"`
+	Roles  []string `json:"roles"`
+}
+
+func (q *Queries) GetServerMembersRoles(ctx context.Context, serverID string) ([]GetServerMembersRolesRow, error) {
+	rows, err := q.db.Query(ctx, getServerMembersRoles, serverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetServerMembersRolesRow
+	for rows.Next() {
+		var i GetServerMembersRolesRow
+		if err := rows.Scan(&i.UserID, &i.Roles); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markNotificationsRead = `-- name: MarkNotificationsRead :execresult
+UPDATE notifications SET read_at = NOW()
+WHERE user_id = $1
+  AND read_at IS NULL
+  AND ($2::text[] IS NULL OR id = ANY($2::text[]))
+`
+
+type MarkNotificationsReadParams struct {
+	UserID string   `json:"user_id"`
+	Ids    []string `json:"ids"`
+}
+
+func (q *Queries) MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, markNotificationsRead, arg.UserID, arg.Ids)
+}
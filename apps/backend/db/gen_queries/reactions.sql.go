@@ -0,0 +1,109 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: reactions.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addReaction = `-- name: AddReaction :execresult
+INSERT INTO reactions (
+  id, message_id, user_id, emoji, emoji_id
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT DO NOTHING
+`
+
+type AddReactionParams struct {
+	ID        string      `json:"id"`
+	MessageID string      `json:"message_id"`
+	UserID    string      `json:"user_id"`
+	Emoji     pgtype.Text `json:"emoji"`
+	EmojiID   pgtype.Text `json:"emoji_id"`
+}
+
+func (q *Queries) AddReaction(ctx context.Context, arg AddReactionParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, addReaction,
+		arg.ID,
+		arg.MessageID,
+		arg.UserID,
+		arg.Emoji,
+		arg.EmojiID,
+	)
+}
+
+const getReactionsFromMessages = `-- name: GetReactionsFromMessages :many
+SELECT r.message_id, r.emoji, r.emoji_id, e.url AS emoji_url, count(r.id) AS count, array_agg(r.user_id ORDER BY r.created_at)::text[] AS users
+FROM reactions r
+LEFT JOIN emojis e ON e.id = r.emoji_id
+WHERE r.message_id = ANY($1::text[])
+GROUP BY r.message_id, r.emoji, r.emoji_id, e.url
+ORDER BY min(r.created_at)
+`
+
+type GetReactionsFromMessagesRow struct {
+	MessageID string      `json:"message_id"`
+	Emoji     pgtype.Text `json:"emoji"`
+	EmojiID   pgtype.Text `json:"emoji_id"`
+	EmojiUrl  pgtype.Text `json:"emoji_url"`
+	Count     int64       `json:"count"`
+	Users     []string    `json:"users"`
+}
+
+func (q *Queries) GetReactionsFromMessages(ctx context.Context, dollar_1 []string) ([]GetReactionsFromMessagesRow, error) {
+	rows, err := q.db.Query(ctx, getReactionsFromMessages, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReactionsFromMessagesRow
+	for rows.Next() {
+		var i GetReactionsFromMessagesRow
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.Emoji,
+			&i.EmojiID,
+			&i.EmojiUrl,
+			&i.Count,
+			&i.Users,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeReaction = `-- name: RemoveReaction :execresult
+DELETE FROM reactions
+WHERE message_id = $1
+  AND user_id = $2
+  AND emoji IS NOT DISTINCT FROM $3
+  AND emoji_id IS NOT DISTINCT FROM $4
+`
+
+type RemoveReactionParams struct {
+	MessageID string      `json:"message_id"`
+	UserID    string      `json:"user_id"`
+	Emoji     pgtype.Text `json:"emoji"`
+	EmojiID   pgtype.Text `json:"emoji_id"`
+}
+
+func (q *Queries) RemoveReaction(ctx context.Context, arg RemoveReactionParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, removeReaction,
+		arg.MessageID,
+		arg.UserID,
+		arg.Emoji,
+		arg.EmojiID,
+	)
+}
@@ -0,0 +1,66 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: recovery_codes.sql
+
+package db
+
+import (
+	"context"
+)
+
+type CreateRecoveryCodesParams struct {
+	ID       string `json:"id"`
+	UserID   string `json:"user_id"`
+	CodeHash string `json:"code_hash"`
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE FROM recovery_codes WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, deleteRecoveryCodes, userID)
+	return err
+}
+
+const getRecoveryCodes = `-- name: GetRecoveryCodes :many
+SELECT id, code_hash FROM recovery_codes WHERE user_id = $1
+`
+
+type GetRecoveryCodesRow struct {
+	ID       string `json:"id"`
+	CodeHash string `json:"code_hash"`
+}
+
+func (q *Queries) GetRecoveryCodes(ctx context.Context, userID string) ([]GetRecoveryCodesRow, error) {
+	rows, err := q.db.Query(ctx, getRecoveryCodes, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRecoveryCodesRow
+	for rows.Next() {
+		var i GetRecoveryCodesRow
+		if err := rows.Scan(&i.ID, &i.CodeHash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+DELETE FROM recovery_codes WHERE id = $1
+`
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, useRecoveryCode, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -68,11 +68,16 @@ func (q *Queries) CreateRole(ctx context.Context, arg CreateRoleParams) (Role, e
 }
 
 const deleteRole = `-- name: DeleteRole :exec
-DELETE FROM roles WHERE id = $1
+DELETE FROM roles WHERE id = $1 AND server_id = $2
 `
 
-func (q *Queries) DeleteRole(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, deleteRole, id)
+type DeleteRoleParams struct {
+	ID       string `json:"id"`
+	ServerID string `json:"server_id"`
+}
+
+func (q *Queries) DeleteRole(ctx context.Context, arg DeleteRoleParams) error {
+	_, err := q.db.Exec(ctx, deleteRole, arg.ID, arg.ServerID)
 	return err
 }
 
@@ -150,9 +155,10 @@ func (q *Queries) GetRoles(ctx context.Context, serverID string) ([]GetRolesRow,
 }
 
 const getUserAbilities = `-- name: GetUserAbilities :many
-SELECT r.abilities 
-FROM roles r, server_membership sm 
-WHERE sm.server_id = $1 AND sm.user_id = $2 AND r.id = ANY(sm.roles)
+SELECT r.id, r.idx, r.abilities
+FROM roles r, server_membership sm
+WHERE sm.server_id = $1 AND sm.user_id = $2 AND r.server_id = $1 AND r.id = ANY(sm.roles)
+ORDER BY r.idx
 `
 
 type GetUserAbilitiesParams struct {
@@ -160,19 +166,25 @@ type GetUserAbilitiesParams struct {
 	UserID   string `json:"user_id"`
 }
 
-func (q *Queries) GetUserAbilities(ctx context.Context, arg GetUserAbilitiesParams) ([][]string, error) {
+type GetUserAbilitiesRow struct {
+	ID        string   `json:"id"`
+	Idx       int32    `json:"idx"`
+	Abilities []string `json:"abilities"`
+}
+
+func (q *Queries) GetUserAbilities(ctx context.Context, arg GetUserAbilitiesParams) ([]GetUserAbilitiesRow, error) {
 	rows, err := q.db.Query(ctx, getUserAbilities, arg.ServerID, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items [][]string
+	var items []GetUserAbilitiesRow
 	for rows.Next() {
-		var abilities []string
-		if err := rows.Scan(&abilities); err != nil {
+		var i GetUserAbilitiesRow
+		if err := rows.Scan(&i.ID, &i.Idx, &i.Abilities); err != nil {
 			return nil, err
 		}
-		items = append(items, abilities)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -181,25 +193,31 @@ func (q *Queries) GetUserAbilities(ctx context.Context, arg GetUserAbilitiesPara
 }
 
 const moveRole = `-- name: MoveRole :exec
-UPDATE roles SET idx = $1 WHERE id = $2
+UPDATE roles SET idx = $1 WHERE id = $2 AND server_id = $3
 `
 
 type MoveRoleParams struct {
-	Idx int32  `json:"idx"`
-	ID  string `json:"id"`
+	Idx      int32  `json:"idx"`
+	ID       string `json:"id"`
+	ServerID string `json:"server_id"`
 }
 
 func (q *Queries) MoveRole(ctx context.Context, arg MoveRoleParams) error {
-	_, err := q.db.Exec(ctx, moveRole, arg.Idx, arg.ID)
+	_, err := q.db.Exec(ctx, moveRole, arg.Idx, arg.ID, arg.ServerID)
 	return err
 }
 
 const removeRoleFromAllMembers = `-- name: RemoveRoleFromAllMembers :exec
-UPDATE server_membership SET roles = array_remove(roles, $1) WHERE $1 = ANY(roles)
+UPDATE server_membership SET roles = array_remove(roles, $1) WHERE server_id = $2 AND $1 = ANY(roles)
 `
 
-func (q *Queries) RemoveRoleFromAllMembers(ctx context.Context, arrayRemove interface{}) error {
-	_, err := q.db.Exec(ctx, removeRoleFromAllMembers, arrayRemove)
+type RemoveRoleFromAllMembersParams struct {
+	ArrayRemove interface{} `json:"array_remove"`
+	ServerID    string      `json:"server_id"`
+}
+
+func (q *Queries) RemoveRoleFromAllMembers(ctx context.Context, arg RemoveRoleFromAllMembersParams) error {
+	_, err := q.db.Exec(ctx, removeRoleFromAllMembers, arg.ArrayRemove, arg.ServerID)
 	return err
 }
 
@@ -219,15 +237,16 @@ func (q *Queries) RemoveRoleMember(ctx context.Context, arg RemoveRoleMemberPara
 }
 
 const updateRolePositions = `-- name: UpdateRolePositions :exec
-UPDATE roles SET idx = idx + 1 WHERE idx >= $1 AND idx < $2
+UPDATE roles SET idx = idx + 1 WHERE server_id = $1 AND idx >= $2 AND idx < $3
 `
 
 type UpdateRolePositionsParams struct {
-	Idx   int32 `json:"idx"`
-	Idx_2 int32 `json:"idx_2"`
+	ServerID string `json:"server_id"`
+	Idx      int32  `json:"idx"`
+	Idx_2    int32  `json:"idx_2"`
 }
 
 func (q *Queries) UpdateRolePositions(ctx context.Context, arg UpdateRolePositionsParams) error {
-	_, err := q.db.Exec(ctx, updateRolePositions, arg.Idx, arg.Idx_2)
+	_, err := q.db.Exec(ctx, updateRolePositions, arg.ServerID, arg.Idx, arg.Idx_2)
 	return err
 }
@@ -452,7 +452,7 @@ func (q *Queries) OwnServer(ctx context.Context, arg OwnServerParams) (pgconn.Co
 }
 
 const updateServerAvatarNBanner = `-- name: UpdateServerAvatarNBanner :exec
-UPDATE servers SET avatar = $1, banner = $2, main_color = $3 WHERE id = $4 AND owner_id = $5
+UPDATE servers SET avatar = $1, banner = $2, main_color = $3 WHERE id = $4
 `
 
 type UpdateServerAvatarNBannerParams struct {
@@ -460,7 +460,6 @@ type UpdateServerAvatarNBannerParams struct {
 	Banner    pgtype.Text `json:"banner"`
 	MainColor pgtype.Text `json:"main_color"`
 	ID        string      `json:"id"`
-	OwnerID   string      `json:"owner_id"`
 }
 
 func (q *Queries) UpdateServerAvatarNBanner(ctx context.Context, arg UpdateServerAvatarNBannerParams) error {
@@ -469,37 +468,34 @@ func (q *Queries) UpdateServerAvatarNBanner(ctx context.Context, arg UpdateServe
 		arg.Banner,
 		arg.MainColor,
 		arg.ID,
-		arg.OwnerID,
 	)
 	return err
 }
 
 const updateServerDescription = `-- name: UpdateServerDescription :exec
-UPDATE servers SET description = $1 WHERE id = $2 AND owner_id = $3
+UPDATE servers SET description = $1 WHERE id = $2
 `
 
 type UpdateServerDescriptionParams struct {
 	Description []byte `json:"description"`
 	ID          string `json:"id"`
-	OwnerID     string `json:"owner_id"`
 }
 
 func (q *Queries) UpdateServerDescription(ctx context.Context, arg UpdateServerDescriptionParams) error {
-	_, err := q.db.Exec(ctx, updateServerDescription, arg.Description, arg.ID, arg.OwnerID)
+	_, err := q.db.Exec(ctx, updateServerDescription, arg.Description, arg.ID)
 	return err
 }
 
 const updateServerName = `-- name: UpdateServerName :exec
-UPDATE servers SET name = $1 WHERE id = $2 AND owner_id = $3
+UPDATE servers SET name = $1 WHERE id = $2
 `
 
 type UpdateServerNameParams struct {
-	Name    string `json:"name"`
-	ID      string `json:"id"`
-	OwnerID string `json:"owner_id"`
+	Name string `json:"name"`
+	ID   string `json:"id"`
 }
 
 func (q *Queries) UpdateServerName(ctx context.Context, arg UpdateServerNameParams) error {
-	_, err := q.db.Exec(ctx, updateServerName, arg.Name, arg.ID, arg.OwnerID)
+	_, err := q.db.Exec(ctx, updateServerName, arg.Name, arg.ID)
 	return err
 }
@@ -0,0 +1,96 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: threads.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createThread = `-- name: CreateThread :one
+INSERT INTO threads (
+  id, server_id, channel_id, creator_id, name
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, server_id, channel_id, creator_id, name, created_at
+`
+
+type CreateThreadParams struct {
+	ID        string `json:"id"`
+	ServerID  string `json:"server_id"`
+	ChannelID string `json:"channel_id"`
+	CreatorID string `json:"creator_id"`
+	Name      string `json:"name"`
+}
+
+func (q *Queries) CreateThread(ctx context.Context, arg CreateThreadParams) (Thread, error) {
+	row := q.db.QueryRow(ctx, createThread,
+		arg.ID,
+		arg.ServerID,
+		arg.ChannelID,
+		arg.CreatorID,
+		arg.Name,
+	)
+	var i Thread
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.ChannelID,
+		&i.CreatorID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getThread = `-- name: GetThread :one
+SELECT id, server_id, channel_id, creator_id, name, created_at FROM threads WHERE id = $1
+`
+
+func (q *Queries) GetThread(ctx context.Context, id string) (Thread, error) {
+	row := q.db.QueryRow(ctx, getThread, id)
+	var i Thread
+	err := row.Scan(
+		&i.ID,
+		&i.ServerID,
+		&i.ChannelID,
+		&i.CreatorID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getThreadsFromChannel = `-- name: GetThreadsFromChannel :many
+SELECT id, server_id, channel_id, creator_id, name, created_at FROM threads WHERE channel_id = $1
+`
+
+func (q *Queries) GetThreadsFromChannel(ctx context.Context, channelID string) ([]Thread, error) {
+	rows, err := q.db.Query(ctx, getThreadsFromChannel, channelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Thread
+	for rows.Next() {
+		var i Thread
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.ChannelID,
+			&i.CreatorID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -10,21 +10,59 @@ import (
 	"time"
 )
 
+const attemptToken = `-- name: AttemptToken :one
+UPDATE tokens SET attempts = attempts + 1
+WHERE token = $1 AND type = $2 AND expire_at > now() AND attempts < $3::int
+RETURNING user_id
+`
+
+type AttemptTokenParams struct {
+	Token       string `json:"token"`
+	Type        string `json:"type"`
+	MaxAttempts int32  `json:"max_attempts"`
+}
+
+func (q *Queries) AttemptToken(ctx context.Context, arg AttemptTokenParams) (string, error) {
+	row := q.db.QueryRow(ctx, attemptToken, arg.Token, arg.Type, arg.MaxAttempts)
+	var user_id string
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const consumeToken = `-- name: ConsumeToken :one
+DELETE FROM tokens WHERE token = $1 AND type = $2 AND expire_at > now()
+RETURNING user_id
+`
+
+type ConsumeTokenParams struct {
+	Token string `json:"token"`
+	Type  string `json:"type"`
+}
+
+func (q *Queries) ConsumeToken(ctx context.Context, arg ConsumeTokenParams) (string, error) {
+	row := q.db.QueryRow(ctx, consumeToken, arg.Token, arg.Type)
+	var user_id string
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
 const createToken = `-- name: CreateToken :one
 INSERT INTO tokens (
-  id, user_id, token, expire_at, type
+  id, user_id, token, expire_at, type, device_name, ip
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6, $7
 )
-RETURNING id, user_id, token, type, expire_at
+RETURNING id, user_id, token, type, expire_at, device_name, ip, created_at, last_used_at, attempts, previous_token, rotated_at
 `
 
 type CreateTokenParams struct {
-	ID       string    `json:"id"`
-	UserID   string    `json:"user_id"`
-	Token    string    `json:"token"`
-	ExpireAt time.Time `json:"expire_at"`
-	Type     string    `json:"type"`
+	ID         string    `json:"id"`
+	UserID     string    `json:"user_id"`
+	Token      string    `json:"token"`
+	ExpireAt   time.Time `json:"expire_at"`
+	Type       string    `json:"type"`
+	DeviceName string    `json:"device_name"`
+	Ip         string    `json:"ip"`
 }
 
 func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (Token, error) {
@@ -34,6 +72,8 @@ func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (Token
 		arg.Token,
 		arg.ExpireAt,
 		arg.Type,
+		arg.DeviceName,
+		arg.Ip,
 	)
 	var i Token
 	err := row.Scan(
@@ -42,44 +82,291 @@ func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (Token
 		&i.Token,
 		&i.Type,
 		&i.ExpireAt,
+		&i.DeviceName,
+		&i.Ip,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.Attempts,
+		&i.PreviousToken,
+		&i.RotatedAt,
 	)
 	return i, err
 }
 
-const deleteRememberMeToken = `-- name: DeleteRememberMeToken :exec
-DELETE FROM tokens WHERE user_id = $1 AND type = 'REMEMBER_ME_TOKEN'
+const deleteExpiredTokens = `-- name: DeleteExpiredTokens :execrows
+DELETE FROM tokens WHERE expire_at < now()
 `
 
-func (q *Queries) DeleteRememberMeToken(ctx context.Context, userID string) error {
-	_, err := q.db.Exec(ctx, deleteRememberMeToken, userID)
-	return err
+func (q *Queries) DeleteExpiredTokens(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredTokens)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOtherSessions = `-- name: DeleteOtherSessions :many
+DELETE FROM tokens WHERE user_id = $1 AND id <> $2 AND type = 'REMEMBER_ME_TOKEN'
+RETURNING id
+`
+
+type DeleteOtherSessionsParams struct {
+	UserID string `json:"user_id"`
+	ID     string `json:"id"`
+}
+
+func (q *Queries) DeleteOtherSessions(ctx context.Context, arg DeleteOtherSessionsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, deleteOtherSessions, arg.UserID, arg.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteSession = `-- name: DeleteSession :execrows
+DELETE FROM tokens WHERE id = $1 AND user_id = $2 AND type = 'REMEMBER_ME_TOKEN'
+`
+
+type DeleteSessionParams struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) DeleteSession(ctx context.Context, arg DeleteSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSession, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserTokens = `-- name: DeleteUserTokens :many
+DELETE FROM tokens WHERE user_id = $1 AND type = $2
+RETURNING id
+`
+
+type DeleteUserTokensParams struct {
+	UserID string `json:"user_id"`
+	Type   string `json:"type"`
+}
+
+func (q *Queries) DeleteUserTokens(ctx context.Context, arg DeleteUserTokensParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, deleteUserTokens, arg.UserID, arg.Type)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const verifyToken = `-- name: VerifyToken :one
-SELECT id, email, username, password, display_name, avatar, banner, body, about, main_color, links, facts, experience, rpm_id, rpm_token, created_at, updated_at FROM users WHERE id = (SELECT user_id FROM tokens WHERE token = $1)
+const getLastTokenCreation = `-- name: GetLastTokenCreation :one
+SELECT created_at FROM tokens
+WHERE user_id = $1 AND type = $2
+ORDER BY created_at DESC
+LIMIT 1
 `
 
-func (q *Queries) VerifyToken(ctx context.Context, token string) (User, error) {
-	row := q.db.QueryRow(ctx, verifyToken, token)
-	var i User
+type GetLastTokenCreationParams struct {
+	UserID string `json:"user_id"`
+	Type   string `json:"type"`
+}
+
+func (q *Queries) GetLastTokenCreation(ctx context.Context, arg GetLastTokenCreationParams) (time.Time, error) {
+	row := q.db.QueryRow(ctx, getLastTokenCreation, arg.UserID, arg.Type)
+	var created_at time.Time
+	err := row.Scan(&created_at)
+	return created_at, err
+}
+
+const getSession = `-- name: GetSession :one
+SELECT tokens.id, tokens.user_id, tokens.token, tokens.type, tokens.expire_at, tokens.device_name, tokens.ip, tokens.created_at, tokens.last_used_at, tokens.attempts, tokens.previous_token, tokens.rotated_at, users.id, users.email, users.username, users.password, users.display_name, users.avatar, users.banner, users.body, users.about, users.main_color, users.links, users.facts, users.experience, users.rpm_id, users.rpm_token, users.created_at, users.updated_at, users.status, users.custom_status_text, users.custom_status_emoji, users.custom_status_expires_at, users.email_verified, users.totp_secret, users.totp_enabled, users.totp_last_step, users.totp_attempts, users.totp_attempts_reset_at FROM tokens
+JOIN users ON users.id = tokens.user_id
+WHERE (tokens.token = $1 OR (tokens.previous_token = $1 AND tokens.rotated_at > now() - make_interval(secs => $2::int)))
+AND tokens.type = 'REMEMBER_ME_TOKEN' AND tokens.expire_at > now()
+`
+
+type GetSessionParams struct {
+	Token string `json:"token"`
+	Grace int32  `json:"grace"`
+}
+
+type GetSessionRow struct {
+	Token Token `json:"token"`
+	User  User  `json:"user"`
+}
+
+func (q *Queries) GetSession(ctx context.Context, arg GetSessionParams) (GetSessionRow, error) {
+	row := q.db.QueryRow(ctx, getSession, arg.Token, arg.Grace)
+	var i GetSessionRow
 	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.Username,
-		&i.Password,
-		&i.DisplayName,
-		&i.Avatar,
-		&i.Banner,
-		&i.Body,
-		&i.About,
-		&i.MainColor,
-		&i.Links,
-		&i.Facts,
-		&i.Experience,
-		&i.RpmID,
-		&i.RpmToken,
-		&i.CreatedAt,
-		&i.UpdatedAt,
+		&i.Token.ID,
+		&i.Token.UserID,
+		&i.Token.Token,
+		&i.Token.Type,
+		&i.Token.ExpireAt,
+		&i.Token.DeviceName,
+		&i.Token.Ip,
+		&i.Token.CreatedAt,
+		&i.Token.LastUsedAt,
+		&i.Token.Attempts,
+		&i.Token.PreviousToken,
+		&i.Token.RotatedAt,
+		&i.User.ID,
+		&i.User.Email,
+		&i.User.Username,
+		&i.User.Password,
+		&i.User.DisplayName,
+		&i.User.Avatar,
+		&i.User.Banner,
+		&i.User.Body,
+		&i.User.About,
+		&i.User.MainColor,
+		&i.User.Links,
+		&i.User.Facts,
+		&i.User.Experience,
+		&i.User.RpmID,
+		&i.User.RpmToken,
+		&i.User.CreatedAt,
+		&i.User.UpdatedAt,
+		&i.User.Status,
+		&i.User.CustomStatusText,
+		&i.User.CustomStatusEmoji,
+		&i.User.CustomStatusExpiresAt,
+		&i.User.EmailVerified,
+		&i.User.TotpSecret,
+		&i.User.TotpEnabled,
+		&i.User.TotpLastStep,
+		&i.User.TotpAttempts,
+		&i.User.TotpAttemptsResetAt,
 	)
 	return i, err
 }
+
+const getSessions = `-- name: GetSessions :many
+SELECT id, device_name, ip, created_at, last_used_at, expire_at FROM tokens
+WHERE user_id = $1 AND type = 'REMEMBER_ME_TOKEN' AND expire_at > now()
+ORDER BY last_used_at DESC
+`
+
+type GetSessionsRow struct {
+	ID         string    `json:"id"`
+	DeviceName string    `json:"device_name"`
+	Ip         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpireAt   time.Time `json:"expire_at"`
+}
+
+func (q *Queries) GetSessions(ctx context.Context, userID string) ([]GetSessionsRow, error) {
+	rows, err := q.db.Query(ctx, getSessions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSessionsRow
+	for rows.Next() {
+		var i GetSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DeviceName,
+			&i.Ip,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpireAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSessionsExpiry = `-- name: GetSessionsExpiry :many
+SELECT id, expire_at FROM tokens
+WHERE id = ANY($1::text[]) AND type = 'REMEMBER_ME_TOKEN'
+`
+
+type GetSessionsExpiryRow struct {
+	ID       string    `json:"id"`
+	ExpireAt time.Time `json:"expire_at"`
+}
+
+func (q *Queries) GetSessionsExpiry(ctx context.Context, ids []string) ([]GetSessionsExpiryRow, error) {
+	rows, err := q.db.Query(ctx, getSessionsExpiry, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSessionsExpiryRow
+	for rows.Next() {
+		var i GetSessionsExpiryRow
+		if err := rows.Scan(&i.ID, &i.ExpireAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rotateToken = `-- name: RotateToken :execrows
+UPDATE tokens SET previous_token = token, rotated_at = now(), token = $1, expire_at = $2, last_used_at = now()
+WHERE id = $3 AND token = $4
+`
+
+type RotateTokenParams struct {
+	NewToken string    `json:"new_token"`
+	ExpireAt time.Time `json:"expire_at"`
+	ID       string    `json:"id"`
+	Token    string    `json:"token"`
+}
+
+func (q *Queries) RotateToken(ctx context.Context, arg RotateTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, rotateToken,
+		arg.NewToken,
+		arg.ExpireAt,
+		arg.ID,
+		arg.Token,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchSession = `-- name: TouchSession :exec
+UPDATE tokens SET last_used_at = now() WHERE id = $1
+`
+
+func (q *Queries) TouchSession(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, touchSession, id)
+	return err
+}
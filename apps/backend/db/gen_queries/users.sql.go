@@ -12,6 +12,27 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const attemptUserTOTP = `-- name: AttemptUserTOTP :execrows
+UPDATE users
+  set totp_attempts = CASE WHEN totp_attempts_reset_at <= now() THEN 1 ELSE totp_attempts + 1 END,
+    totp_attempts_reset_at = CASE WHEN totp_attempts_reset_at <= now() THEN now() + make_interval(secs => $1::int) ELSE totp_attempts_reset_at END
+WHERE id = $2 AND (totp_attempts_reset_at <= now() OR totp_attempts < $3::int)
+`
+
+type AttemptUserTOTPParams struct {
+	WindowSeconds int32  `json:"window_seconds"`
+	ID            string `json:"id"`
+	MaxAttempts   int32  `json:"max_attempts"`
+}
+
+func (q *Queries) AttemptUserTOTP(ctx context.Context, arg AttemptUserTOTPParams) (int64, error) {
+	result, err := q.db.Exec(ctx, attemptUserTOTP, arg.WindowSeconds, arg.ID, arg.MaxAttempts)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 type CreateEmojiParams struct {
 	ID        string `json:"id"`
 	UserID    string `json:"user_id"`
@@ -25,7 +46,7 @@ INSERT INTO users (
 ) VALUES (
   $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
-RETURNING id, email, username, password, display_name, avatar, banner, body, about, main_color, links, facts, experience, rpm_id, rpm_token, created_at, updated_at
+RETURNING id, email, username, password, display_name, avatar, banner, body, about, main_color, links, facts, experience, rpm_id, rpm_token, created_at, updated_at, status, custom_status_text, custom_status_emoji, custom_status_expires_at, email_verified, totp_secret, totp_enabled, totp_last_step, totp_attempts, totp_attempts_reset_at
 `
 
 type CreateUserParams struct {
@@ -75,6 +96,16 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.RpmToken,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.CustomStatusText,
+		&i.CustomStatusEmoji,
+		&i.CustomStatusExpiresAt,
+		&i.EmailVerified,
+		&i.TotpSecret,
+		&i.TotpEnabled,
+		&i.TotpLastStep,
+		&i.TotpAttempts,
+		&i.TotpAttemptsResetAt,
 	)
 	return i, err
 }
@@ -102,6 +133,42 @@ func (q *Queries) DeleteUser(ctx context.Context, id string) error {
 	return err
 }
 
+const enableUserTOTP = `-- name: EnableUserTOTP :execrows
+UPDATE users
+  set totp_enabled = true, totp_last_step = $2
+WHERE id = $1 AND totp_enabled = false
+`
+
+type EnableUserTOTPParams struct {
+	ID           string `json:"id"`
+	TotpLastStep int64  `json:"totp_last_step"`
+}
+
+func (q *Queries) EnableUserTOTP(ctx context.Context, arg EnableUserTOTPParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enableUserTOTP, arg.ID, arg.TotpLastStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getEmoji = `-- name: GetEmoji :one
+SELECT id, url, shortcode FROM emojis WHERE id = $1
+`
+
+type GetEmojiRow struct {
+	ID        string `json:"id"`
+	Url       string `json:"url"`
+	Shortcode string `json:"shortcode"`
+}
+
+func (q *Queries) GetEmoji(ctx context.Context, id string) (GetEmojiRow, error) {
+	row := q.db.QueryRow(ctx, getEmoji, id)
+	var i GetEmojiRow
+	err := row.Scan(&i.ID, &i.Url, &i.Shortcode)
+	return i, err
+}
+
 const getEmojis = `-- name: GetEmojis :many
 SELECT id, url, shortcode FROM emojis WHERE user_id = $1
 `
@@ -133,7 +200,7 @@ func (q *Queries) GetEmojis(ctx context.Context, userID string) ([]GetEmojisRow,
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, email, username, password, display_name, avatar, banner, body, about, main_color, links, facts, experience, rpm_id, rpm_token, created_at, updated_at FROM users WHERE email = $1 OR username = $2
+SELECT id, email, username, password, display_name, avatar, banner, body, about, main_color, links, facts, experience, rpm_id, rpm_token, created_at, updated_at, status, custom_status_text, custom_status_emoji, custom_status_expires_at, email_verified, totp_secret, totp_enabled, totp_last_step, totp_attempts, totp_attempts_reset_at FROM users WHERE email = $1 OR username = $2
 `
 
 type GetUserParams struct {
@@ -162,12 +229,22 @@ func (q *Queries) GetUser(ctx context.Context, arg GetUserParams) (User, error)
 		&i.RpmToken,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.CustomStatusText,
+		&i.CustomStatusEmoji,
+		&i.CustomStatusExpiresAt,
+		&i.EmailVerified,
+		&i.TotpSecret,
+		&i.TotpEnabled,
+		&i.TotpLastStep,
+		&i.TotpAttempts,
+		&i.TotpAttemptsResetAt,
 	)
 	return i, err
 }
 
 const getUserById = `-- name: GetUserById :one
-SELECT id, email, username, password, display_name, avatar, banner, body, about, main_color, links, facts, experience, rpm_id, rpm_token, created_at, updated_at FROM users WHERE id = $1
+SELECT id, email, username, password, display_name, avatar, banner, body, about, main_color, links, facts, experience, rpm_id, rpm_token, created_at, updated_at, status, custom_status_text, custom_status_emoji, custom_status_expires_at, email_verified, totp_secret, totp_enabled, totp_last_step, totp_attempts, totp_attempts_reset_at FROM users WHERE id = $1
 `
 
 func (q *Queries) GetUserById(ctx context.Context, id string) (User, error) {
@@ -191,6 +268,16 @@ func (q *Queries) GetUserById(ctx context.Context, id string) (User, error) {
 		&i.RpmToken,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.CustomStatusText,
+		&i.CustomStatusEmoji,
+		&i.CustomStatusExpiresAt,
+		&i.EmailVerified,
+		&i.TotpSecret,
+		&i.TotpEnabled,
+		&i.TotpLastStep,
+		&i.TotpAttempts,
+		&i.TotpAttemptsResetAt,
 	)
 	return i, err
 }
@@ -218,6 +305,31 @@ func (q *Queries) GetUserMinimal(ctx context.Context, id string) (GetUserMinimal
 	return i, err
 }
 
+const getUserPresence = `-- name: GetUserPresence :one
+SELECT id, status, custom_status_text, custom_status_emoji, custom_status_expires_at FROM users WHERE id = $1
+`
+
+type GetUserPresenceRow struct {
+	ID                    string             `json:"id"`
+	Status                UserStatus         `json:"status"`
+	CustomStatusText      pgtype.Text        `json:"custom_status_text"`
+	CustomStatusEmoji     pgtype.Text        `json:"custom_status_emoji"`
+	CustomStatusExpiresAt pgtype.Timestamptz `json:"custom_status_expires_at"`
+}
+
+func (q *Queries) GetUserPresence(ctx context.Context, id string) (GetUserPresenceRow, error) {
+	row := q.db.QueryRow(ctx, getUserPresence, id)
+	var i GetUserPresenceRow
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.CustomStatusText,
+		&i.CustomStatusEmoji,
+		&i.CustomStatusExpiresAt,
+	)
+	return i, err
+}
+
 const getUsersByIds = `-- name: GetUsersByIds :many
 SELECT id, username, display_name, avatar FROM users WHERE id = ANY($1::text[])
 `
@@ -254,6 +366,36 @@ func (q *Queries) GetUsersByIds(ctx context.Context, dollar_1 []string) ([]GetUs
 	return items, nil
 }
 
+const resetUserTOTPAttempts = `-- name: ResetUserTOTPAttempts :exec
+UPDATE users
+  set totp_attempts = 0
+WHERE id = $1
+`
+
+func (q *Queries) ResetUserTOTPAttempts(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, resetUserTOTPAttempts, id)
+	return err
+}
+
+const setUserTOTPSecret = `-- name: SetUserTOTPSecret :execrows
+UPDATE users
+  set totp_secret = $2
+WHERE id = $1 AND totp_enabled = false
+`
+
+type SetUserTOTPSecretParams struct {
+	ID         string      `json:"id"`
+	TotpSecret pgtype.Text `json:"totp_secret"`
+}
+
+func (q *Queries) SetUserTOTPSecret(ctx context.Context, arg SetUserTOTPSecretParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserTOTPSecret, arg.ID, arg.TotpSecret)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateEmoji = `-- name: UpdateEmoji :exec
 UPDATE emojis SET shortcode = $1 WHERE user_id = $2 AND id = $3
 `
@@ -326,7 +468,7 @@ func (q *Queries) UpdateUserDisplayName(ctx context.Context, arg UpdateUserDispl
 
 const updateUserEmail = `-- name: UpdateUserEmail :exec
 UPDATE users
-  set email = $2
+  set email = $2, email_verified = false
 WHERE id = $1
 `
 
@@ -388,6 +530,31 @@ func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPassword
 	return err
 }
 
+const updateUserPresence = `-- name: UpdateUserPresence :exec
+UPDATE users
+  set status = $2, custom_status_text = $3, custom_status_emoji = $4, custom_status_expires_at = $5
+WHERE id = $1
+`
+
+type UpdateUserPresenceParams struct {
+	ID                    string             `json:"id"`
+	Status                UserStatus         `json:"status"`
+	CustomStatusText      pgtype.Text        `json:"custom_status_text"`
+	CustomStatusEmoji     pgtype.Text        `json:"custom_status_emoji"`
+	CustomStatusExpiresAt pgtype.Timestamptz `json:"custom_status_expires_at"`
+}
+
+func (q *Queries) UpdateUserPresence(ctx context.Context, arg UpdateUserPresenceParams) error {
+	_, err := q.db.Exec(ctx, updateUserPresence,
+		arg.ID,
+		arg.Status,
+		arg.CustomStatusText,
+		arg.CustomStatusEmoji,
+		arg.CustomStatusExpiresAt,
+	)
+	return err
+}
+
 const updateUserUsername = `-- name: UpdateUserUsername :execresult
 UPDATE users
   set username = $2
@@ -402,3 +569,33 @@ type UpdateUserUsernameParams struct {
 func (q *Queries) UpdateUserUsername(ctx context.Context, arg UpdateUserUsernameParams) (pgconn.CommandTag, error) {
 	return q.db.Exec(ctx, updateUserUsername, arg.ID, arg.Username)
 }
+
+const useUserTOTPStep = `-- name: UseUserTOTPStep :execrows
+UPDATE users
+  set totp_last_step = $2
+WHERE id = $1 AND totp_last_step < $2
+`
+
+type UseUserTOTPStepParams struct {
+	ID           string `json:"id"`
+	TotpLastStep int64  `json:"totp_last_step"`
+}
+
+func (q *Queries) UseUserTOTPStep(ctx context.Context, arg UseUserTOTPStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useUserTOTPStep, arg.ID, arg.TotpLastStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const verifyUserEmail = `-- name: VerifyUserEmail :exec
+UPDATE users
+  set email_verified = true
+WHERE id = $1
+`
+
+func (q *Queries) VerifyUserEmail(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, verifyUserEmail, id)
+	return err
+}
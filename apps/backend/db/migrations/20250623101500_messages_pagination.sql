@@ -0,0 +1,5 @@
+-- migrate:up
+CREATE INDEX idx_messages_channel_id_id ON messages(channel_id, id);
+
+-- migrate:down
+DROP INDEX idx_messages_channel_id_id;
@@ -0,0 +1,18 @@
+-- migrate:up
+CREATE TYPE overwrite_type AS ENUM ('role', 'user');
+
+CREATE TABLE channel_overwrites(
+  id VARCHAR(20) PRIMARY KEY,
+  channel_id VARCHAR(20) NOT NULL REFERENCES channels(id) ON DELETE CASCADE,
+  target_id VARCHAR(20) NOT NULL,
+  type overwrite_type NOT NULL,
+  allow VARCHAR(255) ARRAY NOT NULL DEFAULT '{}',
+  deny VARCHAR(255) ARRAY NOT NULL DEFAULT '{}',
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  UNIQUE(channel_id, target_id)
+);
+
+-- migrate:down
+DROP TABLE channel_overwrites;
+DROP TYPE overwrite_type;
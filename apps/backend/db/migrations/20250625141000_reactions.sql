@@ -0,0 +1,17 @@
+-- migrate:up
+CREATE TABLE reactions(
+  id VARCHAR(20) PRIMARY KEY,
+  message_id VARCHAR(20) NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+  user_id VARCHAR(20) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  emoji VARCHAR(255),
+  emoji_id VARCHAR(20) REFERENCES emojis(id) ON DELETE CASCADE,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  CHECK ((emoji IS NULL) <> (emoji_id IS NULL))
+);
+
+CREATE INDEX idx_reactions_message_id ON reactions(message_id);
+CREATE UNIQUE INDEX idx_reactions_message_user_emoji ON reactions(message_id, user_id, emoji) WHERE emoji IS NOT NULL;
+CREATE UNIQUE INDEX idx_reactions_message_user_emoji_id ON reactions(message_id, user_id, emoji_id) WHERE emoji_id IS NOT NULL;
+
+-- migrate:down
+DROP TABLE reactions;
@@ -0,0 +1,22 @@
+-- migrate:up
+ALTER TABLE messages ADD COLUMN reply_to VARCHAR(20) REFERENCES messages(id) ON DELETE SET NULL;
+
+CREATE TABLE threads(
+  id VARCHAR(20) PRIMARY KEY REFERENCES messages(id) ON DELETE CASCADE,
+  server_id VARCHAR(20) NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  channel_id VARCHAR(20) NOT NULL REFERENCES channels(id) ON DELETE CASCADE,
+  creator_id VARCHAR(20) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+ALTER TABLE messages ADD COLUMN thread_id VARCHAR(20) REFERENCES threads(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_messages_thread_id_id ON messages(thread_id, id);
+CREATE INDEX idx_threads_channel_id ON threads(channel_id);
+
+-- migrate:down
+DROP INDEX idx_messages_thread_id_id;
+ALTER TABLE messages DROP COLUMN thread_id;
+DROP TABLE threads;
+ALTER TABLE messages DROP COLUMN reply_to;
@@ -0,0 +1,17 @@
+-- migrate:up
+CREATE FUNCTION message_plain_text(content JSONB) RETURNS TEXT
+  LANGUAGE sql IMMUTABLE
+  AS $$
+    SELECT coalesce(string_agg(t #>> '{}', ' '), '')
+    FROM jsonb_path_query(content, 'strict $.**.text') AS t
+    WHERE jsonb_typeof(t) = 'string'
+  $$;
+
+ALTER TABLE messages ADD COLUMN plain_text TEXT GENERATED ALWAYS AS (message_plain_text(content)) STORED;
+
+CREATE INDEX idx_messages_search ON messages USING GIN (to_tsvector('simple', plain_text));
+
+-- migrate:down
+DROP INDEX idx_messages_search;
+ALTER TABLE messages DROP COLUMN plain_text;
+DROP FUNCTION message_plain_text(JSONB);
@@ -0,0 +1,17 @@
+-- migrate:up
+CREATE TABLE bans(
+  id VARCHAR(20) PRIMARY KEY,
+  server_id VARCHAR(20) NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  user_id VARCHAR(20) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  moderator_id VARCHAR(20) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  reason VARCHAR(512) NOT NULL DEFAULT '',
+  expires_at TIMESTAMP WITH TIME ZONE,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  UNIQUE(server_id, user_id)
+);
+
+ALTER TABLE server_membership ADD COLUMN timeout_until TIMESTAMP WITH TIME ZONE;
+
+-- migrate:down
+ALTER TABLE server_membership DROP COLUMN timeout_until;
+DROP TABLE bans;
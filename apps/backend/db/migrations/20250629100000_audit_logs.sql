@@ -0,0 +1,34 @@
+-- migrate:up
+CREATE TABLE audit_logs(
+  id VARCHAR(20) PRIMARY KEY,
+  server_id VARCHAR(20) NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  actor_id VARCHAR(20) NOT NULL,
+  action VARCHAR(50) NOT NULL,
+  target_type VARCHAR(20) NOT NULL,
+  target_id VARCHAR(20) NOT NULL,
+  changes JSONB DEFAULT '{}' NOT NULL,
+  reason VARCHAR(512) NOT NULL DEFAULT '',
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX idx_audit_logs_server_id_id ON audit_logs(server_id, id);
+
+-- entries can only go away with their server
+CREATE FUNCTION audit_logs_append_only() RETURNS TRIGGER
+  LANGUAGE plpgsql
+  AS $$
+    BEGIN
+      IF TG_OP = 'DELETE' AND pg_trigger_depth() > 1 THEN
+        RETURN OLD;
+      END IF;
+      RAISE EXCEPTION 'audit_logs is append-only';
+    END;
+  $$;
+
+CREATE TRIGGER audit_logs_append_only BEFORE UPDATE OR DELETE ON audit_logs
+  FOR EACH ROW EXECUTE FUNCTION audit_logs_append_only();
+
+-- migrate:down
+DROP TRIGGER audit_logs_append_only ON audit_logs;
+DROP FUNCTION audit_logs_append_only();
+DROP TABLE audit_logs;
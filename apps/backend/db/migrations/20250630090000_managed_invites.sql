@@ -0,0 +1,32 @@
+-- migrate:up
+ALTER TABLE invites
+  ADD COLUMN creator_id VARCHAR(20) REFERENCES users(id) ON DELETE SET NULL,
+  ADD COLUMN max_uses INTEGER DEFAULT 0 NOT NULL,
+  ADD COLUMN uses INTEGER DEFAULT 0 NOT NULL,
+  ADD COLUMN vanity BOOLEAN DEFAULT false NOT NULL,
+  ADD COLUMN revoked_at TIMESTAMP WITH TIME ZONE,
+  ADD COLUMN created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  ALTER COLUMN expire_at DROP NOT NULL,
+  ALTER COLUMN expire_at DROP DEFAULT;
+
+DROP INDEX idx_invites_invite_id;
+CREATE UNIQUE INDEX idx_invites_invite_id ON invites(invite_id);
+CREATE UNIQUE INDEX idx_invites_server_id_vanity ON invites(server_id) WHERE vanity;
+CREATE INDEX idx_invites_server_id ON invites(server_id);
+
+-- migrate:down
+DROP INDEX idx_invites_server_id;
+DROP INDEX idx_invites_server_id_vanity;
+DROP INDEX idx_invites_invite_id;
+CREATE INDEX idx_invites_invite_id ON invites(invite_id);
+
+DELETE FROM invites WHERE expire_at IS NULL;
+ALTER TABLE invites
+  ALTER COLUMN expire_at SET DEFAULT NOW(),
+  ALTER COLUMN expire_at SET NOT NULL,
+  DROP COLUMN created_at,
+  DROP COLUMN revoked_at,
+  DROP COLUMN vanity,
+  DROP COLUMN uses,
+  DROP COLUMN max_uses,
+  DROP COLUMN creator_id;
@@ -0,0 +1,14 @@
+-- migrate:up
+CREATE TABLE join_requests(
+  id VARCHAR(20) PRIMARY KEY,
+  server_id VARCHAR(20) NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  user_id VARCHAR(20) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  invite_id VARCHAR(255) NOT NULL,
+  x INTEGER NOT NULL,
+  y INTEGER NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  UNIQUE(server_id, user_id)
+);
+
+-- migrate:down
+DROP TABLE join_requests;
@@ -0,0 +1,15 @@
+-- migrate:up
+CREATE TYPE user_status AS ENUM ('online', 'idle', 'dnd', 'invisible');
+
+ALTER TABLE users ADD COLUMN status user_status DEFAULT 'online' NOT NULL;
+ALTER TABLE users ADD COLUMN custom_status_text VARCHAR(128);
+ALTER TABLE users ADD COLUMN custom_status_emoji VARCHAR(64);
+ALTER TABLE users ADD COLUMN custom_status_expires_at TIMESTAMP WITH TIME ZONE;
+
+-- migrate:down
+ALTER TABLE users DROP COLUMN custom_status_expires_at;
+ALTER TABLE users DROP COLUMN custom_status_emoji;
+ALTER TABLE users DROP COLUMN custom_status_text;
+ALTER TABLE users DROP COLUMN status;
+
+DROP TYPE user_status;
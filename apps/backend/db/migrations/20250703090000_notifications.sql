@@ -0,0 +1,21 @@
+-- migrate:up
+ALTER TABLE messages ADD COLUMN mentions_roles VARCHAR(20)[];
+
+CREATE TABLE notifications(
+  id VARCHAR(20) PRIMARY KEY,
+  user_id VARCHAR(20) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  message_id VARCHAR(20) NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+  server_id VARCHAR(20) NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  channel_id VARCHAR(20) NOT NULL REFERENCES channels(id) ON DELETE CASCADE,
+  author_id VARCHAR(20) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  type VARCHAR(16) NOT NULL,
+  read_at TIMESTAMP WITH TIME ZONE,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  UNIQUE(user_id, message_id)
+);
+
+CREATE INDEX idx_notifications_user_id_id ON notifications(user_id, id);
+
+-- migrate:down
+DROP TABLE notifications;
+ALTER TABLE messages DROP COLUMN mentions_roles;
@@ -0,0 +1,19 @@
+-- migrate:up
+CREATE TYPE notification_level AS ENUM ('all', 'mentions', 'nothing');
+
+CREATE TABLE notification_settings(
+  user_id VARCHAR(20) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  server_id VARCHAR(20) NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  channel_id VARCHAR(20) REFERENCES channels(id) ON DELETE CASCADE,
+  level notification_level,
+  muted_until TIMESTAMP WITH TIME ZONE,
+  suppress_everyone BOOLEAN DEFAULT FALSE NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_notification_settings_server ON notification_settings(user_id, server_id) WHERE channel_id IS NULL;
+CREATE UNIQUE INDEX idx_notification_settings_channel ON notification_settings(user_id, channel_id) WHERE channel_id IS NOT NULL;
+
+-- migrate:down
+DROP TABLE notification_settings;
+DROP TYPE notification_level;
@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TYPE channel_type ADD VALUE IF NOT EXISTS 'stage';
+ALTER TABLE channels ADD COLUMN user_limit INTEGER CHECK (user_limit > 0);
+
+-- migrate:down
+-- postgres cannot drop a value from an enum, 'stage' stays
+ALTER TABLE channels DROP COLUMN user_limit;
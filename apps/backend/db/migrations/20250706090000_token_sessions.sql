@@ -0,0 +1,17 @@
+-- migrate:up
+ALTER TABLE tokens
+  ADD COLUMN device_name VARCHAR(255) DEFAULT '' NOT NULL,
+  ADD COLUMN ip VARCHAR(45) DEFAULT '' NOT NULL,
+  ADD COLUMN created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  ADD COLUMN last_used_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL;
+
+CREATE INDEX idx_tokens_user_id ON tokens(user_id);
+
+-- migrate:down
+DROP INDEX idx_tokens_user_id;
+
+ALTER TABLE tokens
+  DROP COLUMN device_name,
+  DROP COLUMN ip,
+  DROP COLUMN created_at,
+  DROP COLUMN last_used_at;
@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE users ADD COLUMN email_verified BOOLEAN DEFAULT false NOT NULL;
+
+-- migrate:down
+ALTER TABLE users DROP COLUMN email_verified;
@@ -0,0 +1,26 @@
+-- migrate:up
+ALTER TABLE users
+  ADD COLUMN totp_secret VARCHAR(64),
+  ADD COLUMN totp_enabled BOOLEAN DEFAULT false NOT NULL,
+  ADD COLUMN totp_last_step BIGINT DEFAULT 0 NOT NULL;
+
+ALTER TABLE tokens ADD COLUMN attempts INTEGER DEFAULT 0 NOT NULL;
+
+CREATE TABLE recovery_codes(
+  id VARCHAR(20) PRIMARY KEY,
+  user_id VARCHAR(20) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  code_hash VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX idx_recovery_codes_user_id ON recovery_codes(user_id);
+
+-- migrate:down
+DROP TABLE recovery_codes;
+
+ALTER TABLE tokens DROP COLUMN attempts;
+
+ALTER TABLE users
+  DROP COLUMN totp_secret,
+  DROP COLUMN totp_enabled,
+  DROP COLUMN totp_last_step;
@@ -0,0 +1,9 @@
+-- migrate:up
+ALTER TABLE users
+  ADD COLUMN totp_attempts INTEGER DEFAULT 0 NOT NULL,
+  ADD COLUMN totp_attempts_reset_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL;
+
+-- migrate:down
+ALTER TABLE users
+  DROP COLUMN totp_attempts,
+  DROP COLUMN totp_attempts_reset_at;
@@ -0,0 +1,13 @@
+-- migrate:up
+ALTER TABLE tokens
+  ADD COLUMN previous_token TEXT,
+  ADD COLUMN rotated_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_tokens_previous_token ON tokens(previous_token);
+
+-- migrate:down
+DROP INDEX idx_tokens_previous_token;
+
+ALTER TABLE tokens
+  DROP COLUMN previous_token,
+  DROP COLUMN rotated_at;
@@ -0,0 +1,16 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (
+  id, server_id, actor_id, action, target_type, target_id, changes, reason
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: GetAuditLogs :many
+SELECT * FROM audit_logs
+WHERE server_id = @server_id
+  AND (sqlc.narg(action)::text IS NULL OR action = sqlc.narg(action))
+  AND (sqlc.narg(actor_id)::text IS NULL OR actor_id = sqlc.narg(actor_id))
+  AND (sqlc.narg(target_id)::text IS NULL OR target_id = sqlc.narg(target_id))
+  AND (sqlc.narg(before)::text IS NULL OR id < sqlc.narg(before))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
@@ -18,20 +18,23 @@ WHERE server_id = ANY($1::text[]) AND active = true;
 
 -- name: CreateChannel :one
 INSERT INTO channels (
-  id, server_id, name, type, description, users, roles, x, y
+  id, server_id, name, type, description, users, roles, x, y, user_limit
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 )
 RETURNING *;
 
 -- name: UpdateChannelName :exec
-UPDATE channels SET name = $1 WHERE id = $2;
+UPDATE channels SET name = $1 WHERE id = $2 AND server_id = $3;
 
 -- name: UpdateChannelDescription :exec
-UPDATE channels SET description = $1 WHERE id = $2;
+UPDATE channels SET description = $1 WHERE id = $2 AND server_id = $3;
+
+-- name: UpdateChannelUserLimit :exec
+UPDATE channels SET user_limit = $1 WHERE id = $2 AND server_id = $3;
 
 -- name: DeleteChannel :exec
-DELETE FROM channels WHERE id = $1;
+DELETE FROM channels WHERE id = $1 AND server_id = $2;
 
 -- name: DeactivateChannel :one
 UPDATE channels SET active = false
@@ -40,3 +43,22 @@ WHERE type = 'dm'
   AND $1::varchar = ANY(users) 
   AND $2::varchar = ANY(users)
 RETURNING *;
+
+-- name: GetChannelOverwrites :many
+SELECT * FROM channel_overwrites WHERE channel_id = $1;
+
+-- name: GetChannelsOverwrites :many
+SELECT * FROM channel_overwrites WHERE channel_id = ANY($1::text[]);
+
+-- name: UpsertChannelOverwrite :one
+INSERT INTO channel_overwrites (
+  id, channel_id, target_id, type, allow, deny
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (channel_id, target_id) DO UPDATE
+SET type = EXCLUDED.type, allow = EXCLUDED.allow, deny = EXCLUDED.deny, updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteChannelOverwrite :execresult
+DELETE FROM channel_overwrites WHERE channel_id = $1 AND target_id = $2;
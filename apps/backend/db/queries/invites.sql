@@ -1,10 +1,59 @@
 -- name: CreateInvite :one
 INSERT INTO invites (
-  id, server_id, invite_id, expire_at
+  id, server_id, invite_id, expire_at, creator_id, max_uses
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5, $6
 )
-RETURNING invite_id;
+RETURNING *;
+
+-- Vanity codes are stored lowercased and match whatever the case of the
+-- link, generated codes are case-sensitive.
 
 -- name: CheckInvite :one
-SELECT server_id FROM invites WHERE invite_id = $1 AND expire_at >= NOW();
+SELECT server_id FROM invites
+WHERE (invite_id = $1 OR (vanity AND invite_id = lower($1)))
+  AND revoked_at IS NULL
+  AND (expire_at IS NULL OR expire_at >= NOW())
+  AND (max_uses = 0 OR uses < max_uses);
+
+-- name: UseInvite :execresult
+UPDATE invites SET uses = uses + 1
+WHERE (invite_id = $1 OR (vanity AND invite_id = lower($1)))
+  AND revoked_at IS NULL
+  AND (expire_at IS NULL OR expire_at >= NOW())
+  AND (max_uses = 0 OR uses < max_uses);
+
+-- name: GetInvite :one
+SELECT * FROM invites WHERE invite_id = $1;
+
+-- name: IsInviteCodeTaken :one
+SELECT EXISTS(
+  SELECT 1 FROM invites
+  WHERE lower(invite_id) = lower(@code) AND NOT (server_id = @server_id AND vanity)
+);
+
+-- name: GetActiveInvites :many
+SELECT * FROM invites
+WHERE server_id = $1
+  AND revoked_at IS NULL
+  AND (expire_at IS NULL OR expire_at >= NOW())
+  AND (max_uses = 0 OR uses < max_uses)
+ORDER BY vanity DESC, created_at DESC;
+
+-- name: RevokeInvite :execresult
+UPDATE invites SET revoked_at = NOW()
+WHERE invite_id = $1 AND server_id = $2 AND revoked_at IS NULL;
+
+-- name: GetVanityInvite :one
+SELECT * FROM invites WHERE server_id = $1 AND vanity;
+
+-- name: DeleteVanityInvite :execresult
+DELETE FROM invites WHERE server_id = $1 AND vanity;
+
+-- name: CreateVanityInvite :one
+INSERT INTO invites (
+  id, server_id, invite_id, creator_id, vanity
+) VALUES (
+  $1, $2, $3, $4, true
+)
+RETURNING *;
@@ -0,0 +1,25 @@
+-- name: CreateJoinRequest :one
+INSERT INTO join_requests (
+  id, server_id, user_id, invite_id, x, y
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (server_id, user_id)
+DO UPDATE SET
+    invite_id = EXCLUDED.invite_id,
+    x = EXCLUDED.x,
+    y = EXCLUDED.y
+RETURNING *;
+
+-- name: GetJoinRequest :one
+SELECT * FROM join_requests WHERE id = $1;
+
+-- name: GetJoinRequests :many
+SELECT jr.id, jr.user_id, jr.created_at, u.username, u.display_name, u.avatar
+FROM join_requests jr
+JOIN users u ON u.id = jr.user_id
+WHERE jr.server_id = $1
+ORDER BY jr.created_at;
+
+-- name: DeleteJoinRequest :execresult
+DELETE FROM join_requests WHERE id = $1 AND server_id = $2;
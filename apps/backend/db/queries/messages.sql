@@ -1,23 +1,58 @@
 -- name: GetMessage :one
 SELECT * FROM messages WHERE id = $1;
 
--- name: GetMessagesFromChannel :many
-SELECT * FROM messages WHERE channel_id = $1;
+-- name: GetMessagesByIds :many
+SELECT * FROM messages WHERE id = ANY($1::text[]);
 
--- name: CheckChannelMembership :execresult
-SELECT c.id FROM channels c, server_membership sm WHERE c.id = $1 and c.server_id = sm.server_id and sm.user_id = $2;
+-- name: GetLatestMessagesFromChannel :many
+SELECT * FROM messages
+WHERE channel_id = $1 AND thread_id IS NOT DISTINCT FROM sqlc.narg(thread_id)
+ORDER BY id DESC
+LIMIT $2;
+
+-- name: GetMessagesBefore :many
+SELECT * FROM messages
+WHERE channel_id = $1 AND id < $2 AND thread_id IS NOT DISTINCT FROM sqlc.narg(thread_id)
+ORDER BY id DESC
+LIMIT $3;
+
+-- name: GetMessagesAfter :many
+SELECT * FROM messages
+WHERE channel_id = $1 AND id > $2 AND thread_id IS NOT DISTINCT FROM sqlc.narg(thread_id)
+ORDER BY id ASC
+LIMIT $3;
+
+-- name: GetMessagesAround :many
+SELECT * FROM messages
+WHERE channel_id = $1 AND id >= $2 AND thread_id IS NOT DISTINCT FROM sqlc.narg(thread_id)
+ORDER BY id ASC
+LIMIT $3;
+
+-- name: HasMessagesUntil :one
+SELECT EXISTS(
+  SELECT 1 FROM messages
+  WHERE channel_id = $1 AND id <= $2 AND thread_id IS NOT DISTINCT FROM sqlc.narg(thread_id)
+);
+
+-- name: HasMessagesSince :one
+SELECT EXISTS(
+  SELECT 1 FROM messages
+  WHERE channel_id = $1 AND id >= $2 AND thread_id IS NOT DISTINCT FROM sqlc.narg(thread_id)
+);
 
 -- name: GetLatestMessagesSent :many
-SELECT m.id, m.channel_id FROM messages m WHERE channel_id = ANY($1::text[]) ORDER BY created_at DESC LIMIT 1;
+SELECT DISTINCT ON (m.channel_id) m.id, m.channel_id FROM messages m
+WHERE m.channel_id = ANY($1::text[]) AND m.thread_id IS NULL
+ORDER BY m.channel_id, m.id DESC;
 
 -- name: GetLatestMessagesRead :many
 SELECT channel_id, last_read_message_id, unread_mention_ids FROM user_channel_read_state WHERE user_id = $1;
 
 -- name: CreateMessage :one
 INSERT INTO messages (
-  id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments
+  id, author_id, server_id, channel_id, content, everyone, mentions_users, mentions_channels, attachments, reply_to, thread_id, mentions_roles
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 )
 RETURNING *;
 
@@ -32,7 +67,7 @@ DO UPDATE SET
 
 -- name: UpdateMessage :execresult
 UPDATE messages 
-SET content = $1, mentions_users = $2, mentions_channels = $3, everyone = $4, updated_at = now()
+SET content = $1, mentions_users = $2, mentions_channels = $3, everyone = $4, mentions_roles = $7, updated_at = now()
 WHERE id = $5 AND author_id = $6;
 
 -- name: UpdateMessageContent :execresult
@@ -45,4 +80,47 @@ UPDATE messages SET mentions_users = $1 WHERE id = $2 AND author_id = $3;
 UPDATE messages SET mentions_channels = $1 WHERE id = $2 AND author_id = $3;
 
 -- name: DeleteMessage :execresult
-DELETE FROM messages WHERE id = $1 AND author_id = $2;
+DELETE FROM messages WHERE id = $1 AND channel_id = $2;
+
+-- name: SearchMessages :many
+SELECT * FROM messages
+WHERE channel_id = ANY(@channel_ids::text[])
+  AND to_tsvector('simple', plain_text) @@ websearch_to_tsquery('simple', @query::text)
+  AND (sqlc.narg(author_id)::text IS NULL OR author_id = sqlc.narg(author_id))
+  AND (sqlc.narg(mentions)::text IS NULL OR sqlc.narg(mentions) = ANY(mentions_users))
+  AND (sqlc.narg(has_attachment)::boolean IS NULL OR coalesce(attachments @> '[{}]', false) = sqlc.narg(has_attachment))
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created_at >= sqlc.narg(since))
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created_at < sqlc.narg(until))
+  AND (sqlc.narg(cursor)::text IS NULL OR id < sqlc.narg(cursor))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: GetUnreadCounts :many
+SELECT m.channel_id,
+  COUNT(*)::int AS unread_count,
+  (COUNT(*) FILTER (WHERE @user_id::text = ANY(m.mentions_users) OR m.mentions_roles && sm.roles))::int AS mention_count,
+  (COUNT(*) FILTER (WHERE m.everyone AND NOT (@user_id::text = ANY(m.mentions_users) OR COALESCE(m.mentions_roles && sm.roles, false))))::int AS everyone_count
+FROM messages m
+LEFT JOIN user_channel_read_state rs ON rs.channel_id = m.channel_id AND rs.user_id = @user_id
+LEFT JOIN server_membership sm ON sm.server_id = m.server_id AND sm.user_id = @user_id
+WHERE m.channel_id = ANY(@channel_ids::text[])
+  AND m.thread_id IS NULL
+  AND m.author_id <> @user_id
+  AND CASE
+    WHEN rs.last_read_message_id IS NOT NULL THEN m.id > rs.last_read_message_id
+    ELSE m.created_at > COALESCE(sm.joined_at, '-infinity'::timestamptz)
+  END
+GROUP BY m.channel_id;
+
+-- name: GetChannelReadState :one
+SELECT last_read_message_id FROM user_channel_read_state WHERE user_id = $1 AND channel_id = $2;
+
+-- name: AckChannel :execresult
+INSERT INTO user_channel_read_state (user_id, channel_id, last_read_message_id, unread_mention_ids, updated_at)
+VALUES (@user_id, @channel_id, @message_id, '[]', NOW())
+ON CONFLICT (user_id, channel_id) DO UPDATE SET
+    last_read_message_id = EXCLUDED.last_read_message_id,
+    unread_mention_ids = '[]',
+    updated_at = NOW()
+WHERE user_channel_read_state.last_read_message_id IS NULL
+  OR user_channel_read_state.last_read_message_id < EXCLUDED.last_read_message_id;
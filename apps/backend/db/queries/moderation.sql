@@ -0,0 +1,34 @@
+-- name: CreateBan :one
+INSERT INTO bans (
+  id, server_id, user_id, moderator_id, reason, expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (server_id, user_id)
+DO UPDATE SET
+    moderator_id = EXCLUDED.moderator_id,
+    reason = EXCLUDED.reason,
+    expires_at = EXCLUDED.expires_at,
+    created_at = NOW()
+RETURNING *;
+
+-- name: DeleteBan :execresult
+DELETE FROM bans WHERE server_id = $1 AND user_id = $2;
+
+-- name: IsBanned :execresult
+SELECT id FROM bans
+WHERE server_id = $1 AND user_id = $2 AND (expires_at IS NULL OR expires_at > NOW());
+
+-- name: GetBans :many
+SELECT b.id, b.user_id, b.moderator_id, b.reason, b.expires_at, b.created_at, u.username, u.display_name, u.avatar
+FROM bans b
+JOIN users u ON u.id = b.user_id
+WHERE b.server_id = $1 AND (b.expires_at IS NULL OR b.expires_at > NOW())
+ORDER BY b.created_at DESC;
+
+-- name: SetMemberTimeout :execresult
+UPDATE server_membership SET timeout_until = $1 WHERE server_id = $2 AND user_id = $3;
+
+-- name: GetServerTimeouts :many
+SELECT user_id, timeout_until FROM server_membership
+WHERE server_id = $1 AND timeout_until > NOW();
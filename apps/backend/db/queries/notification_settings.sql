@@ -0,0 +1,34 @@
+-- name: UpsertServerNotificationSettings :one
+INSERT INTO notification_settings (user_id, server_id, level, muted_until, suppress_everyone)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, server_id) WHERE channel_id IS NULL
+DO UPDATE SET
+    level = EXCLUDED.level,
+    muted_until = EXCLUDED.muted_until,
+    suppress_everyone = EXCLUDED.suppress_everyone,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: UpsertChannelNotificationSettings :one
+INSERT INTO notification_settings (user_id, server_id, channel_id, level, muted_until, suppress_everyone)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id, channel_id) WHERE channel_id IS NOT NULL
+DO UPDATE SET
+    level = EXCLUDED.level,
+    muted_until = EXCLUDED.muted_until,
+    suppress_everyone = EXCLUDED.suppress_everyone,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteNotificationSettings :execresult
+DELETE FROM notification_settings
+WHERE user_id = @user_id AND server_id = @server_id AND channel_id IS NOT DISTINCT FROM sqlc.narg('channel_id');
+
+-- name: GetNotificationSettings :many
+SELECT * FROM notification_settings WHERE user_id = $1;
+
+-- name: GetMembersNotificationSettings :many
+SELECT * FROM notification_settings
+WHERE user_id = ANY(@user_ids::text[])
+  AND server_id = @server_id
+  AND (channel_id IS NULL OR channel_id = @channel_id);
@@ -0,0 +1,27 @@
+-- name: CreateNotifications :copyfrom
+INSERT INTO notifications (
+  id, user_id, message_id, server_id, channel_id, author_id, type
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: GetNotifications :many
+SELECT n.id, n.server_id, n.channel_id, n.message_id, n.author_id, n.type, n.read_at, n.created_at,
+  m.content, m.thread_id, u.username, u.display_name, u.avatar
+FROM notifications n
+JOIN messages m ON m.id = n.message_id
+JOIN users u ON u.id = n.author_id
+WHERE n.user_id = @user_id
+  AND (sqlc.narg('before')::text IS NULL OR n.id < sqlc.narg('before')::text)
+  AND (NOT @unread_only::bool OR n.read_at IS NULL)
+ORDER BY n.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: MarkNotificationsRead :execresult
+UPDATE notifications SET read_at = NOW()
+WHERE user_id = @user_id
+  AND read_at IS NULL
+  AND (sqlc.narg('ids')::text[] IS NULL OR id = ANY(sqlc.narg('ids')::text[]));
+
+-- name: GetServerMembersRoles :many
+SELECT user_id, roles FROM server_membership WHERE server_id = $1;
@@ -0,0 +1,22 @@
+-- name: AddReaction :execresult
+INSERT INTO reactions (
+  id, message_id, user_id, emoji, emoji_id
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveReaction :execresult
+DELETE FROM reactions
+WHERE message_id = $1
+  AND user_id = $2
+  AND emoji IS NOT DISTINCT FROM sqlc.narg(emoji)
+  AND emoji_id IS NOT DISTINCT FROM sqlc.narg(emoji_id);
+
+-- name: GetReactionsFromMessages :many
+SELECT r.message_id, r.emoji, r.emoji_id, e.url AS emoji_url, count(r.id) AS count, array_agg(r.user_id ORDER BY r.created_at)::text[] AS users
+FROM reactions r
+LEFT JOIN emojis e ON e.id = r.emoji_id
+WHERE r.message_id = ANY($1::text[])
+GROUP BY r.message_id, r.emoji, r.emoji_id, e.url
+ORDER BY min(r.created_at);
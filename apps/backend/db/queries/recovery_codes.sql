@@ -0,0 +1,15 @@
+-- name: GetRecoveryCodes :many
+SELECT id, code_hash FROM recovery_codes WHERE user_id = $1;
+
+-- name: CreateRecoveryCodes :copyfrom
+INSERT INTO recovery_codes (
+  id, user_id, code_hash
+) VALUES (
+  $1, $2, $3
+);
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM recovery_codes WHERE user_id = $1;
+
+-- name: UseRecoveryCode :execrows
+DELETE FROM recovery_codes WHERE id = $1;
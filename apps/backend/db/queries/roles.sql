@@ -7,9 +7,10 @@ INSERT INTO roles (
 RETURNING *;
 
 -- name: GetUserAbilities :many
-SELECT r.abilities 
-FROM roles r, server_membership sm 
-WHERE sm.server_id = $1 AND sm.user_id = $2 AND r.id = ANY(sm.roles);
+SELECT r.id, r.idx, r.abilities
+FROM roles r, server_membership sm
+WHERE sm.server_id = $1 AND sm.user_id = $2 AND r.server_id = $1 AND r.id = ANY(sm.roles)
+ORDER BY r.idx;
 
 -- name: GetRoles :many
 SELECT r.id, r.idx, r.name, r.color, r.abilities, array_agg(sm.user_id) FILTER (WHERE sm.user_id IS NOT NULL) AS members
@@ -32,13 +33,13 @@ WHERE server_id = $2 AND user_id = $3;
 UPDATE server_membership SET roles = array_remove(roles, $1) WHERE server_id = $2 AND user_id = $3;
 
 -- name: DeleteRole :exec
-DELETE FROM roles WHERE id = $1;
+DELETE FROM roles WHERE id = $1 AND server_id = $2;
 
 -- name: RemoveRoleFromAllMembers :exec
-UPDATE server_membership SET roles = array_remove(roles, $1) WHERE $1 = ANY(roles);
+UPDATE server_membership SET roles = array_remove(roles, $1) WHERE server_id = $2 AND $1 = ANY(roles);
 
 -- name: MoveRole :exec
-UPDATE roles SET idx = $1 WHERE id = $2;
+UPDATE roles SET idx = $1 WHERE id = $2 AND server_id = $3;
 
 -- name: UpdateRolePositions :exec
-UPDATE roles SET idx = idx + 1 WHERE idx >= $1 AND idx < $2;
+UPDATE roles SET idx = idx + 1 WHERE server_id = $1 AND idx >= $2 AND idx < $3;
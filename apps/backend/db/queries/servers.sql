@@ -59,13 +59,13 @@ INSERT INTO server_membership (
 DELETE FROM server_membership WHERE user_id = $1 AND server_id = $2;
 
 -- name: UpdateServerName :exec
-UPDATE servers SET name = $1 WHERE id = $2 AND owner_id = $3;
+UPDATE servers SET name = $1 WHERE id = $2;
 
 -- name: UpdateServerAvatarNBanner :exec
-UPDATE servers SET avatar = $1, banner = $2, main_color = $3 WHERE id = $4 AND owner_id = $5;
+UPDATE servers SET avatar = $1, banner = $2, main_color = $3 WHERE id = $4;
 
 -- name: UpdateServerDescription :exec
-UPDATE servers SET description = $1 WHERE id = $2 AND owner_id = $3;
+UPDATE servers SET description = $1 WHERE id = $2;
 
 -- name: DeleteServer :execresult
 DELETE FROM servers WHERE id = $1 AND owner_id = $2;
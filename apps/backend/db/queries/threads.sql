@@ -0,0 +1,13 @@
+-- name: CreateThread :one
+INSERT INTO threads (
+  id, server_id, channel_id, creator_id, name
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetThread :one
+SELECT * FROM threads WHERE id = $1;
+
+-- name: GetThreadsFromChannel :many
+SELECT * FROM threads WHERE channel_id = $1;
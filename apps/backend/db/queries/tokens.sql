@@ -1,14 +1,58 @@
--- name: VerifyToken :one
-SELECT * FROM users WHERE id = (SELECT user_id FROM tokens WHERE token = $1);
+-- name: GetSession :one
+SELECT sqlc.embed(tokens), sqlc.embed(users) FROM tokens
+JOIN users ON users.id = tokens.user_id
+WHERE (tokens.token = @token OR (tokens.previous_token = @token AND tokens.rotated_at > now() - make_interval(secs => @grace::int)))
+AND tokens.type = 'REMEMBER_ME_TOKEN' AND tokens.expire_at > now();
 
--- name: DeleteRememberMeToken :exec
-DELETE FROM tokens WHERE user_id = $1 AND type = 'REMEMBER_ME_TOKEN';
+-- name: GetSessions :many
+SELECT id, device_name, ip, created_at, last_used_at, expire_at FROM tokens
+WHERE user_id = $1 AND type = 'REMEMBER_ME_TOKEN' AND expire_at > now()
+ORDER BY last_used_at DESC;
+
+-- name: GetSessionsExpiry :many
+SELECT id, expire_at FROM tokens
+WHERE id = ANY(@ids::text[]) AND type = 'REMEMBER_ME_TOKEN';
 
 -- name: CreateToken :one
 INSERT INTO tokens (
-  id, user_id, token, expire_at, type
+  id, user_id, token, expire_at, type, device_name, ip
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6, $7
 )
 RETURNING *;
 
+-- name: TouchSession :exec
+UPDATE tokens SET last_used_at = now() WHERE id = $1;
+
+-- name: RotateToken :execrows
+UPDATE tokens SET previous_token = token, rotated_at = now(), token = @new_token, expire_at = @expire_at, last_used_at = now()
+WHERE id = @id AND token = @token;
+
+-- name: DeleteSession :execrows
+DELETE FROM tokens WHERE id = $1 AND user_id = $2 AND type = 'REMEMBER_ME_TOKEN';
+
+-- name: DeleteOtherSessions :many
+DELETE FROM tokens WHERE user_id = $1 AND id <> $2 AND type = 'REMEMBER_ME_TOKEN'
+RETURNING id;
+
+-- name: DeleteExpiredTokens :execrows
+DELETE FROM tokens WHERE expire_at < now();
+
+-- name: GetLastTokenCreation :one
+SELECT created_at FROM tokens
+WHERE user_id = $1 AND type = $2
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ConsumeToken :one
+DELETE FROM tokens WHERE token = $1 AND type = $2 AND expire_at > now()
+RETURNING user_id;
+
+-- name: DeleteUserTokens :many
+DELETE FROM tokens WHERE user_id = $1 AND type = $2
+RETURNING id;
+
+-- name: AttemptToken :one
+UPDATE tokens SET attempts = attempts + 1
+WHERE token = @token AND type = @type AND expire_at > now() AND attempts < @max_attempts::int
+RETURNING user_id;
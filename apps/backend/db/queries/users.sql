@@ -59,7 +59,38 @@ WHERE id = $1;
 
 -- name: UpdateUserEmail :exec
 UPDATE users
-  set email = $2
+  set email = $2, email_verified = false
+WHERE id = $1;
+
+-- name: VerifyUserEmail :exec
+UPDATE users
+  set email_verified = true
+WHERE id = $1;
+
+-- name: SetUserTOTPSecret :execrows
+UPDATE users
+  set totp_secret = $2
+WHERE id = $1 AND totp_enabled = false;
+
+-- name: EnableUserTOTP :execrows
+UPDATE users
+  set totp_enabled = true, totp_last_step = $2
+WHERE id = $1 AND totp_enabled = false;
+
+-- name: UseUserTOTPStep :execrows
+UPDATE users
+  set totp_last_step = $2
+WHERE id = $1 AND totp_last_step < $2;
+
+-- name: AttemptUserTOTP :execrows
+UPDATE users
+  set totp_attempts = CASE WHEN totp_attempts_reset_at <= now() THEN 1 ELSE totp_attempts + 1 END,
+    totp_attempts_reset_at = CASE WHEN totp_attempts_reset_at <= now() THEN now() + make_interval(secs => @window_seconds::int) ELSE totp_attempts_reset_at END
+WHERE id = @id AND (totp_attempts_reset_at <= now() OR totp_attempts < @max_attempts::int);
+
+-- name: ResetUserTOTPAttempts :exec
+UPDATE users
+  set totp_attempts = 0
 WHERE id = $1;
 
 -- name: UpdateUserPassword :exec
@@ -76,3 +107,14 @@ WHERE id = $1;
 UPDATE users
   set facts = $2
 WHERE id = $1;
+
+-- name: GetEmoji :one
+SELECT id, url, shortcode FROM emojis WHERE id = $1;
+
+-- name: UpdateUserPresence :exec
+UPDATE users
+  set status = $2, custom_status_text = $3, custom_status_emoji = $4, custom_status_expires_at = $5
+WHERE id = $1;
+
+-- name: GetUserPresence :one
+SELECT id, status, custom_status_text, custom_status_emoji, custom_status_expires_at FROM users WHERE id = $1;
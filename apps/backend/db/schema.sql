@@ -18,14 +18,125 @@ CREATE TYPE public.channel_type AS ENUM (
     'voice',
     'textual',
     'dm',
-    'groups'
+    'groups',
+    'stage'
 );
 
 
+--
+-- Name: notification_level; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.notification_level AS ENUM (
+    'all',
+    'mentions',
+    'nothing'
+);
+
+
+--
+-- Name: overwrite_type; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.overwrite_type AS ENUM (
+    'role',
+    'user'
+);
+
+
+--
+-- Name: user_status; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.user_status AS ENUM (
+    'online',
+    'idle',
+    'dnd',
+    'invisible'
+);
+
+
+--
+-- Name: audit_logs_append_only(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.audit_logs_append_only() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+    BEGIN
+      IF TG_OP = 'DELETE' AND pg_trigger_depth() > 1 THEN
+        RETURN OLD;
+      END IF;
+      RAISE EXCEPTION 'audit_logs is append-only';
+    END;
+  $$;
+
+
+--
+-- Name: message_plain_text(jsonb); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.message_plain_text(content jsonb) RETURNS text
+    LANGUAGE sql IMMUTABLE
+    AS $_$
+    SELECT coalesce(string_agg(t #>> '{}', ' '), '')
+    FROM jsonb_path_query(content, 'strict $.**.text') AS t
+    WHERE jsonb_typeof(t) = 'string'
+  $_$;
+
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: audit_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_logs (
+    id character varying(20) NOT NULL,
+    server_id character varying(20) NOT NULL,
+    actor_id character varying(20) NOT NULL,
+    action character varying(50) NOT NULL,
+    target_type character varying(20) NOT NULL,
+    target_id character varying(20) NOT NULL,
+    changes jsonb DEFAULT '{}'::jsonb NOT NULL,
+    reason character varying(512) DEFAULT ''::character varying NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: bans; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.bans (
+    id character varying(20) NOT NULL,
+    server_id character varying(20) NOT NULL,
+    user_id character varying(20) NOT NULL,
+    moderator_id character varying(20) NOT NULL,
+    reason character varying(512) DEFAULT ''::character varying NOT NULL,
+    expires_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: channel_overwrites; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.channel_overwrites (
+    id character varying(20) NOT NULL,
+    channel_id character varying(20) NOT NULL,
+    target_id character varying(20) NOT NULL,
+    type public.overwrite_type NOT NULL,
+    allow character varying(255)[] DEFAULT '{}'::character varying[] NOT NULL,
+    deny character varying(255)[] DEFAULT '{}'::character varying[] NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: channels; Type: TABLE; Schema: public; Owner: -
 --
@@ -42,7 +153,9 @@ CREATE TABLE public.channels (
     y integer NOT NULL,
     active boolean DEFAULT true NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
-    updated_at timestamp with time zone DEFAULT now() NOT NULL
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_limit integer,
+    CONSTRAINT channels_user_limit_check CHECK ((user_limit > 0))
 );
 
 
@@ -80,7 +193,28 @@ CREATE TABLE public.invites (
     id character varying(20) NOT NULL,
     server_id character varying(20) NOT NULL,
     invite_id character varying(255) NOT NULL,
-    expire_at timestamp with time zone DEFAULT now() NOT NULL
+    expire_at timestamp with time zone,
+    creator_id character varying(20),
+    max_uses integer DEFAULT 0 NOT NULL,
+    uses integer DEFAULT 0 NOT NULL,
+    vanity boolean DEFAULT false NOT NULL,
+    revoked_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: join_requests; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.join_requests (
+    id character varying(20) NOT NULL,
+    server_id character varying(20) NOT NULL,
+    user_id character varying(20) NOT NULL,
+    invite_id character varying(255) NOT NULL,
+    x integer NOT NULL,
+    y integer NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
@@ -99,10 +233,73 @@ CREATE TABLE public.messages (
     mentions_channels character varying(20)[],
     attachments jsonb DEFAULT '[]'::jsonb,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    reply_to character varying(20),
+    thread_id character varying(20),
+    plain_text text GENERATED ALWAYS AS (public.message_plain_text(content)) STORED,
+    mentions_roles character varying(20)[]
+);
+
+
+--
+-- Name: notification_settings; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.notification_settings (
+    user_id character varying(20) NOT NULL,
+    server_id character varying(20) NOT NULL,
+    channel_id character varying(20),
+    level public.notification_level,
+    muted_until timestamp with time zone,
+    suppress_everyone boolean DEFAULT false NOT NULL,
     updated_at timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
+--
+-- Name: notifications; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.notifications (
+    id character varying(20) NOT NULL,
+    user_id character varying(20) NOT NULL,
+    message_id character varying(20) NOT NULL,
+    server_id character varying(20) NOT NULL,
+    channel_id character varying(20) NOT NULL,
+    author_id character varying(20) NOT NULL,
+    type character varying(16) NOT NULL,
+    read_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: reactions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.reactions (
+    id character varying(20) NOT NULL,
+    message_id character varying(20) NOT NULL,
+    user_id character varying(20) NOT NULL,
+    emoji character varying(255),
+    emoji_id character varying(20),
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT reactions_check CHECK (((emoji IS NULL) <> (emoji_id IS NULL)))
+);
+
+
+--
+-- Name: recovery_codes; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.recovery_codes (
+    id character varying(20) NOT NULL,
+    user_id character varying(20) NOT NULL,
+    code_hash character varying(255) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: roles; Type: TABLE; Schema: public; Owner: -
 --
@@ -139,7 +336,8 @@ CREATE TABLE public.server_membership (
     roles character varying(20)[],
     x integer NOT NULL,
     y integer NOT NULL,
-    joined_at timestamp with time zone DEFAULT now() NOT NULL
+    joined_at timestamp with time zone DEFAULT now() NOT NULL,
+    timeout_until timestamp with time zone
 );
 
 
@@ -161,6 +359,20 @@ CREATE TABLE public.servers (
 );
 
 
+--
+-- Name: threads; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.threads (
+    id character varying(20) NOT NULL,
+    server_id character varying(20) NOT NULL,
+    channel_id character varying(20) NOT NULL,
+    creator_id character varying(20) NOT NULL,
+    name character varying(100) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: tokens; Type: TABLE; Schema: public; Owner: -
 --
@@ -170,7 +382,14 @@ CREATE TABLE public.tokens (
     user_id character varying(20) NOT NULL,
     token text NOT NULL,
     type character varying(255) NOT NULL,
-    expire_at timestamp with time zone DEFAULT now() NOT NULL
+    expire_at timestamp with time zone DEFAULT now() NOT NULL,
+    device_name character varying(255) DEFAULT ''::character varying NOT NULL,
+    ip character varying(45) DEFAULT ''::character varying NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_used_at timestamp with time zone DEFAULT now() NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    previous_token text,
+    rotated_at timestamp with time zone
 );
 
 
@@ -208,10 +427,60 @@ CREATE TABLE public.users (
     rpm_id character varying(255),
     rpm_token text,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
-    updated_at timestamp with time zone DEFAULT now() NOT NULL
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    status public.user_status DEFAULT 'online'::public.user_status NOT NULL,
+    custom_status_text character varying(128),
+    custom_status_emoji character varying(64),
+    custom_status_expires_at timestamp with time zone,
+    email_verified boolean DEFAULT false NOT NULL,
+    totp_secret character varying(64),
+    totp_enabled boolean DEFAULT false NOT NULL,
+    totp_last_step bigint DEFAULT 0 NOT NULL,
+    totp_attempts integer DEFAULT 0 NOT NULL,
+    totp_attempts_reset_at timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
+--
+-- Name: audit_logs audit_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_logs
+    ADD CONSTRAINT audit_logs_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: bans bans_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bans
+    ADD CONSTRAINT bans_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: bans bans_server_id_user_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bans
+    ADD CONSTRAINT bans_server_id_user_id_key UNIQUE (server_id, user_id);
+
+
+--
+-- Name: channel_overwrites channel_overwrites_channel_id_target_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.channel_overwrites
+    ADD CONSTRAINT channel_overwrites_channel_id_target_id_key UNIQUE (channel_id, target_id);
+
+
+--
+-- Name: channel_overwrites channel_overwrites_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.channel_overwrites
+    ADD CONSTRAINT channel_overwrites_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: channels channels_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -244,6 +513,22 @@ ALTER TABLE ONLY public.invites
     ADD CONSTRAINT invites_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: join_requests join_requests_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.join_requests
+    ADD CONSTRAINT join_requests_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: join_requests join_requests_server_id_user_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.join_requests
+    ADD CONSTRAINT join_requests_server_id_user_id_key UNIQUE (server_id, user_id);
+
+
 --
 -- Name: messages messages_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -252,6 +537,38 @@ ALTER TABLE ONLY public.messages
     ADD CONSTRAINT messages_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: notifications notifications_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notifications
+    ADD CONSTRAINT notifications_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: notifications notifications_user_id_message_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notifications
+    ADD CONSTRAINT notifications_user_id_message_id_key UNIQUE (user_id, message_id);
+
+
+--
+-- Name: reactions reactions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reactions
+    ADD CONSTRAINT reactions_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: recovery_codes recovery_codes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.recovery_codes
+    ADD CONSTRAINT recovery_codes_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: roles roles_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -292,6 +609,14 @@ ALTER TABLE ONLY public.servers
     ADD CONSTRAINT servers_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: threads threads_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.threads
+    ADD CONSTRAINT threads_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: tokens tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -332,11 +657,116 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_username_key UNIQUE (username);
 
 
+--
+-- Name: idx_audit_logs_server_id_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_logs_server_id_id ON public.audit_logs USING btree (server_id, id);
+
+
 --
 -- Name: idx_invites_invite_id; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_invites_invite_id ON public.invites USING btree (invite_id);
+CREATE UNIQUE INDEX idx_invites_invite_id ON public.invites USING btree (invite_id);
+
+
+--
+-- Name: idx_invites_server_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_invites_server_id ON public.invites USING btree (server_id);
+
+
+--
+-- Name: idx_invites_server_id_vanity; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_invites_server_id_vanity ON public.invites USING btree (server_id) WHERE vanity;
+
+
+--
+-- Name: idx_messages_channel_id_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_messages_channel_id_id ON public.messages USING btree (channel_id, id);
+
+
+--
+-- Name: idx_messages_search; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_messages_search ON public.messages USING gin (to_tsvector('simple'::regconfig, plain_text));
+
+
+--
+-- Name: idx_messages_thread_id_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_messages_thread_id_id ON public.messages USING btree (thread_id, id);
+
+
+--
+-- Name: idx_notification_settings_channel; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_notification_settings_channel ON public.notification_settings USING btree (user_id, channel_id) WHERE (channel_id IS NOT NULL);
+
+
+--
+-- Name: idx_notification_settings_server; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_notification_settings_server ON public.notification_settings USING btree (user_id, server_id) WHERE (channel_id IS NULL);
+
+
+--
+-- Name: idx_notifications_user_id_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_notifications_user_id_id ON public.notifications USING btree (user_id, id);
+
+
+--
+-- Name: idx_reactions_message_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reactions_message_id ON public.reactions USING btree (message_id);
+
+
+--
+-- Name: idx_reactions_message_user_emoji; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_reactions_message_user_emoji ON public.reactions USING btree (message_id, user_id, emoji) WHERE (emoji IS NOT NULL);
+
+
+--
+-- Name: idx_reactions_message_user_emoji_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_reactions_message_user_emoji_id ON public.reactions USING btree (message_id, user_id, emoji_id) WHERE (emoji_id IS NOT NULL);
+
+
+--
+-- Name: idx_recovery_codes_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_recovery_codes_user_id ON public.recovery_codes USING btree (user_id);
+
+
+--
+-- Name: idx_threads_channel_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_threads_channel_id ON public.threads USING btree (channel_id);
+
+
+--
+-- Name: idx_tokens_previous_token; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tokens_previous_token ON public.tokens USING btree (previous_token);
 
 
 --
@@ -346,6 +776,13 @@ CREATE INDEX idx_invites_invite_id ON public.invites USING btree (invite_id);
 CREATE INDEX idx_tokens_token ON public.tokens USING btree (token);
 
 
+--
+-- Name: idx_tokens_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tokens_user_id ON public.tokens USING btree (user_id);
+
+
 --
 -- Name: idx_users_email; Type: INDEX; Schema: public; Owner: -
 --
@@ -360,6 +797,53 @@ CREATE INDEX idx_users_email ON public.users USING btree (email);
 CREATE INDEX idx_users_username ON public.users USING btree (username);
 
 
+--
+-- Name: audit_logs audit_logs_append_only; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER audit_logs_append_only BEFORE DELETE OR UPDATE ON public.audit_logs FOR EACH ROW EXECUTE FUNCTION public.audit_logs_append_only();
+
+
+--
+-- Name: audit_logs audit_logs_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_logs
+    ADD CONSTRAINT audit_logs_server_id_fkey FOREIGN KEY (server_id) REFERENCES public.servers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: bans bans_moderator_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bans
+    ADD CONSTRAINT bans_moderator_id_fkey FOREIGN KEY (moderator_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: bans bans_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bans
+    ADD CONSTRAINT bans_server_id_fkey FOREIGN KEY (server_id) REFERENCES public.servers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: bans bans_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bans
+    ADD CONSTRAINT bans_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: channel_overwrites channel_overwrites_channel_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.channel_overwrites
+    ADD CONSTRAINT channel_overwrites_channel_id_fkey FOREIGN KEY (channel_id) REFERENCES public.channels(id) ON DELETE CASCADE;
+
+
 --
 -- Name: channels channels_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -392,6 +876,14 @@ ALTER TABLE ONLY public.friends
     ADD CONSTRAINT friends_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
+--
+-- Name: invites invites_creator_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.invites
+    ADD CONSTRAINT invites_creator_id_fkey FOREIGN KEY (creator_id) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
 --
 -- Name: invites invites_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -400,6 +892,22 @@ ALTER TABLE ONLY public.invites
     ADD CONSTRAINT invites_server_id_fkey FOREIGN KEY (server_id) REFERENCES public.servers(id) ON DELETE CASCADE;
 
 
+--
+-- Name: join_requests join_requests_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.join_requests
+    ADD CONSTRAINT join_requests_server_id_fkey FOREIGN KEY (server_id) REFERENCES public.servers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: join_requests join_requests_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.join_requests
+    ADD CONSTRAINT join_requests_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: messages messages_author_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -416,6 +924,14 @@ ALTER TABLE ONLY public.messages
     ADD CONSTRAINT messages_channel_id_fkey FOREIGN KEY (channel_id) REFERENCES public.channels(id) ON DELETE CASCADE;
 
 
+--
+-- Name: messages messages_reply_to_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.messages
+    ADD CONSTRAINT messages_reply_to_fkey FOREIGN KEY (reply_to) REFERENCES public.messages(id) ON DELETE SET NULL;
+
+
 --
 -- Name: messages messages_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -424,6 +940,110 @@ ALTER TABLE ONLY public.messages
     ADD CONSTRAINT messages_server_id_fkey FOREIGN KEY (server_id) REFERENCES public.servers(id) ON DELETE CASCADE;
 
 
+--
+-- Name: messages messages_thread_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.messages
+    ADD CONSTRAINT messages_thread_id_fkey FOREIGN KEY (thread_id) REFERENCES public.threads(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notification_settings notification_settings_channel_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_settings
+    ADD CONSTRAINT notification_settings_channel_id_fkey FOREIGN KEY (channel_id) REFERENCES public.channels(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notification_settings notification_settings_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_settings
+    ADD CONSTRAINT notification_settings_server_id_fkey FOREIGN KEY (server_id) REFERENCES public.servers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notification_settings notification_settings_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_settings
+    ADD CONSTRAINT notification_settings_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notifications notifications_author_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notifications
+    ADD CONSTRAINT notifications_author_id_fkey FOREIGN KEY (author_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notifications notifications_channel_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notifications
+    ADD CONSTRAINT notifications_channel_id_fkey FOREIGN KEY (channel_id) REFERENCES public.channels(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notifications notifications_message_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notifications
+    ADD CONSTRAINT notifications_message_id_fkey FOREIGN KEY (message_id) REFERENCES public.messages(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notifications notifications_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notifications
+    ADD CONSTRAINT notifications_server_id_fkey FOREIGN KEY (server_id) REFERENCES public.servers(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notifications notifications_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notifications
+    ADD CONSTRAINT notifications_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: reactions reactions_emoji_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reactions
+    ADD CONSTRAINT reactions_emoji_id_fkey FOREIGN KEY (emoji_id) REFERENCES public.emojis(id) ON DELETE CASCADE;
+
+
+--
+-- Name: reactions reactions_message_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reactions
+    ADD CONSTRAINT reactions_message_id_fkey FOREIGN KEY (message_id) REFERENCES public.messages(id) ON DELETE CASCADE;
+
+
+--
+-- Name: reactions reactions_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reactions
+    ADD CONSTRAINT reactions_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: recovery_codes recovery_codes_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.recovery_codes
+    ADD CONSTRAINT recovery_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: roles roles_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -456,6 +1076,38 @@ ALTER TABLE ONLY public.servers
     ADD CONSTRAINT servers_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
+--
+-- Name: threads threads_channel_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.threads
+    ADD CONSTRAINT threads_channel_id_fkey FOREIGN KEY (channel_id) REFERENCES public.channels(id) ON DELETE CASCADE;
+
+
+--
+-- Name: threads threads_creator_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.threads
+    ADD CONSTRAINT threads_creator_id_fkey FOREIGN KEY (creator_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: threads threads_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.threads
+    ADD CONSTRAINT threads_id_fkey FOREIGN KEY (id) REFERENCES public.messages(id) ON DELETE CASCADE;
+
+
+--
+-- Name: threads threads_server_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.threads
+    ADD CONSTRAINT threads_server_id_fkey FOREIGN KEY (server_id) REFERENCES public.servers(id) ON DELETE CASCADE;
+
+
 --
 -- Name: tokens tokens_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -498,4 +1150,22 @@ ALTER TABLE ONLY public.user_channel_read_state
 --
 
 INSERT INTO public.schema_migrations (version) VALUES
-    ('20250502134015');
+    ('20250502134015'),
+    ('20250623101500'),
+    ('20250624093000'),
+    ('20250625141000'),
+    ('20250626110000'),
+    ('20250627150000'),
+    ('20250628120000'),
+    ('20250629100000'),
+    ('20250630090000'),
+    ('20250701100000'),
+    ('20250702090000'),
+    ('20250703090000'),
+    ('20250704090000'),
+    ('20250705090000'),
+    ('20250706090000'),
+    ('20250707090000'),
+    ('20250708090000'),
+    ('20250709090000'),
+    ('20250710090000');
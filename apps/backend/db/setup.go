@@ -13,7 +13,10 @@ type DBManager struct {
 	conn *pgxpool.Pool
 }
 
-var Query *db.Queries
+var (
+	Query *db.Queries
+	pool  *pgxpool.Pool
+)
 
 func Setup() *DBManager {
 	dsn := os.Getenv("DATABASE_URL")
@@ -24,9 +27,26 @@ func Setup() *DBManager {
 	}
 
 	Query = db.New(conn)
+	pool = conn
 	return &DBManager{conn: conn}
 }
 
+// Tx runs fn inside a transaction, committed only when fn returns no error.
+func Tx(ctx context.Context, fn func(q *db.Queries) error) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = fn(Query.WithTx(tx))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
 func (db *DBManager) Close() {
 	db.conn.Close()
 }
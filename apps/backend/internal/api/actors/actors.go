@@ -3,6 +3,7 @@ package actors
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/anthdm/hollywood/actor"
 	"github.com/lxzan/gws"
@@ -33,9 +34,11 @@ func SetupServersEngine() {
 }
 
 type VoiceUser struct {
-	ID     string `json:"user_id"`
-	Deafen bool   `json:"deafen"`
-	Mute   bool   `json:"mute"`
+	ID         string    `json:"user_id"`
+	Deafen     bool      `json:"deafen"`
+	Mute       bool      `json:"mute"`
+	ServerMute bool      `json:"server_mute"`
+	JoinedAt   time.Time `json:"joined_at"`
 }
 
 type (
@@ -49,14 +52,16 @@ type server struct {
 	channels   ChannelMap
 	users      UserMap
 	usersSlice []string
+	presences  map[string]*protoTypes.Presence
 	logger     *slog.Logger
 }
 
 func NewServer() actor.Receiver {
 	return &server{
-		channels: make(ChannelMap),
-		users:    make(UserMap),
-		logger:   slog.Default(),
+		channels:  make(ChannelMap),
+		users:     make(UserMap),
+		presences: make(map[string]*protoTypes.Presence),
+		logger:    slog.Default(),
 	}
 }
 
@@ -104,20 +109,39 @@ func (s *server) Receive(ctx *actor.Context) {
 		s.RemoveRoleMember(ctx, msg)
 	case *protoTypes.ChangeRoleRanking:
 		s.ChangeRoleRanking(ctx, msg)
+	case *protoTypes.BroadcastModerationAction:
+		s.Moderate(ctx, msg)
+	case *protoTypes.JoinRequest:
+		s.NewJoinRequest(ctx, msg)
+	case *protoTypes.JoinRequestResolved:
+		s.JoinRequestResolved(ctx, msg)
+	case *protoTypes.Presence:
+		s.UpdatePresence(ctx, msg)
 	}
 }
 
 type channel struct {
-	users  UserMap
-	call   CallMap
-	logger *slog.Logger
+	users         UserMap
+	call          CallMap
+	speakers      map[string]bool
+	hands         map[string]bool
+	timeouts      map[string]time.Time
+	typing        map[string]time.Time
+	typingStarts  map[string]time.Time
+	typingSweeper *actor.SendRepeater
+	logger        *slog.Logger
 }
 
 func NewChannel() actor.Receiver {
 	return &channel{
-		users:  make(UserMap),
-		call:   make(CallMap),
-		logger: slog.Default(),
+		users:        make(UserMap),
+		call:         make(CallMap),
+		speakers:     make(map[string]bool),
+		hands:        make(map[string]bool),
+		timeouts:     make(map[string]time.Time),
+		typing:       make(map[string]time.Time),
+		typingStarts: make(map[string]time.Time),
+		logger:       slog.Default(),
 	}
 }
 
@@ -127,10 +151,13 @@ func (c *channel) Receive(ctx *actor.Context) {
 		slog.Info("channel stopped",
 			"id", ctx.PID().GetID(),
 		)
+		c.stopTypingSweeper()
 	case actor.Started:
 		slog.Info("channel started",
 			"id", ctx.PID().GetID(),
 		)
+		c.InitializeThreads(ctx)
+		c.InitializeTimeouts(ctx)
 	case actor.InternalError:
 		slog.Info("channel erroring",
 			"id", ctx.PID().GetID(),
@@ -144,22 +171,109 @@ func (c *channel) Receive(ctx *actor.Context) {
 		c.ConnectToCall(ctx, msg)
 	case *protoTypes.DisconnectFromCall:
 		c.DisconnectFromCall(ctx, msg)
+	case *protoTypes.Mute:
+		c.Mute(ctx, msg)
+	case *protoTypes.Deafen:
+		c.Deafen(ctx, msg)
+	case *protoTypes.MoveToCall:
+		c.MoveFromCall(ctx, msg)
+	case *protoTypes.CallEnded:
+		c.EndCall(ctx, msg)
+	case *protoTypes.StageAction:
+		c.StageAction(ctx, msg)
 	case *protoTypes.IncomingChatMessage:
 		c.NewMessage(ctx, msg)
+	case *protoTypes.BroadcastChatMessage:
+		c.broadcastMessage(msg)
+	case *protoTypes.StartThread:
+		c.StartThread(ctx, msg)
 	case *protoTypes.EditChatMessage:
 		c.EditMessage(ctx, msg)
 	case *protoTypes.DeleteChatMessage:
 		c.DeleteMessage(ctx, msg)
+	case *protoTypes.AddReaction:
+		c.AddReaction(ctx, msg)
+	case *protoTypes.RemoveReaction:
+		c.RemoveReaction(ctx, msg)
 	case *protoTypes.BroadcastUserInformations:
 		c.BroadcastUserInformations(ctx, msg)
+	case *protoTypes.RefreshChannelPermissions:
+		c.RefreshPermissions(ctx, msg)
+	case *protoTypes.Typing:
+		c.Typing(ctx, msg)
+	case typingSweep:
+		c.SweepTyping(ctx)
+	case *protoTypes.BroadcastModerationAction:
+		c.UpdateTimeout(ctx, msg)
 	}
 }
 
+type thread struct {
+	logger *slog.Logger
+}
+
+func NewThread() actor.Receiver {
+	return &thread{
+		logger: slog.Default(),
+	}
+}
+
+func (t *thread) Receive(ctx *actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case actor.Stopped:
+		slog.Info("thread stopped",
+			"id", ctx.PID().GetID(),
+		)
+	case actor.Started:
+		slog.Info("thread started",
+			"id", ctx.PID().GetID(),
+		)
+	case actor.InternalError:
+		slog.Info("thread erroring",
+			"id", ctx.PID().GetID(),
+			"err", msg.Err,
+		)
+	case *protoTypes.IncomingChatMessage:
+		t.NewMessage(ctx, msg)
+	}
+}
+
+// A user actor lives as long as the user has at least one socket open, every
+// device of the user shares it.
 type user struct {
-	servers  ServerMap
-	channels ChannelMap
-	wsConn   *gws.Conn
-	logger   *slog.Logger
+	servers       ServerMap
+	channels      ChannelMap
+	presence      *protoTypes.Presence
+	presenceTimer *time.Timer
+	conns         map[*gws.Conn]bool
+	// requests remembers which socket sent a pending request so that only
+	// this one gets the answer.
+	requests       map[string]pendingRequest
+	requestSweeper *actor.SendRepeater
+	logger         *slog.Logger
+}
+
+type pendingRequest struct {
+	conn   *gws.Conn
+	sentAt time.Time
+}
+
+// AttachConnection hands another socket of a connected user to their actor.
+type AttachConnection struct {
+	Conn *gws.Conn
+}
+
+// DetachConnection removes a closed socket from the actor of the user, the
+// actor is poisoned instead when it was the last one.
+type DetachConnection struct {
+	Conn *gws.Conn
+}
+
+// ClientRequest is a message sent by the client on one of the sockets of the
+// user.
+type ClientRequest struct {
+	Conn    *gws.Conn
+	Message *protoTypes.ClientMessage
 }
 
 func NewUser(wsConn *gws.Conn) actor.Producer {
@@ -167,7 +281,8 @@ func NewUser(wsConn *gws.Conn) actor.Producer {
 		return &user{
 			servers:  make(ServerMap),
 			channels: make(ChannelMap),
-			wsConn:   wsConn,
+			conns:    map[*gws.Conn]bool{wsConn: true},
+			requests: make(map[string]pendingRequest),
 			logger:   slog.Default(),
 		}
 	}
@@ -183,6 +298,10 @@ func (u *user) Receive(ctx *actor.Context) {
 		u.KillUser(ctx)
 	case actor.InternalError:
 		slog.Info("user error", "err", msg.Err)
+	case AttachConnection:
+		u.AttachConnection(ctx, msg.Conn)
+	case DetachConnection:
+		u.DetachConnection(ctx, msg.Conn)
 	case *protoTypes.NewServerCreated:
 		u.NewServer(ctx, msg)
 	case *protoTypes.BroadcastConnect:
@@ -199,12 +318,26 @@ func (u *user) Receive(ctx *actor.Context) {
 		u.BroadcastEditMessage(ctx, msg)
 	case *protoTypes.DeleteChatMessage:
 		u.BroadcastDeleteMessage(ctx, msg)
+	case *protoTypes.BroadcastReactionAdded:
+		u.BroadcastReactionAdded(ctx, msg)
+	case *protoTypes.BroadcastReactionRemoved:
+		u.BroadcastReactionRemoved(ctx, msg)
+	case *protoTypes.BroadcastThreadCreation:
+		u.BroadcastThreadCreation(ctx, msg)
 	case *protoTypes.CallInitialization:
 		u.SendCallInitialization(ctx, msg)
 	case *protoTypes.ConnectToCall:
 		u.BroadcastConnectToCall(ctx, msg)
 	case *protoTypes.DisconnectFromCall:
 		u.BroadcastDisconnectFromCall(ctx, msg)
+	case *protoTypes.Mute:
+		u.BroadcastMute(ctx, msg)
+	case *protoTypes.Deafen:
+		u.BroadcastDeafen(ctx, msg)
+	case *protoTypes.MoveToCall:
+		u.BroadcastMoveToCall(ctx, msg)
+	case *protoTypes.StageState:
+		u.BroadcastStage(ctx, msg)
 	case *protoTypes.BodyNewUserInServer:
 		u.BroadcastNewUserInServer(ctx, msg)
 	case *protoTypes.SendFriendInvite:
@@ -229,6 +362,36 @@ func (u *user) Receive(ctx *actor.Context) {
 		u.RemoveRoleMember(ctx, msg)
 	case *protoTypes.ChangeRoleRanking:
 		u.MoveRole(ctx, msg)
+	case *protoTypes.ChannelAccessChanged:
+		u.ChannelAccessChanged(ctx, msg)
+	case *protoTypes.BroadcastModerationAction:
+		u.ModerationAction(ctx, msg)
+	case *protoTypes.JoinRequest:
+		u.BroadcastJoinRequest(ctx, msg)
+	case *protoTypes.JoinRequestResolved:
+		u.BroadcastJoinRequestResolved(ctx, msg)
+	case ClientRequest:
+		u.HandleClientMessage(ctx, msg.Conn, msg.Message)
+	case *protoTypes.Ack:
+		u.SendAck(ctx, msg)
+	case *protoTypes.RequestError:
+		u.SendRequestError(ctx, msg)
+	case *protoTypes.Typing:
+		u.BroadcastTyping(ctx, msg)
+	case *protoTypes.UserChangedPresence:
+		u.PresenceChanged(ctx, msg.Presence)
+	case *protoTypes.Presence:
+		u.BroadcastPresence(ctx, msg)
+	case *protoTypes.ReadState:
+		u.BroadcastReadState(ctx, msg)
+	case *protoTypes.Notification:
+		u.BroadcastNotification(ctx, msg)
+	case *protoTypes.NotificationsRead:
+		u.BroadcastNotificationsRead(ctx, msg)
+	case customStatusExpired:
+		u.CustomStatusExpired(ctx)
+	case requestSweep:
+		u.SweepRequests(ctx)
 	}
 }
 
@@ -2,15 +2,86 @@ package actors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/anthdm/hollywood/actor"
+	"github.com/okzmo/kyob/db"
+	"github.com/okzmo/kyob/internal/domain/permissions"
 	services "github.com/okzmo/kyob/internal/service"
 	"github.com/okzmo/kyob/internal/utils"
 	protoTypes "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// notifyMentions stores a notification for every user mentioned by the
+// message and pushes it to the ones currently connected.
+func notifyMentions(message *protoTypes.BroadcastChatMessage) {
+	notifications, err := services.CreateMentionNotifications(context.TODO(), message)
+	if err != nil {
+		slog.Error("failed to create mention notifications", "err", err)
+		return
+	}
+
+	for _, notification := range notifications {
+		userPID := UsersEngine.Registry.GetPID("user", notification.UserId)
+		UsersEngine.Send(userPID, notification)
+	}
+}
+
+// broadcast only reaches the connected users allowed to view the channel.
+func (c *channel) broadcast(msg any) {
+	for user, visible := range c.users {
+		if visible {
+			UsersEngine.Send(user, msg)
+		}
+	}
+}
+
+// broadcastMessage is broadcast for new messages, each user is told whether
+// the message counts toward their unread and mention badges.
+func (c *channel) broadcastMessage(message *protoTypes.BroadcastChatMessage) {
+	var userIDs []string
+	for user, visible := range c.users {
+		if visible {
+			userIDs = append(userIDs, utils.GetEntityIdFromPID(user))
+		}
+	}
+
+	badges, err := services.GetMessageBadges(context.TODO(), message, userIDs)
+	if err != nil {
+		slog.Error("failed to get message badges", "err", err)
+	}
+
+	for user, visible := range c.users {
+		if !visible {
+			continue
+		}
+
+		userMessage := proto.Clone(message).(*protoTypes.BroadcastChatMessage)
+		userBadges := badges[utils.GetEntityIdFromPID(user)]
+		userMessage.Unread = userBadges.Unread
+		userMessage.Mention = userBadges.Mention
+		UsersEngine.Send(user, userMessage)
+	}
+}
+
+func (c *channel) canView(channelID string, user *actor.PID) bool {
+	userID := utils.GetEntityIdFromPID(user)
+
+	abilities, err := services.GetChannelAbilities(context.TODO(), channelID, userID)
+	if err != nil {
+		return false
+	}
+
+	return abilities.Has(permissions.ViewChannel)
+}
+
 // USERS
 
 func (c *channel) Connect(ctx *actor.Context) {
@@ -19,26 +90,41 @@ func (c *channel) Connect(ctx *actor.Context) {
 	serverID := utils.GetEntityIdFromPID(ctx.Parent())
 
 	if _, ok := c.users[sender]; ok {
-		c.logger.Warn("user already connected", "user", ctx.Sender().GetID())
+		// another device of the user connected and needs the call as well
+		c.sendCallInitialization(sender, serverID, channelID)
 		return
 	}
-	c.users[sender] = true
+	c.users[sender] = c.canView(channelID, sender)
 	c.logger.Info("user connected", "sender", ctx.Sender().GetID(), "id", ctx.PID())
 
-	if len(c.call) > 0 {
-		var callUsers []*protoTypes.ConnectToCall
-		for _, v := range c.call {
-			callUsers = append(callUsers, &protoTypes.ConnectToCall{
-				UserId:    v.ID,
-				ServerId:  serverID,
-				ChannelId: channelID,
-			})
-		}
+	c.sendCallInitialization(sender, serverID, channelID)
+}
 
-		UsersEngine.Send(sender, &protoTypes.CallInitialization{
-			CallUsers: callUsers,
+func (c *channel) sendCallInitialization(user *actor.PID, serverID, channelID string) {
+	if len(c.call) == 0 || !c.users[user] {
+		return
+	}
+
+	var callUsers []*protoTypes.ConnectToCall
+	for _, v := range c.call {
+		callUsers = append(callUsers, &protoTypes.ConnectToCall{
+			UserId:     v.ID,
+			ServerId:   serverID,
+			ChannelId:  channelID,
+			Mute:       v.Mute,
+			Deafen:     v.Deafen,
+			ServerMute: v.ServerMute,
 		})
 	}
+
+	initialization := &protoTypes.CallInitialization{
+		CallUsers: callUsers,
+	}
+	if len(c.speakers) > 0 || len(c.hands) > 0 {
+		initialization.Stage = c.stageState(serverID, channelID)
+	}
+
+	UsersEngine.Send(user, initialization)
 }
 
 func (c *channel) Disconnect(ctx *actor.Context) {
@@ -55,38 +141,66 @@ func (c *channel) Disconnect(ctx *actor.Context) {
 	c.logger.Info("user disconnected", "sender", ctx.Sender(), "id", ctx.PID())
 	delete(c.users, sender)
 
-	if _, ok := c.call[senderID]; ok {
-		delete(c.call, senderID)
+	if _, ok := c.typing[senderID]; ok {
+		delete(c.typing, senderID)
+		c.broadcastTyping(&protoTypes.Typing{
+			UserId:    senderID,
+			ServerId:  serverID,
+			ChannelId: channelID,
+			Typing:    false,
+		})
+	}
 
-		for user := range c.users {
-			UsersEngine.Send(user, &protoTypes.DisconnectFromCall{
-				UserId:    senderID,
-				ChannelId: channelID,
-				ServerId:  serverID,
-			})
-		}
+	if _, ok := c.call[senderID]; ok {
+		c.leaveCall(serverID, channelID, senderID)
 	}
 }
 
 // MESSAGES
 
 func (c *channel) NewMessage(ctx *actor.Context, msg *protoTypes.IncomingChatMessage) {
+	if until, ok := c.timeouts[msg.AuthorId]; ok {
+		if time.Now().Before(until) {
+			c.logger.Warn("timed out user tried to send a message", "user", msg.AuthorId, "id", ctx.PID())
+			respondWithError(ctx, msg.RequestId, services.ErrMemberTimedOut)
+			return
+		}
+		delete(c.timeouts, msg.AuthorId)
+	}
+
+	if msg.ThreadId != "" {
+		threadPID := ctx.Child(ctx.PID().Child(fmt.Sprintf("thread/%s", msg.ThreadId)).ID)
+		if threadPID == nil {
+			respondWithError(ctx, msg.RequestId, services.ErrThreadNotFound)
+			return
+		}
+
+		ctx.Forward(threadPID)
+		return
+	}
+
 	messageToSend := &services.MessageBody{
 		Content:       msg.Content,
 		Everyone:      msg.Everyone,
 		MentionsUsers: msg.MentionsUsers,
+		MentionsRoles: msg.MentionsRoles,
 		Attachments:   msg.Attachments,
+		ReplyTo:       msg.ReplyTo,
 	}
 
 	message, err := services.CreateMessage(context.TODO(), msg.AuthorId, msg.ServerId, msg.ChannelId, messageToSend)
 	if err != nil {
 		slog.Error("failed to create message", "err", err)
+		respondWithError(ctx, msg.RequestId, err)
 		return
 	}
 
-	for user := range c.users {
-		UsersEngine.Send(user, message)
-	}
+	// clients drop the indicator as soon as the message shows up
+	delete(c.typing, msg.AuthorId)
+
+	c.broadcastMessage(message)
+	notifyMentions(message)
+	respond(ctx, &protoTypes.Ack{RequestId: msg.RequestId, Message: message})
 }
 
 func (c *channel) EditMessage(ctx *actor.Context, msg *protoTypes.EditChatMessage) {
@@ -94,56 +208,618 @@ func (c *channel) EditMessage(ctx *actor.Context, msg *protoTypes.EditChatMessag
 		Content:       msg.Content,
 		Everyone:      msg.Everyone,
 		MentionsUsers: msg.MentionsUsers,
+		MentionsRoles: msg.MentionsRoles,
 	}
 
 	message, err := services.EditMessage(context.TODO(), msg.UserId, msg.ServerId, msg.ChannelId, msg.MessageId, messageToEdit)
 	if err != nil {
-		slog.Error("failed to create message", "err", err)
+		slog.Error("failed to edit message", "err", err)
+		respondWithError(ctx, msg.RequestId, err)
 		return
 	}
 
-	for user := range c.users {
-		UsersEngine.Send(user, message)
-	}
+	c.broadcast(message)
+	respond(ctx, &protoTypes.Ack{RequestId: msg.RequestId, EditedMessage: message})
 }
 
 func (c *channel) DeleteMessage(ctx *actor.Context, msg *protoTypes.DeleteChatMessage) {
-	err := services.DeleteMessage(context.TODO(), msg.MessageId, msg.UserId)
+	err := services.DeleteMessage(context.TODO(), msg.ChannelId, msg.MessageId, msg.UserId)
 	if err != nil {
 		slog.Error("failed to delete message", "err", err)
+		respondWithError(ctx, msg.RequestId, err)
 		return
 	}
 
-	for user := range c.users {
-		UsersEngine.Send(user, msg)
+	c.broadcast(msg)
+	respond(ctx, &protoTypes.Ack{RequestId: msg.RequestId})
+}
+
+func (c *channel) AddReaction(ctx *actor.Context, msg *protoTypes.AddReaction) {
+	body := &services.ReactionBody{
+		Emoji:   msg.Emoji,
+		EmojiID: msg.EmojiId,
+	}
+
+	reaction, err := services.AddReaction(context.TODO(), msg.UserId, msg.ServerId, msg.ChannelId, msg.MessageId, body)
+	if err != nil {
+		slog.Error("failed to add reaction", "err", err)
+		reply(ctx, msg.RequestId, nil, err)
+		return
+	}
+
+	c.broadcast(reaction)
+	reply(ctx, msg.RequestId, &protoTypes.Ack{}, nil)
+}
+
+func (c *channel) RemoveReaction(ctx *actor.Context, msg *protoTypes.RemoveReaction) {
+	body := &services.ReactionBody{
+		Emoji:   msg.Emoji,
+		EmojiID: msg.EmojiId,
+	}
+
+	reaction, err := services.RemoveReaction(context.TODO(), msg.UserId, msg.ServerId, msg.ChannelId, msg.MessageId, body)
+	if err != nil {
+		slog.Error("failed to remove reaction", "err", err)
+		reply(ctx, msg.RequestId, nil, err)
+		return
 	}
+
+	c.broadcast(reaction)
+	reply(ctx, msg.RequestId, &protoTypes.Ack{}, nil)
 }
 
 func (c *channel) BroadcastUserInformations(ctx *actor.Context, msg *protoTypes.BroadcastUserInformations) {
-	for user := range c.users {
-		UsersEngine.Send(user, msg)
+	c.broadcast(msg)
+}
+
+// TYPING
+
+const (
+	// typingTimeout is how long a typing indicator lives without a new start
+	// signal from the client.
+	typingTimeout = 8 * time.Second
+	// typingThrottle is the minimum delay between two broadcasted start
+	// signals from the same user.
+	typingThrottle      = 2 * time.Second
+	typingSweepInterval = time.Second
+)
+
+type typingSweep struct{}
+
+// broadcastTyping reaches every user allowed to view the channel except the
+// one typing.
+func (c *channel) broadcastTyping(msg *protoTypes.Typing) {
+	for user, visible := range c.users {
+		if visible && utils.GetEntityIdFromPID(user) != msg.UserId {
+			UsersEngine.Send(user, msg)
+		}
+	}
+}
+
+func (c *channel) Typing(ctx *actor.Context, msg *protoTypes.Typing) {
+	requestID := msg.RequestId
+	msg.RequestId = ""
+
+	if !c.users[ctx.Sender()] {
+		respondWithError(ctx, requestID, ErrUnknownChannel)
+		return
+	}
+
+	now := time.Now()
+
+	if !msg.Typing {
+		if _, ok := c.typing[msg.UserId]; ok {
+			delete(c.typing, msg.UserId)
+			c.broadcastTyping(msg)
+		}
+		respond(ctx, &protoTypes.Ack{RequestId: requestID})
+		return
+	}
+
+	if until, ok := c.timeouts[msg.UserId]; ok && now.Before(until) {
+		respondWithError(ctx, requestID, services.ErrMemberTimedOut)
+		return
+	}
+
+	c.typing[msg.UserId] = now.Add(typingTimeout)
+	c.startTypingSweeper(ctx)
+	respond(ctx, &protoTypes.Ack{RequestId: requestID})
+
+	// a start within the throttle window only extends the indicator
+	if lastStart, ok := c.typingStarts[msg.UserId]; ok && now.Sub(lastStart) < typingThrottle {
+		return
+	}
+	c.typingStarts[msg.UserId] = now
+
+	c.broadcastTyping(msg)
+}
+
+func (c *channel) SweepTyping(ctx *actor.Context) {
+	channelID := utils.GetEntityIdFromPID(ctx.PID())
+	serverID := utils.GetEntityIdFromPID(ctx.Parent())
+	now := time.Now()
+
+	for userID, expiresAt := range c.typing {
+		if now.Before(expiresAt) {
+			continue
+		}
+
+		delete(c.typing, userID)
+		c.broadcastTyping(&protoTypes.Typing{
+			UserId:    userID,
+			ServerId:  serverID,
+			ChannelId: channelID,
+			Typing:    false,
+		})
+	}
+
+	for userID, lastStart := range c.typingStarts {
+		if now.Sub(lastStart) >= typingThrottle {
+			delete(c.typingStarts, userID)
+		}
+	}
+
+	if len(c.typing) == 0 && len(c.typingStarts) == 0 {
+		c.stopTypingSweeper()
+	}
+}
+
+func (c *channel) startTypingSweeper(ctx *actor.Context) {
+	if c.typingSweeper != nil {
+		return
+	}
+
+	sweeper := ctx.SendRepeat(ctx.PID(), typingSweep{}, typingSweepInterval)
+	c.typingSweeper = &sweeper
+}
+
+func (c *channel) stopTypingSweeper() {
+	if c.typingSweeper == nil {
+		return
+	}
+
+	c.typingSweeper.Stop()
+	c.typingSweeper = nil
+}
+
+// MODERATION
+
+func (c *channel) InitializeTimeouts(ctx *actor.Context) {
+	serverID := utils.GetEntityIdFromPID(ctx.Parent())
+	if serverID == "global" {
+		return
+	}
+
+	timeouts, err := db.Query.GetServerTimeouts(context.TODO(), serverID)
+	if err != nil {
+		slog.Error("failed to get timeouts", "err", err)
+		return
+	}
+
+	for _, timeout := range timeouts {
+		c.timeouts[timeout.UserID] = timeout.TimeoutUntil.Time
+	}
+}
+
+func (c *channel) UpdateTimeout(ctx *actor.Context, msg *protoTypes.BroadcastModerationAction) {
+	switch msg.Action {
+	case services.ModerationTimeout:
+		c.timeouts[msg.UserId] = msg.ExpiresAt.AsTime()
+	case services.ModerationRemoveTimeout:
+		delete(c.timeouts, msg.UserId)
+	}
+}
+
+// THREADS
+
+func (c *channel) InitializeThreads(ctx *actor.Context) {
+	channelID := utils.GetEntityIdFromPID(ctx.PID())
+
+	threads, err := db.Query.GetThreadsFromChannel(context.TODO(), channelID)
+	if err != nil {
+		slog.Error("failed to get threads", "err", err)
+		return
+	}
+
+	for _, thread := range threads {
+		ctx.SpawnChild(NewThread, "thread", actor.WithID(thread.ID))
+	}
+}
+
+func (c *channel) StartThread(ctx *actor.Context, msg *protoTypes.StartThread) {
+	body := &services.ThreadBody{
+		Name: msg.Name,
 	}
+
+	thread, err := services.CreateThread(context.TODO(), msg.UserId, msg.ServerId, msg.ChannelId, msg.MessageId, body)
+	if err != nil {
+		slog.Error("failed to create thread", "err", err)
+		reply(ctx, msg.RequestId, nil, err)
+		return
+	}
+
+	threadPID := ctx.SpawnChild(NewThread, "thread", actor.WithID(thread.Id))
+	thread.ActorId = threadPID.ID
+	thread.ActorAddress = threadPID.Address
+
+	c.broadcast(thread)
+	reply(ctx, msg.RequestId, &protoTypes.Ack{}, nil)
 }
 
 // CALL
 
-func (c *channel) ConnectToCall(ctx *actor.Context, msg *protoTypes.ConnectToCall) {
-	fmt.Println(msg.UserId)
+// callState returns what the channel knows about the user in its call, a
+// user not in the call yet counts the occupants they would join.
+func (c *channel) callState(userID string) services.CallState {
+	voiceUser, ok := c.call[userID]
+
+	state := services.CallState{
+		Speaker:    c.speakers[userID],
+		ServerMute: voiceUser.ServerMute,
+	}
+	if !ok {
+		state.Occupants = len(c.call)
+	}
+
+	return state
+}
+
+func (c *channel) joinCall(msg *protoTypes.ConnectToCall) {
 	c.call[msg.UserId] = VoiceUser{
-		ID:     msg.UserId,
-		Deafen: false,
-		Mute:   false,
+		ID:       msg.UserId,
+		Deafen:   msg.Deafen,
+		Mute:     msg.Mute,
+		JoinedAt: time.Now(),
+	}
+
+	c.broadcast(msg)
+}
+
+// leaveCall drops the user from the call and from the stage, the speakers and
+// raised hands are broadcasted again when they changed.
+func (c *channel) leaveCall(serverID, channelID, userID string) {
+	delete(c.call, userID)
+
+	c.broadcast(&protoTypes.DisconnectFromCall{
+		UserId:    userID,
+		ServerId:  serverID,
+		ChannelId: channelID,
+	})
+
+	if c.speakers[userID] || c.hands[userID] {
+		delete(c.speakers, userID)
+		delete(c.hands, userID)
+		c.broadcast(c.stageState(serverID, channelID))
+	}
+}
+
+// ConnectToCall is where the user limit is enforced, a token is only issued
+// by the channel since it is the one knowing who is in the call. Joins
+// reported by the livekit webhook come with no one to answer and only update
+// the call.
+func (c *channel) ConnectToCall(ctx *actor.Context, msg *protoTypes.ConnectToCall) {
+	requestID := msg.RequestId
+	msg.RequestId = ""
+
+	if msg.MovedFrom != "" {
+		c.ConnectMovedUser(ctx, requestID, msg)
+		return
+	}
+
+	_, inCall := c.call[msg.UserId]
+
+	if msg.Refresh {
+		if !inCall {
+			reply(ctx, requestID, nil, services.ErrNotInCall)
+			return
+		}
+
+		token, err := services.GenerateCallToken(context.TODO(), msg.ChannelId, msg.UserId, c.callState(msg.UserId))
+		if err != nil {
+			reply(ctx, requestID, nil, err)
+			return
+		}
+
+		reply(ctx, requestID, callTokenAck(token), nil)
+		return
 	}
 
-	for user := range c.users {
-		UsersEngine.Send(user, msg)
+	if requestID == "" && ctx.Sender() == nil {
+		// the client and the livekit webhook both report the same join
+		if voiceUser, ok := c.call[msg.UserId]; ok {
+			voiceUser.JoinedAt = time.Now()
+			c.call[msg.UserId] = voiceUser
+			return
+		}
+
+		err := services.CheckChannelAbility(context.TODO(), msg.ChannelId, msg.UserId, permissions.Connect)
+		if err != nil {
+			slog.Error("failed to connect to call", "err", err)
+			return
+		}
+
+		c.joinCall(msg)
+		return
+	}
+
+	token, err := services.GenerateCallToken(context.TODO(), msg.ChannelId, msg.UserId, c.callState(msg.UserId))
+	if err != nil {
+		reply(ctx, requestID, nil, err)
+		return
+	}
+
+	if inCall {
+		voiceUser := c.call[msg.UserId]
+		voiceUser.JoinedAt = time.Now()
+		c.call[msg.UserId] = voiceUser
+	} else {
+		c.joinCall(msg)
+	}
+
+	reply(ctx, requestID, callTokenAck(token), nil)
+}
+
+// ConnectMovedUser takes in a user moved by a moderator from another call of
+// the server and hands them the token to join this one. A moderator can move
+// someone in a full call. The user only leaves the call they were in once
+// they have the token, they stay there when it cannot be issued.
+func (c *channel) ConnectMovedUser(ctx *actor.Context, requestID string, msg *protoTypes.ConnectToCall) {
+	token, err := services.GenerateCallToken(context.TODO(), msg.ChannelId, msg.UserId, services.CallState{})
+	if err != nil {
+		reply(ctx, requestID, nil, err)
+		return
+	}
+
+	move := &protoTypes.MoveToCall{
+		UserId:        msg.UserId,
+		ServerId:      msg.ServerId,
+		FromChannelId: msg.MovedFrom,
+		ToChannelId:   msg.ChannelId,
+		CallToken:     token.Token,
+	}
+	msg.MovedFrom = ""
+
+	if _, ok := c.call[msg.UserId]; !ok {
+		c.joinCall(msg)
 	}
+
+	sourcePID := ServersEngine.Registry.GetPID(fmt.Sprintf("server/%s/channel", move.ServerId), move.FromChannelId)
+	ServersEngine.Send(sourcePID, &protoTypes.DisconnectFromCall{
+		UserId:    move.UserId,
+		ServerId:  move.ServerId,
+		ChannelId: move.FromChannelId,
+	})
+
+	userPID := UsersEngine.Registry.GetPID("user", msg.UserId)
+	UsersEngine.Send(userPID, move)
+
+	reply(ctx, requestID, &protoTypes.Ack{}, nil)
 }
 
+// DisconnectFromCall ignores departures that happened before the user last
+// joined, a webhook can arrive after the user already came back.
 func (c *channel) DisconnectFromCall(ctx *actor.Context, msg *protoTypes.DisconnectFromCall) {
-	delete(c.call, msg.UserId)
+	voiceUser, ok := c.call[msg.UserId]
+	if !ok {
+		return
+	}
+
+	if msg.LeftAt != nil && msg.LeftAt.AsTime().Before(voiceUser.JoinedAt.Truncate(time.Second)) {
+		return
+	}
+
+	c.leaveCall(msg.ServerId, msg.ChannelId, msg.UserId)
+}
+
+func (c *channel) EndCall(ctx *actor.Context, msg *protoTypes.CallEnded) {
+	for userID := range c.call {
+		delete(c.call, userID)
+
+		c.broadcast(&protoTypes.DisconnectFromCall{
+			UserId:    userID,
+			ServerId:  msg.ServerId,
+			ChannelId: msg.ChannelId,
+		})
+	}
 
-	for user := range c.users {
-		UsersEngine.Send(user, msg)
+	if len(c.speakers) > 0 || len(c.hands) > 0 {
+		clear(c.speakers)
+		clear(c.hands)
+		c.broadcast(c.stageState(msg.ServerId, msg.ChannelId))
+	}
+}
+
+// Mute applies both the mute a user sets on themselves and the one set by a
+// moderator, the latter being flagged as a server mute and taking the right
+// to speak away from the user.
+func (c *channel) Mute(ctx *actor.Context, msg *protoTypes.Mute) {
+	requestID := msg.RequestId
+	msg.RequestId = ""
+
+	voiceUser, ok := c.call[msg.UserId]
+	if !ok {
+		reply(ctx, requestID, nil, services.ErrNotInCall)
+		return
+	}
+
+	if !msg.Server {
+		voiceUser.Mute = msg.Status
+		c.call[msg.UserId] = voiceUser
+		c.broadcast(msg)
+		reply(ctx, requestID, &protoTypes.Ack{}, nil)
+		return
+	}
+
+	state := c.callState(msg.UserId)
+	state.ServerMute = msg.Status
+
+	err := services.ServerMuteMember(context.TODO(), msg.ModeratorId, msg.ServerId, msg.ChannelId, msg.UserId, state)
+	if err != nil {
+		reply(ctx, requestID, nil, err)
+		return
+	}
+
+	voiceUser.ServerMute = msg.Status
+	c.call[msg.UserId] = voiceUser
+
+	c.broadcast(msg)
+	reply(ctx, requestID, &protoTypes.Ack{}, nil)
+}
+
+func (c *channel) Deafen(ctx *actor.Context, msg *protoTypes.Deafen) {
+	requestID := msg.RequestId
+	msg.RequestId = ""
+
+	voiceUser, ok := c.call[msg.UserId]
+	if !ok {
+		reply(ctx, requestID, nil, services.ErrNotInCall)
+		return
+	}
+
+	voiceUser.Deafen = msg.Status
+	c.call[msg.UserId] = voiceUser
+
+	c.broadcast(msg)
+	reply(ctx, requestID, &protoTypes.Ack{}, nil)
+}
+
+// MoveFromCall hands a moved user over to the destination channel, keeping
+// the mute and deafen they chose. The server mute stays behind since the new
+// call token lets them speak again. The destination answers the moderator and
+// tells this channel when the user can leave.
+func (c *channel) MoveFromCall(ctx *actor.Context, msg *protoTypes.MoveToCall) {
+	voiceUser, ok := c.call[msg.UserId]
+	if !ok {
+		reply(ctx, "", nil, services.ErrNotInCall)
+		return
+	}
+
+	destinationPID := ServersEngine.Registry.GetPID(fmt.Sprintf("server/%s/channel", msg.ServerId), msg.ToChannelId)
+	if destinationPID == nil {
+		reply(ctx, "", nil, services.ErrChannelNotFound)
+		return
+	}
+
+	ServersEngine.SendWithSender(destinationPID, &protoTypes.ConnectToCall{
+		UserId:    msg.UserId,
+		ServerId:  msg.ServerId,
+		ChannelId: msg.ToChannelId,
+		Mute:      voiceUser.Mute,
+		Deafen:    voiceUser.Deafen,
+		MovedFrom: msg.FromChannelId,
+	}, ctx.Sender())
+}
+
+// STAGE
+
+func (c *channel) stageState(serverID, channelID string) *protoTypes.StageState {
+	return &protoTypes.StageState{
+		ServerId:    serverID,
+		ChannelId:   channelID,
+		Speakers:    slices.Sorted(maps.Keys(c.speakers)),
+		RaisedHands: slices.Sorted(maps.Keys(c.hands)),
+	}
+}
+
+// StageAction lets listeners raise their hand and stage managers choose who
+// speaks. The publish rights of a promoted or demoted user are updated right
+// away, one who did not reach the room yet gets them with their next token.
+func (c *channel) StageAction(ctx *actor.Context, msg *protoTypes.StageAction) {
+	requestID := msg.RequestId
+
+	err := services.CheckStageAction(context.TODO(), msg.ServerId, msg.ChannelId, msg.UserId, msg)
+	if err != nil {
+		reply(ctx, requestID, nil, err)
+		return
+	}
+
+	if _, ok := c.call[msg.TargetId]; !ok {
+		reply(ctx, requestID, nil, services.ErrNotInCall)
+		return
+	}
+
+	switch msg.Action {
+	case services.StageRaiseHand:
+		if c.speakers[msg.TargetId] || c.hands[msg.TargetId] {
+			reply(ctx, requestID, &protoTypes.Ack{}, nil)
+			return
+		}
+		c.hands[msg.TargetId] = true
+	case services.StageLowerHand:
+		if !c.hands[msg.TargetId] {
+			reply(ctx, requestID, &protoTypes.Ack{}, nil)
+			return
+		}
+		delete(c.hands, msg.TargetId)
+	case services.StageAddSpeaker, services.StageRemoveSpeaker:
+		speaker := msg.Action == services.StageAddSpeaker
+		if c.speakers[msg.TargetId] == speaker {
+			reply(ctx, requestID, &protoTypes.Ack{}, nil)
+			return
+		}
+
+		state := c.callState(msg.TargetId)
+		state.Speaker = speaker
+
+		err := services.UpdateCallPermissions(context.TODO(), msg.ChannelId, msg.TargetId, state)
+		if err != nil && !errors.Is(err, services.ErrNotInCall) {
+			reply(ctx, requestID, nil, err)
+			return
+		}
+
+		if speaker {
+			c.speakers[msg.TargetId] = true
+			delete(c.hands, msg.TargetId)
+		} else {
+			delete(c.speakers, msg.TargetId)
+		}
+	}
+
+	c.broadcast(c.stageState(msg.ServerId, msg.ChannelId))
+	reply(ctx, requestID, &protoTypes.Ack{}, nil)
+}
+
+// PERMISSIONS
+
+func (c *channel) RefreshPermissions(ctx *actor.Context, msg *protoTypes.RefreshChannelPermissions) {
+	channelID := utils.GetEntityIdFromPID(ctx.PID())
+
+	channel, err := db.Query.GetChannel(context.TODO(), channelID)
+	if err != nil {
+		slog.Error("failed to get channel", "err", err)
+		return
+	}
+
+	var userLimit *int32
+	if channel.UserLimit.Valid {
+		userLimit = &channel.UserLimit.Int32
+	}
+
+	for user, visible := range c.users {
+		canView := c.canView(channelID, user)
+		if canView == visible {
+			continue
+		}
+		c.users[user] = canView
+
+		UsersEngine.Send(user, &protoTypes.ChannelAccessChanged{
+			ServerId:  channel.ServerID,
+			ChannelId: channel.ID,
+			Visible:   canView,
+			Channel: &protoTypes.BroadcastChannelCreation{
+				Id:          channel.ID,
+				ServerId:    channel.ServerID,
+				Name:        channel.Name,
+				Type:        string(channel.Type),
+				Description: &channel.Description.String,
+				Users:       channel.Users,
+				Roles:       channel.Roles,
+				X:           channel.X,
+				Y:           channel.Y,
+				UserLimit:   userLimit,
+				CreatedAt:   timestamppb.New(channel.CreatedAt),
+				UpdatedAt:   timestamppb.New(channel.UpdatedAt),
+			},
+		})
 	}
 }
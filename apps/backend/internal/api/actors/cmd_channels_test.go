@@ -0,0 +1,45 @@
+package actors
+
+import (
+	"testing"
+	"time"
+
+	protoTypes "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDisconnectFromCallIgnoresStaleDeparture(t *testing.T) {
+	c := NewChannel().(*channel)
+	rejoinedAt := time.Now()
+	c.call["user"] = VoiceUser{JoinedAt: rejoinedAt}
+
+	// the webhook of the previous session arrives after the user came back
+	c.DisconnectFromCall(nil, &protoTypes.DisconnectFromCall{
+		UserId:    "user",
+		ServerId:  "server",
+		ChannelId: "channel",
+		LeftAt:    timestamppb.New(rejoinedAt.Add(-10 * time.Second)),
+	})
+
+	if _, ok := c.call["user"]; !ok {
+		t.Fatal("a departure older than the last join should be ignored")
+	}
+}
+
+func TestDisconnectFromCallAfterJoin(t *testing.T) {
+	c := NewChannel().(*channel)
+	joinedAt := time.Now()
+	c.call["user"] = VoiceUser{JoinedAt: joinedAt}
+
+	// livekit only gives the departure to the second
+	c.DisconnectFromCall(nil, &protoTypes.DisconnectFromCall{
+		UserId:    "user",
+		ServerId:  "server",
+		ChannelId: "channel",
+		LeftAt:    timestamppb.New(joinedAt.Truncate(time.Second)),
+	})
+
+	if _, ok := c.call["user"]; ok {
+		t.Fatal("a departure after the last join should remove the user")
+	}
+}
@@ -0,0 +1,390 @@
+package actors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/anthdm/hollywood/actor"
+	"github.com/lxzan/gws"
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+	protoTypes "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrInvalidClientMessage = errors.New("invalid client message")
+	ErrUnknownChannel       = errors.New("unknown channel")
+	ErrRequestTimeout       = errors.New("request timed out")
+)
+
+func requestErrorCode(err error) string {
+	switch {
+	case errors.Is(err, ErrInvalidClientMessage):
+		return "ERR_INVALID_REQUEST"
+	case errors.Is(err, ErrUnknownChannel), errors.Is(err, services.ErrChannelNotFound):
+		return "ERR_CHANNEL_NOT_FOUND"
+	case errors.Is(err, ErrRequestTimeout):
+		return "ERR_REQUEST_TIMEOUT"
+	case errors.Is(err, services.ErrMemberTimedOut):
+		return "ERR_TIMED_OUT"
+	case errors.Is(err, services.ErrInvalidReply):
+		return "ERR_INVALID_REPLY"
+	case errors.Is(err, services.ErrThreadNotFound):
+		return "ERR_THREAD_NOT_FOUND"
+	case errors.Is(err, services.ErrThreadAlreadyExists):
+		return "ERR_THREAD_EXISTS"
+	case errors.Is(err, services.ErrEmojiNotFound):
+		return "ERR_EMOJI_NOT_FOUND"
+	case errors.Is(err, services.ErrReactionExists):
+		return "ERR_REACTION_EXISTS"
+	case errors.Is(err, services.ErrReactionNotFound):
+		return "ERR_REACTION_NOT_FOUND"
+	case errors.Is(err, services.ErrNotVoiceChannel):
+		return "ERR_NOT_VOICE_CHANNEL"
+	case errors.Is(err, services.ErrNotStageChannel):
+		return "ERR_NOT_STAGE_CHANNEL"
+	case errors.Is(err, services.ErrInvalidStageAction):
+		return "ERR_INVALID_STAGE_ACTION"
+	case errors.Is(err, services.ErrCallFull):
+		return "ERR_CALL_FULL"
+	case errors.Is(err, services.ErrNotInCall):
+		return "ERR_NOT_IN_CALL"
+	case errors.Is(err, services.ErrInvalidAck):
+		return "ERR_INVALID_ACK"
+	case errors.Is(err, services.ErrInvalidStatus),
+		errors.Is(err, services.ErrInvalidCustomStatus):
+		return "ERR_INVALID_PRESENCE"
+	case errors.Is(err, services.ErrUnauthorizedMessageCreation),
+		errors.Is(err, services.ErrUnauthorizedMessageEdition),
+		errors.Is(err, services.ErrUnauthorizedMessageDeletion),
+		errors.Is(err, services.ErrUnauthorizedThreadCreation),
+		errors.Is(err, services.ErrUnauthorizedReaction),
+		errors.Is(err, services.ErrNotServerMember),
+		errors.Is(err, services.ErrMissingAbility):
+		return "ERR_UNAUTHORIZED"
+	default:
+		return "ERR_INTERNAL"
+	}
+}
+
+func newRequestError(requestID string, err error) *protoTypes.RequestError {
+	code := requestErrorCode(err)
+
+	message := err.Error()
+	if code == "ERR_INTERNAL" {
+		message = "something went wrong"
+	}
+
+	return &protoTypes.RequestError{
+		RequestId: requestID,
+		Code:      code,
+		Message:   message,
+	}
+}
+
+// respond acknowledges a websocket request. Commands coming from the REST
+// handlers carry no request id and no sender, so there is nobody to answer.
+func respond(ctx *actor.Context, ack *protoTypes.Ack) {
+	if ack.RequestId == "" || ctx.Sender() == nil {
+		return
+	}
+
+	UsersEngine.Send(ctx.Sender(), ack)
+}
+
+func respondWithError(ctx *actor.Context, requestID string, err error) {
+	if requestID == "" || ctx.Sender() == nil {
+		return
+	}
+
+	UsersEngine.Send(ctx.Sender(), newRequestError(requestID, err))
+}
+
+// reply answers a command that may come from either side. A websocket request
+// is answered on the socket of the user, a REST handler waiting on
+// Engine.Request sends no request id and gets the ack or the error itself.
+func reply(ctx *actor.Context, requestID string, ack *protoTypes.Ack, err error) {
+	if requestID == "" && ctx.Sender() != nil {
+		if err != nil {
+			ctx.Respond(err)
+		} else {
+			ctx.Respond(ack)
+		}
+		return
+	}
+
+	if err != nil {
+		respondWithError(ctx, requestID, err)
+		return
+	}
+
+	ack.RequestId = requestID
+	respond(ctx, ack)
+}
+
+func callTokenAck(token *services.LivekitResponse) *protoTypes.Ack {
+	return &protoTypes.Ack{
+		CallToken:          token.Token,
+		CallTokenExpiresAt: timestamppb.New(token.ExpiresAt),
+	}
+}
+
+// CLIENT REQUESTS
+
+func (u *user) channelPID(serverID, channelID string) (*actor.PID, error) {
+	if serverID == "" || channelID == "" {
+		return nil, ErrInvalidClientMessage
+	}
+
+	channelPID := ServersEngine.Registry.GetPID(fmt.Sprintf("server/%s/channel", serverID), channelID)
+	if channelPID == nil {
+		return nil, ErrUnknownChannel
+	}
+
+	for channel := range u.channels {
+		if channel.ID == channelPID.ID {
+			return channel, nil
+		}
+	}
+
+	return nil, ErrUnknownChannel
+}
+
+func (u *user) HandleClientMessage(ctx *actor.Context, conn *gws.Conn, msg *protoTypes.ClientMessage) {
+	userID := utils.GetEntityIdFromPID(ctx.PID())
+	if msg.RequestId != "" {
+		u.requests[msg.RequestId] = pendingRequest{conn: conn, sentAt: time.Now()}
+	}
+
+	switch content := msg.Content.(type) {
+	case *protoTypes.ClientMessage_SendMessage:
+		channelPID, err := u.channelPID(content.SendMessage.ServerId, content.SendMessage.ChannelId)
+		if err != nil {
+			u.SendRequestError(ctx, newRequestError(msg.RequestId, err))
+			return
+		}
+
+		content.SendMessage.AuthorId = userID
+		content.SendMessage.RequestId = msg.RequestId
+		ServersEngine.SendWithSender(channelPID, content.SendMessage, ctx.PID())
+	case *protoTypes.ClientMessage_EditMessage:
+		channelPID, err := u.channelPID(content.EditMessage.ServerId, content.EditMessage.ChannelId)
+		if err != nil {
+			u.SendRequestError(ctx, newRequestError(msg.RequestId, err))
+			return
+		}
+
+		content.EditMessage.UserId = userID
+		content.EditMessage.RequestId = msg.RequestId
+		ServersEngine.SendWithSender(channelPID, content.EditMessage, ctx.PID())
+	case *protoTypes.ClientMessage_DeleteMessage:
+		channelPID, err := u.channelPID(content.DeleteMessage.ServerId, content.DeleteMessage.ChannelId)
+		if err != nil {
+			u.SendRequestError(ctx, newRequestError(msg.RequestId, err))
+			return
+		}
+
+		content.DeleteMessage.UserId = userID
+		content.DeleteMessage.RequestId = msg.RequestId
+		ServersEngine.SendWithSender(channelPID, content.DeleteMessage, ctx.PID())
+	case *protoTypes.ClientMessage_Typing:
+		channelPID, err := u.channelPID(content.Typing.ServerId, content.Typing.ChannelId)
+		if err != nil {
+			u.SendRequestError(ctx, newRequestError(msg.RequestId, err))
+			return
+		}
+
+		// the channel knows the typing user by the sender, it is always given
+		content.Typing.UserId = userID
+		content.Typing.RequestId = msg.RequestId
+		ServersEngine.SendWithSender(channelPID, content.Typing, ctx.PID())
+	case *protoTypes.ClientMessage_VoiceState:
+		u.VoiceState(ctx, msg.RequestId, content.VoiceState)
+	case *protoTypes.ClientMessage_Mute:
+		channelPID, err := u.channelPID(content.Mute.ServerId, content.Mute.ChannelId)
+		if err != nil {
+			u.SendRequestError(ctx, newRequestError(msg.RequestId, err))
+			return
+		}
+
+		content.Mute.UserId = userID
+		content.Mute.Server = false
+		content.Mute.RequestId = msg.RequestId
+		u.request(ctx, channelPID, content.Mute, msg.RequestId)
+	case *protoTypes.ClientMessage_Deafen:
+		channelPID, err := u.channelPID(content.Deafen.ServerId, content.Deafen.ChannelId)
+		if err != nil {
+			u.SendRequestError(ctx, newRequestError(msg.RequestId, err))
+			return
+		}
+
+		content.Deafen.UserId = userID
+		content.Deafen.RequestId = msg.RequestId
+		u.request(ctx, channelPID, content.Deafen, msg.RequestId)
+	case *protoTypes.ClientMessage_Stage:
+		channelPID, err := u.channelPID(content.Stage.ServerId, content.Stage.ChannelId)
+		if err != nil {
+			u.SendRequestError(ctx, newRequestError(msg.RequestId, err))
+			return
+		}
+
+		content.Stage.UserId = userID
+		if content.Stage.TargetId == "" {
+			content.Stage.TargetId = userID
+		}
+		content.Stage.RequestId = msg.RequestId
+		u.request(ctx, channelPID, content.Stage, msg.RequestId)
+	case *protoTypes.ClientMessage_SetPresence:
+		u.SetPresence(ctx, msg.RequestId, content.SetPresence)
+	case *protoTypes.ClientMessage_AckChannel:
+		u.AckChannel(ctx, msg.RequestId, content.AckChannel)
+	default:
+		u.SendRequestError(ctx, newRequestError(msg.RequestId, ErrInvalidClientMessage))
+	}
+}
+
+func (u *user) VoiceState(ctx *actor.Context, requestID string, msg *protoTypes.VoiceState) {
+	userID := utils.GetEntityIdFromPID(ctx.PID())
+
+	channelPID, err := u.channelPID(msg.ServerId, msg.ChannelId)
+	if err != nil {
+		u.SendRequestError(ctx, newRequestError(requestID, err))
+		return
+	}
+
+	if !msg.Connected {
+		ServersEngine.Send(channelPID, &protoTypes.DisconnectFromCall{
+			UserId:    userID,
+			ServerId:  msg.ServerId,
+			ChannelId: msg.ChannelId,
+		})
+		u.SendAck(ctx, &protoTypes.Ack{RequestId: requestID})
+		return
+	}
+
+	// the channel answers with the call token, nobody would receive it
+	if requestID == "" {
+		u.SendRequestError(ctx, newRequestError(requestID, ErrInvalidClientMessage))
+		return
+	}
+
+	ServersEngine.SendWithSender(channelPID, &protoTypes.ConnectToCall{
+		UserId:    userID,
+		ServerId:  msg.ServerId,
+		ChannelId: msg.ChannelId,
+		RequestId: requestID,
+	}, ctx.PID())
+}
+
+// request forwards a command to the channel, the sender is only given when
+// there is a request to answer since the channel otherwise takes it for a
+// REST handler waiting on its answer.
+func (u *user) request(ctx *actor.Context, channelPID *actor.PID, msg any, requestID string) {
+	if requestID == "" {
+		ServersEngine.Send(channelPID, msg)
+		return
+	}
+
+	ServersEngine.SendWithSender(channelPID, msg, ctx.PID())
+}
+
+func (u *user) SetPresence(ctx *actor.Context, requestID string, msg *protoTypes.Presence) {
+	body := &services.PresenceBody{
+		Status:            msg.Status,
+		CustomStatusText:  msg.CustomStatusText,
+		CustomStatusEmoji: msg.CustomStatusEmoji,
+	}
+	if msg.CustomStatusExpiresAt != nil {
+		expiresAt := msg.CustomStatusExpiresAt.AsTime()
+		body.CustomStatusExpiresAt = &expiresAt
+	}
+
+	presence, err := services.UpdatePresence(context.TODO(), utils.GetEntityIdFromPID(ctx.PID()), body)
+	if err != nil {
+		u.SendRequestError(ctx, newRequestError(requestID, err))
+		return
+	}
+
+	u.PresenceChanged(ctx, presence)
+	u.SendAck(ctx, &protoTypes.Ack{RequestId: requestID})
+}
+
+func (u *user) AckChannel(ctx *actor.Context, requestID string, msg *protoTypes.ReadState) {
+	userID := utils.GetEntityIdFromPID(ctx.PID())
+
+	readState, err := services.AckChannel(context.TODO(), userID, msg.ChannelId, msg.LastReadMessageId)
+	if err != nil {
+		u.SendRequestError(ctx, newRequestError(requestID, err))
+		return
+	}
+
+	u.BroadcastReadState(ctx, readState)
+	u.SendAck(ctx, &protoTypes.Ack{RequestId: requestID})
+}
+
+func (u *user) SendAck(ctx *actor.Context, msg *protoTypes.Ack) {
+	if msg.RequestId == "" {
+		return
+	}
+
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_Ack{
+			Ack: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeResponse(msg.RequestId, m)
+}
+
+func (u *user) SendRequestError(ctx *actor.Context, msg *protoTypes.RequestError) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_Error{
+			Error: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeResponse(msg.RequestId, m)
+}
+
+// writeResponse answers a request on the socket that sent it, the other
+// devices of the user don't know about it.
+func (u *user) writeResponse(requestID string, m []byte) {
+	request, ok := u.requests[requestID]
+	if !ok {
+		u.writeMessage(m)
+		return
+	}
+
+	delete(u.requests, requestID)
+	request.conn.WriteMessage(gws.OpcodeBinary, m)
+}
+
+const (
+	// requestTimeout is how long a request waits for its answer before the
+	// client is told it failed.
+	requestTimeout       = 30 * time.Second
+	requestSweepInterval = 10 * time.Second
+)
+
+type requestSweep struct{}
+
+// SweepRequests fails the requests nobody answered, an actor that went away
+// or dropped the command would otherwise leave them pending forever.
+func (u *user) SweepRequests(ctx *actor.Context) {
+	now := time.Now()
+
+	for requestID, request := range u.requests {
+		if now.Sub(request.sentAt) < requestTimeout {
+			continue
+		}
+
+		u.logger.Warn("request timed out", "request", requestID, "id", ctx.PID())
+		u.SendRequestError(ctx, newRequestError(requestID, ErrRequestTimeout))
+	}
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/anthdm/hollywood/actor"
 	"github.com/okzmo/kyob/db"
 	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
 	services "github.com/okzmo/kyob/internal/service"
 	"github.com/okzmo/kyob/internal/utils"
 	protoTypes "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/proto"
 )
 
 // SERVER
@@ -46,29 +48,46 @@ func (s *server) Connect(ctx *actor.Context, msg *protoTypes.Connect) {
 	userID := utils.GetEntityIdFromPID(sender)
 	serverID := utils.GetEntityIdFromPID(ctx.PID())
 
+	presence := &protoTypes.Presence{UserId: userID, Status: string(queries.UserStatusOnline)}
+	if msg.Presence != nil {
+		presence = proto.Clone(msg.Presence).(*protoTypes.Presence)
+	}
+	presence.ServerId = serverID
+
 	if serverID == "global" {
 		friends, err := db.Query.GetFriends(context.TODO(), userID)
 		if err != nil {
 			slog.Error("failed to get friends", "err", err)
 			return
 		}
-		friendsIds := make([]string, len(friends))
+		s.presences[userID] = presence
+
+		friendsIds := make([]string, 0, len(friends))
+		var friendsPresences []*protoTypes.Presence
 
 		for _, user := range friends {
-			userPID := UsersEngine.Registry.GetPID("user", user.ID)
-			UsersEngine.Send(userPID, &protoTypes.BroadcastConnect{
-				ServerId: serverID,
-				UserId:   userID,
-				Type:     msg.Type,
-			})
+			if !services.IsInvisible(presence) {
+				userPID := UsersEngine.Registry.GetPID("user", user.ID)
+				UsersEngine.Send(userPID, &protoTypes.BroadcastConnect{
+					ServerId:  serverID,
+					UserId:    userID,
+					Type:      msg.Type,
+					Presences: []*protoTypes.Presence{presence},
+				})
+			}
+
+			if friendPresence, ok := s.presences[user.ID]; ok && !services.IsInvisible(friendPresence) {
+				friendsPresences = append(friendsPresences, friendPresence)
+			}
 
 			friendsIds = append(friendsIds, user.ID)
 		}
 
 		UsersEngine.Send(sender, &protoTypes.BroadcastConnect{
-			ServerId: serverID,
-			UserId:   userID,
-			Users:    friendsIds,
+			ServerId:  serverID,
+			UserId:    userID,
+			Users:     friendsIds,
+			Presences: friendsPresences,
 		})
 	} else {
 		if _, ok := s.users[sender]; ok {
@@ -76,26 +95,32 @@ func (s *server) Connect(ctx *actor.Context, msg *protoTypes.Connect) {
 			return
 		}
 		s.users[sender] = true
+		s.presences[userID] = presence
 		s.logger.Info("user connected to this server", "user", ctx.Sender().GetID(), "id", ctx.PID())
 
 		for user := range s.users {
 			if user == sender {
 				UsersEngine.Send(user, &protoTypes.BroadcastConnect{
-					ServerId: serverID,
-					UserId:   userID,
-					Users:    s.usersSlice,
+					ServerId:  serverID,
+					UserId:    userID,
+					Users:     s.usersSlice,
+					Presences: s.visiblePresences(),
 				})
-			} else {
+			} else if !services.IsInvisible(presence) {
 				UsersEngine.Send(user, &protoTypes.BroadcastConnect{
-					ServerId: serverID,
-					UserId:   userID,
-					Type:     msg.Type,
+					ServerId:  serverID,
+					UserId:    userID,
+					Type:      msg.Type,
+					Presences: []*protoTypes.Presence{presence},
 				})
 			}
 		}
 	}
 
-	s.usersSlice = append(s.usersSlice, userID)
+	// invisible users are connected but must appear offline to others
+	if !services.IsInvisible(presence) {
+		s.usersSlice = append(s.usersSlice, userID)
+	}
 
 	if msg.Type == "JOIN_SERVER" {
 		for _, channel := range ctx.Children() {
@@ -105,36 +130,221 @@ func (s *server) Connect(ctx *actor.Context, msg *protoTypes.Connect) {
 }
 
 func (s *server) Disconnect(ctx *actor.Context, msg *protoTypes.Disconnect) {
-	sender := ctx.Sender()
+	if utils.GetEntityIdFromPID(ctx.PID()) == "global" {
+		s.disconnectFromFriends(ctx, ctx.Sender(), msg.Type)
+		return
+	}
+
+	s.disconnect(ctx, ctx.Sender(), msg.Type, msg.Type == "LEAVE_SERVER")
+}
+
+// disconnect removes the user from the server and, when they are no longer a
+// member, from every channel of the server as well.
+func (s *server) disconnect(ctx *actor.Context, sender *actor.PID, disconnectType string, leaving bool) {
 	_, ok := s.users[sender]
 	if !ok {
 		s.logger.Warn("unknown user disconnected", "user", sender, "id", ctx.PID())
 		return
 	}
-	s.logger.Info("user disconnected", "sender", ctx.Sender(), "id", ctx.PID())
+	s.logger.Info("user disconnected", "sender", sender, "id", ctx.PID())
 
 	userID := utils.GetEntityIdFromPID(sender)
 	serverID := utils.GetEntityIdFromPID(ctx.PID())
 
 	idx := slices.Index(s.usersSlice, userID)
-	s.usersSlice = slices.Delete(s.usersSlice, idx, idx+1)
+	if idx != -1 {
+		s.usersSlice = slices.Delete(s.usersSlice, idx, idx+1)
+	}
 	delete(s.users, sender)
 
-	for user := range s.users {
-		UsersEngine.Send(user, &protoTypes.BroadcastDisconnect{
-			ServerId: serverID,
+	invisible := services.IsInvisible(s.presences[userID])
+	delete(s.presences, userID)
+
+	// a leaving member is always announced, an invisible one going offline
+	// was never seen online
+	if !invisible || leaving {
+		for user := range s.users {
+			UsersEngine.Send(user, &protoTypes.BroadcastDisconnect{
+				ServerId: serverID,
+				UserId:   userID,
+				Type:     disconnectType,
+			})
+		}
+	}
+
+	if leaving {
+		for _, channel := range ctx.Children() {
+			ServersEngine.SendWithSender(channel, &protoTypes.Disconnect{}, sender)
+		}
+	}
+}
+
+func (s *server) disconnectFromFriends(ctx *actor.Context, sender *actor.PID, disconnectType string) {
+	userID := utils.GetEntityIdFromPID(sender)
+
+	presence, ok := s.presences[userID]
+	if !ok {
+		return
+	}
+	delete(s.presences, userID)
+
+	idx := slices.Index(s.usersSlice, userID)
+	if idx != -1 {
+		s.usersSlice = slices.Delete(s.usersSlice, idx, idx+1)
+	}
+
+	if services.IsInvisible(presence) {
+		return
+	}
+
+	for _, friend := range s.presenceRecipients(ctx, userID) {
+		UsersEngine.Send(friend, &protoTypes.BroadcastDisconnect{
+			ServerId: "global",
 			UserId:   userID,
-			Type:     msg.Type,
+			Type:     disconnectType,
 		})
 	}
+}
+
+// PRESENCE
 
-	if msg.Type == "LEAVE_SERVER" {
+func (s *server) visiblePresences() []*protoTypes.Presence {
+	presences := make([]*protoTypes.Presence, 0, len(s.usersSlice))
+	for _, userID := range s.usersSlice {
+		if presence, ok := s.presences[userID]; ok {
+			presences = append(presences, presence)
+		}
+	}
+
+	return presences
+}
+
+// presenceRecipients returns the users who can see the given user's presence:
+// their friends for the global server, the other connected members otherwise.
+func (s *server) presenceRecipients(ctx *actor.Context, userID string) []*actor.PID {
+	var recipients []*actor.PID
+
+	if utils.GetEntityIdFromPID(ctx.PID()) == "global" {
+		friends, err := db.Query.GetFriends(context.TODO(), userID)
+		if err != nil {
+			slog.Error("failed to get friends", "err", err)
+			return nil
+		}
+
+		for _, friend := range friends {
+			if _, ok := s.presences[friend.ID]; ok {
+				recipients = append(recipients, UsersEngine.Registry.GetPID("user", friend.ID))
+			}
+		}
+
+		return recipients
+	}
+
+	for user := range s.users {
+		if utils.GetEntityIdFromPID(user) != userID {
+			recipients = append(recipients, user)
+		}
+	}
+
+	return recipients
+}
+
+func (s *server) UpdatePresence(ctx *actor.Context, msg *protoTypes.Presence) {
+	previous, ok := s.presences[msg.UserId]
+	if !ok {
+		return
+	}
+	s.presences[msg.UserId] = msg
+
+	wasInvisible := services.IsInvisible(previous)
+	isInvisible := services.IsInvisible(msg)
+
+	if wasInvisible && isInvisible {
+		return
+	}
+
+	var toSend any = msg
+	switch {
+	case !wasInvisible && isInvisible:
+		idx := slices.Index(s.usersSlice, msg.UserId)
+		if idx != -1 {
+			s.usersSlice = slices.Delete(s.usersSlice, idx, idx+1)
+		}
+
+		toSend = &protoTypes.BroadcastDisconnect{
+			ServerId: msg.ServerId,
+			UserId:   msg.UserId,
+			Type:     "DISCONNECTING",
+		}
+	case wasInvisible && !isInvisible:
+		s.usersSlice = append(s.usersSlice, msg.UserId)
+
+		toSend = &protoTypes.BroadcastConnect{
+			ServerId:  msg.ServerId,
+			UserId:    msg.UserId,
+			Type:      "CONNECTING",
+			Presences: []*protoTypes.Presence{msg},
+		}
+	}
+
+	for _, user := range s.presenceRecipients(ctx, msg.UserId) {
+		UsersEngine.Send(user, toSend)
+	}
+}
+
+// MODERATION
+
+func (s *server) Moderate(ctx *actor.Context, msg *protoTypes.BroadcastModerationAction) {
+	for user := range s.users {
+		UsersEngine.Send(user, msg)
+	}
+
+	switch msg.Action {
+	case services.ModerationKick, services.ModerationBan:
+		for user := range s.users {
+			if utils.GetEntityIdFromPID(user) == msg.UserId {
+				s.disconnect(ctx, user, msg.Action, true)
+				break
+			}
+		}
+	case services.ModerationTimeout, services.ModerationRemoveTimeout:
 		for _, channel := range ctx.Children() {
-			ServersEngine.SendWithSender(channel, &protoTypes.Disconnect{}, sender)
+			ServersEngine.Send(channel, msg)
+		}
+	}
+}
+
+// JOIN REQUESTS
+
+// sendToManagers only reaches the connected members able to handle join
+// requests.
+func (s *server) sendToManagers(ctx *actor.Context, msg any) {
+	serverID := utils.GetEntityIdFromPID(ctx.PID())
+
+	for user := range s.users {
+		userID := utils.GetEntityIdFromPID(user)
+		if services.CheckAbility(context.TODO(), serverID, userID, permissions.ManageServer) == nil {
+			UsersEngine.Send(user, msg)
 		}
 	}
 }
 
+func (s *server) NewJoinRequest(ctx *actor.Context, msg *protoTypes.JoinRequest) {
+	s.sendToManagers(ctx, msg)
+}
+
+func (s *server) JoinRequestResolved(ctx *actor.Context, msg *protoTypes.JoinRequestResolved) {
+	s.sendToManagers(ctx, &protoTypes.JoinRequestResolved{
+		Id:       msg.Id,
+		ServerId: msg.ServerId,
+		UserId:   msg.UserId,
+		Approved: msg.Approved,
+	})
+
+	userPID := UsersEngine.Registry.GetPID("user", msg.UserId)
+	UsersEngine.Send(userPID, msg)
+}
+
 // CHANNELS
 
 func (s *server) InitializeChannels(serverID string, ctx *actor.Context) {
@@ -182,6 +392,7 @@ func (s *server) CreateChannel(ctx *actor.Context, msg *protoTypes.BodyChannelCr
 		Roles:       msg.Roles,
 		X:           msg.X,
 		Y:           msg.Y,
+		UserLimit:   msg.UserLimit,
 	}
 
 	if msg.Id != "" {
@@ -262,7 +473,7 @@ func (s *server) CreateRole(ctx *actor.Context, msg *protoTypes.CreateRole) {
 		Index:     int(msg.Idx),
 	}
 
-	role, err := services.CreateRole(context.TODO(), msg.ServerId, body)
+	role, err := services.CreateRole(context.TODO(), msg.RequesterId, msg.ServerId, body)
 	if err != nil {
 		slog.Error("failed to create role", "err", err)
 		return
@@ -281,29 +492,37 @@ func (s *server) AddRoleMember(ctx *actor.Context, msg *protoTypes.AddRoleMember
 		RoleID: msg.Id,
 	}
 
-	err := services.AddRoleMember(context.TODO(), msg.ServerId, body)
+	err := services.AddRoleMember(context.TODO(), msg.RequesterId, msg.ServerId, body)
 	if err != nil {
 		slog.Error("failed to add role member", "err", err)
 		return
 	}
 
+	s.RefreshChannelsPermissions(ctx, msg.ServerId)
+
 	for user := range s.users {
 		UsersEngine.Send(user, msg)
 	}
 }
 
 func (s *server) RemoveRoleMember(ctx *actor.Context, msg *protoTypes.RemoveRoleMember) {
-	body := &services.BodyAddOrRemoveRole{
-		UserID: msg.UserId,
-		RoleID: msg.Id,
-	}
+	// without a user, the role has already been deleted and members only
+	// need to be notified
+	if msg.UserId != "" {
+		body := &services.BodyAddOrRemoveRole{
+			UserID: msg.UserId,
+			RoleID: msg.Id,
+		}
 
-	err := services.RemoveRoleMember(context.TODO(), msg.ServerId, body)
-	if err != nil {
-		slog.Error("failed to remove role member", "err", err)
-		return
+		err := services.RemoveRoleMember(context.TODO(), msg.RequesterId, msg.ServerId, body)
+		if err != nil {
+			slog.Error("failed to remove role member", "err", err)
+			return
+		}
 	}
 
+	s.RefreshChannelsPermissions(ctx, msg.ServerId)
+
 	for user := range s.users {
 		UsersEngine.Send(user, msg)
 	}
@@ -316,9 +535,9 @@ func (s *server) ChangeRoleRanking(ctx *actor.Context, msg *protoTypes.ChangeRol
 		To:     int(msg.To),
 	}
 
-	err := services.MoveRole(context.TODO(), body)
+	err := services.MoveRole(context.TODO(), msg.RequesterId, msg.ServerId, body)
 	if err != nil {
-		slog.Error("failed to remove role member", "err", err)
+		slog.Error("failed to move role", "err", err)
 		return
 	}
 
@@ -326,3 +545,12 @@ func (s *server) ChangeRoleRanking(ctx *actor.Context, msg *protoTypes.ChangeRol
 		UsersEngine.Send(user, msg)
 	}
 }
+
+func (s *server) RefreshChannelsPermissions(ctx *actor.Context, serverID string) {
+	for _, channel := range ctx.Children() {
+		ServersEngine.Send(channel, &protoTypes.RefreshChannelPermissions{
+			ServerId:  serverID,
+			ChannelId: utils.GetEntityIdFromPID(channel),
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package actors
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/anthdm/hollywood/actor"
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+	protoTypes "github.com/okzmo/kyob/types"
+)
+
+// MESSAGES
+
+func (t *thread) NewMessage(ctx *actor.Context, msg *protoTypes.IncomingChatMessage) {
+	messageToSend := &services.MessageBody{
+		Content:       msg.Content,
+		Everyone:      msg.Everyone,
+		MentionsUsers: msg.MentionsUsers,
+		MentionsRoles: msg.MentionsRoles,
+		Attachments:   msg.Attachments,
+		ReplyTo:       msg.ReplyTo,
+		ThreadID:      utils.GetEntityIdFromPID(ctx.PID()),
+	}
+
+	message, err := services.CreateMessage(context.TODO(), msg.AuthorId, msg.ServerId, msg.ChannelId, messageToSend)
+	if err != nil {
+		slog.Error("failed to create thread message", "err", err)
+		respondWithError(ctx, msg.RequestId, err)
+		return
+	}
+
+	// the parent channel fans the message out to the users allowed to view it
+	ctx.Send(ctx.Parent(), message)
+	notifyMentions(message)
+	respond(ctx, &protoTypes.Ack{RequestId: msg.RequestId, Message: message})
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/anthdm/hollywood/actor"
 	"github.com/lxzan/gws"
 	"github.com/okzmo/kyob/db"
 	queries "github.com/okzmo/kyob/db/gen_queries"
+	services "github.com/okzmo/kyob/internal/service"
 	"github.com/okzmo/kyob/internal/utils"
 	protoTypes "github.com/okzmo/kyob/types"
 	"google.golang.org/protobuf/proto"
@@ -18,6 +20,17 @@ func (u *user) InitializeUser(ctx *actor.Context) {
 	strSplit := strings.Split(ctx.PID().GetID(), "/")
 	userID := strSplit[len(strSplit)-1]
 
+	presence, err := services.GetPresence(context.TODO(), userID)
+	if err != nil {
+		u.logger.Error("failed to get presence", "id", userID, "err", err)
+		presence = &protoTypes.Presence{UserId: userID, Status: string(queries.UserStatusOnline)}
+	}
+	u.presence = presence
+	u.scheduleCustomStatusExpiry(ctx)
+
+	sweeper := ctx.SendRepeat(ctx.PID(), requestSweep{}, requestSweepInterval)
+	u.requestSweeper = &sweeper
+
 	servers, err := db.Query.GetServersFromUser(context.TODO(), userID)
 	if err != nil {
 		u.logger.Error("no servers found for the user with id", "id", userID, "err", err)
@@ -27,7 +40,7 @@ func (u *user) InitializeUser(ctx *actor.Context) {
 		serverPID := ServersEngine.Registry.GetPID("server", server.ID)
 		u.servers[serverPID] = true
 
-		ServersEngine.SendWithSender(serverPID, &protoTypes.Connect{Type: "CONNECTING"}, ctx.PID())
+		ServersEngine.SendWithSender(serverPID, &protoTypes.Connect{Type: "CONNECTING", Presence: u.presence}, ctx.PID())
 		var channels []queries.Channel
 
 		if server.ID == "global" {
@@ -46,6 +59,14 @@ func (u *user) InitializeUser(ctx *actor.Context) {
 }
 
 func (u *user) KillUser(ctx *actor.Context) {
+	if u.presenceTimer != nil {
+		u.presenceTimer.Stop()
+	}
+
+	if u.requestSweeper != nil {
+		u.requestSweeper.Stop()
+	}
+
 	for server := range u.servers {
 		ServersEngine.SendWithSender(server, &protoTypes.Disconnect{
 			Type: "DISCONNECTING",
@@ -59,10 +80,37 @@ func (u *user) KillUser(ctx *actor.Context) {
 	}
 }
 
+// writeMessage fans a message out to every socket of the user.
+func (u *user) writeMessage(m []byte) {
+	for conn := range u.conns {
+		conn.WriteMessage(gws.OpcodeBinary, m)
+	}
+}
+
+// AttachConnection adds a socket opened by another device of the user. The
+// channels are joined again so that they send their call to the new device.
+func (u *user) AttachConnection(ctx *actor.Context, conn *gws.Conn) {
+	u.conns[conn] = true
+
+	for channel := range u.channels {
+		ServersEngine.SendWithSender(channel, &protoTypes.Connect{Type: "CONNECTING"}, ctx.PID())
+	}
+}
+
+func (u *user) DetachConnection(ctx *actor.Context, conn *gws.Conn) {
+	delete(u.conns, conn)
+
+	for requestID, request := range u.requests {
+		if request.conn == conn {
+			delete(u.requests, requestID)
+		}
+	}
+}
+
 func (u *user) NewServer(ctx *actor.Context, msg *protoTypes.NewServerCreated) {
 	serverPid := actor.NewPID(msg.ActorAddress, msg.ActorId)
 	u.servers[serverPid] = true
-	ServersEngine.SendWithSender(serverPid, &protoTypes.Connect{Type: "CONNECTING"}, ctx.PID())
+	ServersEngine.SendWithSender(serverPid, &protoTypes.Connect{Type: "CONNECTING", Presence: u.presence}, ctx.PID())
 }
 
 func (u *user) BroadcastNewUserInServer(ctx *actor.Context, msg *protoTypes.BodyNewUserInServer) {
@@ -75,7 +123,7 @@ func (u *user) BroadcastNewUserInServer(ctx *actor.Context, msg *protoTypes.Body
 		},
 	}
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) BroadcastConnect(ctx *actor.Context, msg *protoTypes.BroadcastConnect) {
@@ -85,7 +133,7 @@ func (u *user) BroadcastConnect(ctx *actor.Context, msg *protoTypes.BroadcastCon
 		},
 	}
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) BroadcastDisconnect(ctx *actor.Context, msg *protoTypes.BroadcastDisconnect) {
@@ -95,7 +143,7 @@ func (u *user) BroadcastDisconnect(ctx *actor.Context, msg *protoTypes.Broadcast
 		},
 	}
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) BroadcastChannelCreation(ctx *actor.Context, msg *protoTypes.BroadcastChannelCreation) {
@@ -111,6 +159,7 @@ func (u *user) BroadcastChannelCreation(ctx *actor.Context, msg *protoTypes.Broa
 				Roles:       msg.Roles,
 				X:           msg.X,
 				Y:           msg.Y,
+				UserLimit:   msg.UserLimit,
 				CreatedAt:   msg.CreatedAt,
 				UpdatedAt:   msg.UpdatedAt,
 			},
@@ -122,7 +171,7 @@ func (u *user) BroadcastChannelCreation(ctx *actor.Context, msg *protoTypes.Broa
 	u.channels[channelPid] = true
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) BroadcastChannelRemoved(ctx *actor.Context, msg *protoTypes.BroadcastChannelRemoved) {
@@ -138,10 +187,30 @@ func (u *user) BroadcastChannelRemoved(ctx *actor.Context, msg *protoTypes.Broad
 	channelPid := actor.NewPID(msg.ActorAddress, msg.ActorId)
 	ServersEngine.SendWithSender(channelPid, &protoTypes.Disconnect{Type: "DISCONNECTING"}, ctx.PID())
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 	delete(u.channels, channelPid)
 }
 
+func (u *user) ChannelAccessChanged(ctx *actor.Context, msg *protoTypes.ChannelAccessChanged) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_ChannelRemoved{
+			ChannelRemoved: &protoTypes.BroadcastChannelRemoved{
+				ServerId:  msg.ServerId,
+				ChannelId: msg.ChannelId,
+			},
+		},
+	}
+
+	if msg.Visible {
+		msgToSend.Content = &protoTypes.WSMessage_ChannelCreation{
+			ChannelCreation: msg.Channel,
+		}
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
 func (u *user) ChannelKilled(ctx *actor.Context, msg *protoTypes.KillChannel) {
 	channelPid := actor.NewPID(msg.ActorAddress, msg.ActorId)
 	ServersEngine.SendWithSender(channelPid, &protoTypes.Disconnect{Type: "DISCONNECTING"}, ctx.PID())
@@ -156,7 +225,7 @@ func (u *user) BroadcastChatMessage(ctx *actor.Context, msg *protoTypes.Broadcas
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) BroadcastEditMessage(ctx *actor.Context, msg *protoTypes.BroadcastEditMessage) {
@@ -167,7 +236,7 @@ func (u *user) BroadcastEditMessage(ctx *actor.Context, msg *protoTypes.Broadcas
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) BroadcastDeleteMessage(ctx *actor.Context, msg *protoTypes.DeleteChatMessage) {
@@ -182,7 +251,102 @@ func (u *user) BroadcastDeleteMessage(ctx *actor.Context, msg *protoTypes.Delete
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastReactionAdded(ctx *actor.Context, msg *protoTypes.BroadcastReactionAdded) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_ReactionAdded{
+			ReactionAdded: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastReactionRemoved(ctx *actor.Context, msg *protoTypes.BroadcastReactionRemoved) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_ReactionRemoved{
+			ReactionRemoved: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) ModerationAction(ctx *actor.Context, msg *protoTypes.BroadcastModerationAction) {
+	userID := utils.GetEntityIdFromPID(ctx.PID())
+	removed := msg.Action == services.ModerationKick || msg.Action == services.ModerationBan
+
+	if msg.UserId == userID && removed {
+		serverPrefix := fmt.Sprintf("server/%s", msg.ServerId)
+		for server := range u.servers {
+			if server.ID == serverPrefix {
+				delete(u.servers, server)
+			}
+		}
+
+		for channel := range u.channels {
+			if strings.HasPrefix(channel.ID, serverPrefix+"/") {
+				delete(u.channels, channel)
+			}
+		}
+	}
+
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_ModerationAction{
+			ModerationAction: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastTyping(ctx *actor.Context, msg *protoTypes.Typing) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_Typing{
+			Typing: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastJoinRequest(ctx *actor.Context, msg *protoTypes.JoinRequest) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_JoinRequest{
+			JoinRequest: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastJoinRequestResolved(ctx *actor.Context, msg *protoTypes.JoinRequestResolved) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_JoinRequestResolved{
+			JoinRequestResolved: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastThreadCreation(ctx *actor.Context, msg *protoTypes.BroadcastThreadCreation) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_ThreadCreation{
+			ThreadCreation: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
 }
 
 func (u *user) FriendInvite(ctx *actor.Context, msg *protoTypes.SendFriendInvite) {
@@ -193,7 +357,7 @@ func (u *user) FriendInvite(ctx *actor.Context, msg *protoTypes.SendFriendInvite
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) AcceptFriend(ctx *actor.Context, msg *protoTypes.AcceptFriendInvite) {
@@ -204,7 +368,7 @@ func (u *user) AcceptFriend(ctx *actor.Context, msg *protoTypes.AcceptFriendInvi
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) DeleteFriend(ctx *actor.Context, msg *protoTypes.DeleteFriend) {
@@ -215,7 +379,7 @@ func (u *user) DeleteFriend(ctx *actor.Context, msg *protoTypes.DeleteFriend) {
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) ChangingUserInformations(ctx *actor.Context, msg *protoTypes.UserChangedInformations) {
@@ -239,6 +403,106 @@ func (u *user) ChangingUserInformations(ctx *actor.Context, msg *protoTypes.User
 	}
 }
 
+// PRESENCE
+
+type customStatusExpired struct{}
+
+func (u *user) PresenceChanged(ctx *actor.Context, msg *protoTypes.Presence) {
+	u.presence = msg
+	u.scheduleCustomStatusExpiry(ctx)
+
+	for server := range u.servers {
+		ServersEngine.SendWithSender(server, &protoTypes.Presence{
+			UserId:                msg.UserId,
+			ServerId:              utils.GetEntityIdFromPID(server),
+			Status:                msg.Status,
+			CustomStatusText:      msg.CustomStatusText,
+			CustomStatusEmoji:     msg.CustomStatusEmoji,
+			CustomStatusExpiresAt: msg.CustomStatusExpiresAt,
+		}, ctx.PID())
+	}
+
+	u.BroadcastPresence(ctx, msg)
+}
+
+// scheduleCustomStatusExpiry wakes the actor up once the custom status
+// expires so every server hears about it.
+func (u *user) scheduleCustomStatusExpiry(ctx *actor.Context) {
+	if u.presenceTimer != nil {
+		u.presenceTimer.Stop()
+		u.presenceTimer = nil
+	}
+
+	if u.presence.CustomStatusExpiresAt == nil {
+		return
+	}
+
+	pid := ctx.PID()
+	u.presenceTimer = time.AfterFunc(time.Until(u.presence.CustomStatusExpiresAt.AsTime()), func() {
+		UsersEngine.Send(pid, customStatusExpired{})
+	})
+}
+
+func (u *user) CustomStatusExpired(ctx *actor.Context) {
+	if u.presence.CustomStatusExpiresAt == nil || u.presence.CustomStatusExpiresAt.AsTime().After(time.Now()) {
+		return
+	}
+
+	presence, err := services.UpdatePresence(context.TODO(), u.presence.UserId, &services.PresenceBody{
+		Status: u.presence.Status,
+	})
+	if err != nil {
+		u.logger.Error("failed to clear custom status", "err", err)
+		return
+	}
+
+	u.PresenceChanged(ctx, presence)
+}
+
+func (u *user) BroadcastPresence(ctx *actor.Context, msg *protoTypes.Presence) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_Presence{
+			Presence: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastReadState(ctx *actor.Context, msg *protoTypes.ReadState) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_ReadState{
+			ReadState: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastNotification(ctx *actor.Context, msg *protoTypes.Notification) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_Notification{
+			Notification: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastNotificationsRead(ctx *actor.Context, msg *protoTypes.NotificationsRead) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_NotificationsRead{
+			NotificationsRead: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
 func (u *user) BroadcastUserInformations(ctx *actor.Context, msg *protoTypes.BroadcastUserInformations) {
 	msgToSend := &protoTypes.WSMessage{
 		Content: &protoTypes.WSMessage_UserChanged{
@@ -247,7 +511,7 @@ func (u *user) BroadcastUserInformations(ctx *actor.Context, msg *protoTypes.Bro
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) BroadcastConnectToCall(ctx *actor.Context, msg *protoTypes.ConnectToCall) {
@@ -258,7 +522,7 @@ func (u *user) BroadcastConnectToCall(ctx *actor.Context, msg *protoTypes.Connec
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) BroadcastDisconnectFromCall(ctx *actor.Context, msg *protoTypes.DisconnectFromCall) {
@@ -269,7 +533,51 @@ func (u *user) BroadcastDisconnectFromCall(ctx *actor.Context, msg *protoTypes.D
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastMute(ctx *actor.Context, msg *protoTypes.Mute) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_MuteUser{
+			MuteUser: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastDeafen(ctx *actor.Context, msg *protoTypes.Deafen) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_DeafenUser{
+			DeafenUser: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastMoveToCall(ctx *actor.Context, msg *protoTypes.MoveToCall) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_MoveToCall{
+			MoveToCall: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
+}
+
+func (u *user) BroadcastStage(ctx *actor.Context, msg *protoTypes.StageState) {
+	msgToSend := &protoTypes.WSMessage{
+		Content: &protoTypes.WSMessage_Stage{
+			Stage: msg,
+		},
+	}
+
+	m, _ := proto.Marshal(msgToSend)
+	u.writeMessage(m)
 }
 
 func (u *user) SendCallInitialization(ctx *actor.Context, msg *protoTypes.CallInitialization) {
@@ -280,7 +588,7 @@ func (u *user) SendCallInitialization(ctx *actor.Context, msg *protoTypes.CallIn
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) ServerInformationsChanged(ctx *actor.Context, msg *protoTypes.ServerChangedInformations) {
@@ -291,7 +599,7 @@ func (u *user) ServerInformationsChanged(ctx *actor.Context, msg *protoTypes.Ser
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) NewRoleCreated(ctx *actor.Context, msg *protoTypes.CreateRole) {
@@ -302,7 +610,7 @@ func (u *user) NewRoleCreated(ctx *actor.Context, msg *protoTypes.CreateRole) {
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) AddRoleMember(ctx *actor.Context, msg *protoTypes.AddRoleMember) {
@@ -313,7 +621,7 @@ func (u *user) AddRoleMember(ctx *actor.Context, msg *protoTypes.AddRoleMember)
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) RemoveRoleMember(ctx *actor.Context, msg *protoTypes.RemoveRoleMember) {
@@ -324,7 +632,7 @@ func (u *user) RemoveRoleMember(ctx *actor.Context, msg *protoTypes.RemoveRoleMe
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
 
 func (u *user) MoveRole(ctx *actor.Context, msg *protoTypes.ChangeRoleRanking) {
@@ -335,5 +643,5 @@ func (u *user) MoveRole(ctx *actor.Context, msg *protoTypes.ChangeRoleRanking) {
 	}
 
 	m, _ := proto.Marshal(msgToSend)
-	u.wsConn.WriteMessage(gws.OpcodeBinary, m)
+	u.writeMessage(m)
 }
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+func GetAuditLogs(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	query := r.URL.Query()
+
+	params := services.GetAuditLogsParams{
+		Action:   query.Get("action"),
+		ActorID:  query.Get("actor_id"),
+		TargetID: query.Get("target_id"),
+		Before:   query.Get("before"),
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l <= 0 {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid limit.")
+			return
+		}
+		params.Limit = l
+	}
+
+	page, err := services.GetAuditLogs(r.Context(), user.ID, serverID, &params)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, page)
+}
@@ -3,10 +3,10 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
 	"time"
 
-	"github.com/okzmo/kyob/db"
 	queries "github.com/okzmo/kyob/db/gen_queries"
 	services "github.com/okzmo/kyob/internal/service"
 	"github.com/okzmo/kyob/internal/utils"
@@ -15,6 +15,25 @@ import (
 type signInParams struct {
 	EmailOrUsername string `validate:"required" json:"email_or_username"`
 	Password        string `validate:"required" json:"password"`
+	DeviceName      string `validate:"max=255" json:"device_name"`
+}
+
+// sessionInfo describes the device signing in, the user agent stands for the
+// device when the client didn't name it.
+func sessionInfo(r *http.Request, deviceName string) services.SessionInfo {
+	if deviceName == "" {
+		deviceName = r.UserAgent()
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return services.SessionInfo{
+		DeviceName: deviceName,
+		IP:         ip,
+	}
 }
 
 func SignIn(w http.ResponseWriter, r *http.Request) {
@@ -32,7 +51,7 @@ func SignIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := services.SignIn(r.Context(), body.EmailOrUsername, body.Password)
+	token, challenge, err := services.SignIn(r.Context(), body.EmailOrUsername, body.Password, sessionInfo(r, body.DeviceName))
 	if err != nil {
 		switch {
 		case errors.Is(err, services.ErrUserNotFound):
@@ -45,15 +64,12 @@ func SignIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "token",
-		Value:    *token,
-		Path:     "/",
-		Expires:  time.Now().Add(30 * (24 * time.Hour)),
-		HttpOnly: true,
-		Secure:   false,
-		SameSite: http.SameSiteLaxMode,
-	})
+	if challenge != nil {
+		utils.RespondWithJSON(w, http.StatusAccepted, challenge)
+		return
+	}
+
+	utils.SetTokenCookie(w, *token, time.Now().Add(services.SessionDuration))
 	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
 }
 
@@ -62,6 +78,7 @@ type signUpParams struct {
 	Username    string `validate:"required,max=20" json:"username"`
 	DisplayName string `validate:"required,max=20" json:"display_name"`
 	Password    string `validate:"required,min=8,max=254" json:"password"`
+	DeviceName  string `validate:"max=255" json:"device_name"`
 }
 
 func SignUp(w http.ResponseWriter, r *http.Request) {
@@ -80,37 +97,111 @@ func SignUp(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := services.SignUp(r.Context(), body.Email, body.Username, body.DisplayName, body.Password, bodyURL, rpmUser.ID, rpmUser.AccessToken)
+	token, err := services.SignUp(r.Context(), body.Email, body.Username, body.DisplayName, body.Password, bodyURL, rpmUser.ID, rpmUser.AccessToken, sessionInfo(r, body.DeviceName))
 	if err != nil {
 		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "token",
-		Value:    *token,
-		Path:     "/",
-		Expires:  time.Now().Add(30 * (24 * time.Hour)),
-		HttpOnly: true,
-		Secure:   false,
-		SameSite: http.SameSiteLaxMode,
-	})
+	utils.SetTokenCookie(w, *token, time.Now().Add(services.SessionDuration))
 	utils.RespondWithJSON(w, http.StatusCreated, &DefaultResponse{Message: "success"})
 }
 
 func Logout(w http.ResponseWriter, r *http.Request) {
 	user := r.Context().Value("user").(queries.User)
-	err := db.Query.DeleteRememberMeToken(r.Context(), user.ID)
+	session := r.Context().Value("session").(queries.Token)
+
+	err := services.RevokeSession(r.Context(), user.ID, session.ID)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	connections.closeSessions(CloseSessionRevoked, session.ID)
+
+	utils.ClearTokenCookie(w)
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func RequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+
+	err := services.RequestEmailVerification(r.Context(), user)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrEmailAlreadyVerified):
+			utils.RespondWithError(w, http.StatusConflict, "Your email is already verified.", "ERR_EMAIL_ALREADY_VERIFIED")
+		case errors.Is(err, services.ErrTokenRequestTooSoon):
+			utils.RespondWithError(w, http.StatusTooManyRequests, "Please wait before asking for another email.", "ERR_TOKEN_REQUEST_TOO_SOON")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func ConfirmEmail(w http.ResponseWriter, r *http.Request) {
+	var body services.ConfirmTokenBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = services.ConfirmEmail(r.Context(), body.Token)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidToken):
+			utils.RespondWithError(w, http.StatusBadRequest, "This link is invalid or expired.", "ERR_INVALID_TOKEN")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var body services.RequestPasswordResetBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = services.RequestPasswordReset(r.Context(), body.Email)
 	if err != nil {
 		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:    "token",
-		Value:   "",
-		Path:    "/",
-		Expires: time.Now().Add(-30 * (24 * time.Hour)),
-	})
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var body services.ResetPasswordBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	revoked, err := services.ResetPassword(r.Context(), body.Token, body.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidToken):
+			utils.RespondWithError(w, http.StatusBadRequest, "This link is invalid or expired.", "ERR_INVALID_TOKEN")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	connections.closeSessions(CloseSessionRevoked, revoked...)
+
 	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
 }
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	queries "github.com/okzmo/kyob/db/gen_queries"
 	"github.com/okzmo/kyob/internal/api/actors"
+	"github.com/okzmo/kyob/internal/domain/permissions"
 	services "github.com/okzmo/kyob/internal/service"
 	"github.com/okzmo/kyob/internal/utils"
 	proto "github.com/okzmo/kyob/types"
@@ -23,7 +25,18 @@ func CreateChannel(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if body.UserLimit != nil && body.Type != queries.ChannelTypeVoice && body.Type != queries.ChannelTypeStage {
+		utils.RespondWithError(w, http.StatusBadRequest, "Only voice channels have a user limit.", "ERR_INVALID_USER_LIMIT")
+		return
+	}
+
 	user := r.Context().Value("user").(queries.User)
+	err = services.CheckAbility(r.Context(), serverID, user.ID, permissions.ManageChannels)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
 	channelMessage := &proto.BodyChannelCreation{
 		CreatorId:   user.ID,
 		ServerId:    serverID,
@@ -32,6 +45,7 @@ func CreateChannel(w http.ResponseWriter, r *http.Request) {
 		Description: body.Description,
 		X:           body.X,
 		Y:           body.Y,
+		UserLimit:   body.UserLimit,
 	}
 
 	serverPID := actors.ServersEngine.Registry.GetPID("server", serverID)
@@ -54,7 +68,11 @@ func EditChannel(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch {
 		case errors.Is(err, services.ErrUnauthorizedChannelEdition):
-			utils.RespondWithError(w, http.StatusUnauthorized, "You cannot edit this channel.")
+			utils.RespondWithError(w, http.StatusForbidden, "You cannot edit this channel.")
+		case errors.Is(err, services.ErrChannelNotFound):
+			utils.RespondWithError(w, http.StatusNotFound, "This channel doesn't exist.", "ERR_CHANNEL_NOT_FOUND")
+		case errors.Is(err, services.ErrInvalidUserLimit):
+			utils.RespondWithError(w, http.StatusBadRequest, "Only voice channels have a user limit.", "ERR_INVALID_USER_LIMIT")
 		default:
 			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
 		}
@@ -69,6 +87,12 @@ func DeleteChannel(w http.ResponseWriter, r *http.Request) {
 	channelID := chi.URLParam(r, "channel_id")
 	serverID := chi.URLParam(r, "server_id")
 
+	err := services.CheckAbility(r.Context(), serverID, user.ID, permissions.ManageChannels)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
 	protoMessage := &proto.BodyChannelRemoved{
 		ServerId:  serverID,
 		ChannelId: channelID,
@@ -80,27 +104,76 @@ func DeleteChannel(w http.ResponseWriter, r *http.Request) {
 	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
 }
 
+// channelRequestTimeout leaves the channel actor time to reach LiveKit.
+const channelRequestTimeout = 10 * time.Second
+
+// requestChannel sends a command to the channel actor and waits for its
+// answer, an error raised by the actor is returned as is.
+func requestChannel(serverID, channelID string, msg any) (*proto.Ack, error) {
+	channelPID := actors.ServersEngine.Registry.GetPID(fmt.Sprintf("server/%s/channel", serverID), channelID)
+	if channelPID == nil {
+		return nil, services.ErrChannelNotFound
+	}
+
+	res, err := actors.ServersEngine.Request(channelPID, msg, channelRequestTimeout).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	switch res := res.(type) {
+	case *proto.Ack:
+		return res, nil
+	case error:
+		return nil, res
+	default:
+		return nil, fmt.Errorf("unexpected channel answer %T", res)
+	}
+}
+
+func callTokenResponse(ack *proto.Ack) *services.LivekitResponse {
+	return &services.LivekitResponse{
+		Token:     ack.CallToken,
+		ExpiresAt: ack.CallTokenExpiresAt.AsTime(),
+	}
+}
+
 func ConnectToCall(w http.ResponseWriter, r *http.Request) {
 	user := r.Context().Value("user").(queries.User)
 	channelID := chi.URLParam(r, "channel_id")
 	serverID := chi.URLParam(r, "server_id")
 
-	token, err := services.GenerateCallToken(channelID, user.ID)
+	ack, err := requestChannel(serverID, channelID, &proto.ConnectToCall{
+		UserId:    user.ID,
+		ServerId:  serverID,
+		ChannelId: channelID,
+	})
 	if err != nil {
-		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		respondWithVoiceError(w, err)
 		return
 	}
 
-	protoMessage := &proto.ConnectToCall{
+	utils.RespondWithJSON(w, http.StatusOK, callTokenResponse(ack))
+}
+
+// RefreshCallToken issues a new token before the current one expires, the
+// abilities of the user are checked again.
+func RefreshCallToken(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	channelID := chi.URLParam(r, "channel_id")
+	serverID := chi.URLParam(r, "server_id")
+
+	ack, err := requestChannel(serverID, channelID, &proto.ConnectToCall{
 		UserId:    user.ID,
 		ServerId:  serverID,
 		ChannelId: channelID,
+		Refresh:   true,
+	})
+	if err != nil {
+		respondWithVoiceError(w, err)
+		return
 	}
 
-	channelPID := actors.ServersEngine.Registry.GetPID(fmt.Sprintf("server/%s/channel", serverID), channelID)
-	actors.ServersEngine.Send(channelPID, protoMessage)
-
-	utils.RespondWithJSON(w, http.StatusOK, &services.LivekitResponse{Token: token})
+	utils.RespondWithJSON(w, http.StatusOK, callTokenResponse(ack))
 }
 
 func DisconnectFromCall(w http.ResponseWriter, r *http.Request) {
@@ -119,3 +192,247 @@ func DisconnectFromCall(w http.ResponseWriter, r *http.Request) {
 
 	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
 }
+
+func UpdateVoiceState(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	channelID := chi.URLParam(r, "channel_id")
+	serverID := chi.URLParam(r, "server_id")
+	var body services.VoiceStateBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	channelPID := actors.ServersEngine.Registry.GetPID(fmt.Sprintf("server/%s/channel", serverID), channelID)
+	if channelPID == nil {
+		utils.RespondWithError(w, http.StatusNotFound, "This channel doesn't exist.", "ERR_CHANNEL_NOT_FOUND")
+		return
+	}
+
+	if body.Mute != nil {
+		actors.ServersEngine.Send(channelPID, &proto.Mute{
+			UserId:    user.ID,
+			Status:    *body.Mute,
+			ServerId:  serverID,
+			ChannelId: channelID,
+		})
+	}
+
+	if body.Deafen != nil {
+		actors.ServersEngine.Send(channelPID, &proto.Deafen{
+			UserId:    user.ID,
+			Status:    *body.Deafen,
+			ServerId:  serverID,
+			ChannelId: channelID,
+		})
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func respondWithVoiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrNotInCall):
+		utils.RespondWithError(w, http.StatusNotFound, "This user is not in this call.", "ERR_NOT_IN_CALL")
+	case errors.Is(err, services.ErrNotVoiceChannel):
+		utils.RespondWithError(w, http.StatusBadRequest, "This channel is not a voice channel.", "ERR_NOT_VOICE_CHANNEL")
+	case errors.Is(err, services.ErrInvalidMove):
+		utils.RespondWithError(w, http.StatusBadRequest, "This user is already in this channel.", "ERR_INVALID_MOVE")
+	case errors.Is(err, services.ErrCallFull):
+		utils.RespondWithError(w, http.StatusConflict, "This call is full.", "ERR_CALL_FULL")
+	case errors.Is(err, services.ErrNotStageChannel):
+		utils.RespondWithError(w, http.StatusBadRequest, "This channel is not a stage channel.", "ERR_NOT_STAGE_CHANNEL")
+	case errors.Is(err, services.ErrInvalidStageAction):
+		utils.RespondWithError(w, http.StatusBadRequest, "You can only raise or lower your own hand.", "ERR_INVALID_STAGE_ACTION")
+	default:
+		respondWithModerationError(w, err)
+	}
+}
+
+func ServerMuteMember(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	channelID := chi.URLParam(r, "channel_id")
+	serverID := chi.URLParam(r, "server_id")
+	userID := chi.URLParam(r, "user_id")
+	var body services.ServerMuteBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	_, err = requestChannel(serverID, channelID, &proto.Mute{
+		UserId:      userID,
+		Status:      body.Mute,
+		ServerId:    serverID,
+		ChannelId:   channelID,
+		Server:      true,
+		ModeratorId: user.ID,
+	})
+	if err != nil {
+		respondWithVoiceError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func MoveMember(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	channelID := chi.URLParam(r, "channel_id")
+	serverID := chi.URLParam(r, "server_id")
+	userID := chi.URLParam(r, "user_id")
+	var body services.MoveMemberBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	move, err := services.MoveMember(r.Context(), user.ID, serverID, channelID, userID, &body)
+	if err != nil {
+		respondWithVoiceError(w, err)
+		return
+	}
+
+	_, err = requestChannel(serverID, channelID, move)
+	if err != nil {
+		respondWithVoiceError(w, err)
+		return
+	}
+
+	err = services.CompleteMove(r.Context(), user.ID, move)
+	if err != nil {
+		respondWithVoiceError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func StageAction(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	channelID := chi.URLParam(r, "channel_id")
+	serverID := chi.URLParam(r, "server_id")
+	var body services.StageActionBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if body.TargetID == "" {
+		body.TargetID = user.ID
+	}
+
+	_, err = requestChannel(serverID, channelID, &proto.StageAction{
+		UserId:    user.ID,
+		ServerId:  serverID,
+		ChannelId: channelID,
+		Action:    body.Action,
+		TargetId:  body.TargetID,
+	})
+	if err != nil {
+		respondWithVoiceError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func AckChannel(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	channelID := chi.URLParam(r, "channel_id")
+	var body services.AckBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	readState, err := services.AckChannel(r.Context(), user.ID, channelID, body.MessageID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidAck):
+			utils.RespondWithError(w, http.StatusBadRequest, "This message is not in this channel.", "ERR_INVALID_ACK")
+		default:
+			respondWithPermissionError(w, err)
+		}
+		return
+	}
+
+	// every device of the user follows the same read marker
+	userPID := actors.UsersEngine.Registry.GetPID("user", user.ID)
+	actors.UsersEngine.Send(userPID, readState)
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
+
+func GetChannelOverwrites(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	channelID := chi.URLParam(r, "channel_id")
+	serverID := chi.URLParam(r, "server_id")
+
+	overwrites, err := services.GetChannelOverwrites(r.Context(), user.ID, serverID, channelID)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, overwrites)
+}
+
+func SetChannelOverwrite(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	channelID := chi.URLParam(r, "channel_id")
+	serverID := chi.URLParam(r, "server_id")
+	targetID := chi.URLParam(r, "target_id")
+	var body services.OverwriteBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	overwrite, err := services.SetChannelOverwrite(r.Context(), user.ID, serverID, channelID, targetID, &body)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
+	channelPID := actors.ServersEngine.Registry.GetPID(fmt.Sprintf("server/%s/channel", serverID), channelID)
+	actors.ServersEngine.Send(channelPID, &proto.RefreshChannelPermissions{
+		ServerId:  serverID,
+		ChannelId: channelID,
+	})
+
+	utils.RespondWithJSON(w, http.StatusOK, overwrite)
+}
+
+func DeleteChannelOverwrite(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	channelID := chi.URLParam(r, "channel_id")
+	serverID := chi.URLParam(r, "server_id")
+	targetID := chi.URLParam(r, "target_id")
+
+	err := services.DeleteChannelOverwrite(r.Context(), user.ID, serverID, channelID, targetID)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
+	channelPID := actors.ServersEngine.Registry.GetPID(fmt.Sprintf("server/%s/channel", serverID), channelID)
+	actors.ServersEngine.Send(channelPID, &proto.RefreshChannelPermissions{
+		ServerId:  serverID,
+		ChannelId: channelID,
+	})
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/okzmo/kyob/internal/api/actors"
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+const maxWebhookSize = 1 << 20
+
+// LivekitWebhook keeps the calls in sync with what LiveKit sees, clients that
+// crash or lose their connection never tell us they left.
+func LivekitWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookSize))
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, "Invalid payload.", "ERR_INVALID_PAYLOAD")
+		return
+	}
+
+	event, err := services.ReceiveLivekitWebhook(r.Header.Get("Authorization"), body)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidWebhookSignature):
+			utils.RespondWithError(w, http.StatusUnauthorized, "Invalid signature.", "ERR_INVALID_SIGNATURE")
+		default:
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid payload.", "ERR_INVALID_PAYLOAD")
+		}
+		return
+	}
+
+	callEvent, err := services.NewCallEvent(r.Context(), event)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrChannelNotFound):
+			// the channel is gone, there is no call left to fix
+			slog.Warn("livekit webhook for unknown room", "event", event.Event, "room", event.Room.GetName())
+			utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+		case errors.Is(err, services.ErrInvalidWebhookPayload):
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid payload.", "ERR_INVALID_PAYLOAD")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	if callEvent != nil {
+		channelPID := actors.ServersEngine.Registry.GetPID(fmt.Sprintf("server/%s/channel", callEvent.ServerID), callEvent.ChannelID)
+		if channelPID == nil {
+			// the call state lives in the channel actor, without one there is nothing to update
+			slog.Warn("livekit webhook for a channel without actor", "event", event.Event, "server", callEvent.ServerID, "channel", callEvent.ChannelID)
+		} else {
+			actors.ServersEngine.Send(channelPID, callEvent.Message)
+		}
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
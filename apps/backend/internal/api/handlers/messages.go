@@ -2,9 +2,12 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	queries "github.com/okzmo/kyob/db/gen_queries"
@@ -24,6 +27,9 @@ func CreateOrEditMessage(w http.ResponseWriter, r *http.Request) {
 	body.Type = r.FormValue("type")
 	body.Everyone = r.FormValue("everyone") == "true"
 	body.MentionsUsers = r.Form["mentions_users[]"]
+	body.MentionsRoles = r.Form["mentions_roles[]"]
+	body.ReplyTo = r.FormValue("reply_to")
+	body.ThreadID = r.FormValue("thread_id")
 	contentJSON := r.FormValue("content")
 	if err := json.Unmarshal([]byte(contentJSON), &body.Content); err != nil {
 		slog.Error(err.Error())
@@ -59,7 +65,10 @@ func CreateOrEditMessage(w http.ResponseWriter, r *http.Request) {
 			ChannelId:     channelID,
 			Everyone:      body.Everyone,
 			MentionsUsers: body.MentionsUsers,
+			MentionsRoles: body.MentionsRoles,
 			Attachments:   body.Attachments,
+			ReplyTo:       body.ReplyTo,
+			ThreadId:      body.ThreadID,
 		}
 
 		actors.ServersEngine.Send(channelPID, mess)
@@ -74,6 +83,7 @@ func CreateOrEditMessage(w http.ResponseWriter, r *http.Request) {
 			Everyone:      body.Everyone,
 			Content:       body.Content,
 			MentionsUsers: body.MentionsUsers,
+			MentionsRoles: body.MentionsRoles,
 		}
 		actors.ServersEngine.Send(channelPID, mess)
 	}
@@ -101,13 +111,236 @@ func DeleteMessage(w http.ResponseWriter, r *http.Request) {
 }
 
 func GetMessages(w http.ResponseWriter, r *http.Request) {
+	getMessages(w, r, "")
+}
+
+func GetThreadMessages(w http.ResponseWriter, r *http.Request) {
+	getMessages(w, r, chi.URLParam(r, "thread_id"))
+}
+
+func getMessages(w http.ResponseWriter, r *http.Request, threadID string) {
+	user := r.Context().Value("user").(queries.User)
 	channelID := chi.URLParam(r, "channel_id")
+	query := r.URL.Query()
+
+	params := services.GetMessagesParams{
+		ThreadID: threadID,
+		Before:   query.Get("before"),
+		After:    query.Get("after"),
+		Around:   query.Get("around"),
+	}
 
-	messages, err := services.GetMessages(r.Context(), channelID)
+	if limit := query.Get("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l <= 0 {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid limit.")
+			return
+		}
+		params.Limit = l
+	}
+
+	page, err := services.GetMessages(r.Context(), user.ID, channelID, &params)
 	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUnauthorizedMessagesAccess):
+			utils.RespondWithError(w, http.StatusForbidden, "You cannot read this channel.", "ERR_MISSING_ABILITY")
+		case errors.Is(err, services.ErrThreadNotFound):
+			utils.RespondWithError(w, http.StatusNotFound, "This thread doesn't exist.", "ERR_THREAD_NOT_FOUND")
+		case errors.Is(err, services.ErrInvalidMessagesCursor):
+			utils.RespondWithError(w, http.StatusBadRequest, "Only one of before, after or around can be used.", "ERR_INVALID_CURSOR")
+		case errors.Is(err, services.ErrMessagesCursorNotFound):
+			utils.RespondWithError(w, http.StatusNotFound, "This message doesn't exist.", "ERR_CURSOR_NOT_FOUND")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, page)
+}
+
+func respondWithReactionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrChannelNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "This channel doesn't exist.", "ERR_CHANNEL_NOT_FOUND")
+	case errors.Is(err, services.ErrUnauthorizedReaction):
+		utils.RespondWithError(w, http.StatusForbidden, "You cannot react to this message.", "ERR_UNAUTHORIZED")
+	case errors.Is(err, services.ErrEmojiNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "This emoji doesn't exist.", "ERR_EMOJI_NOT_FOUND")
+	case errors.Is(err, services.ErrReactionExists):
+		utils.RespondWithError(w, http.StatusConflict, "You already reacted with this emoji.", "ERR_REACTION_EXISTS")
+	case errors.Is(err, services.ErrReactionNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "You did not react with this emoji.", "ERR_REACTION_NOT_FOUND")
+	default:
 		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func AddReaction(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "server_id")
+	channelID := chi.URLParam(r, "channel_id")
+	messageID := chi.URLParam(r, "message_id")
+	var body services.ReactionBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	_, err = requestChannel(serverID, channelID, &proto.AddReaction{
+		UserId:    user.ID,
+		ServerId:  serverID,
+		ChannelId: channelID,
+		MessageId: messageID,
+		Emoji:     body.Emoji,
+		EmojiId:   body.EmojiID,
+	})
+	if err != nil {
+		respondWithReactionError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func RemoveReaction(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "server_id")
+	channelID := chi.URLParam(r, "channel_id")
+	messageID := chi.URLParam(r, "message_id")
+	var body services.ReactionBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	_, err = requestChannel(serverID, channelID, &proto.RemoveReaction{
+		UserId:    user.ID,
+		ServerId:  serverID,
+		ChannelId: channelID,
+		MessageId: messageID,
+		Emoji:     body.Emoji,
+		EmojiId:   body.EmojiID,
+	})
+	if err != nil {
+		respondWithReactionError(w, err)
 		return
 	}
 
-	utils.RespondWithJSON(w, http.StatusOK, messages)
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func CreateThread(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "server_id")
+	channelID := chi.URLParam(r, "channel_id")
+	messageID := chi.URLParam(r, "message_id")
+	var body services.ThreadBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	_, err = requestChannel(serverID, channelID, &proto.StartThread{
+		UserId:    user.ID,
+		ServerId:  serverID,
+		ChannelId: channelID,
+		MessageId: messageID,
+		Name:      body.Name,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrChannelNotFound):
+			utils.RespondWithError(w, http.StatusNotFound, "This channel doesn't exist.", "ERR_CHANNEL_NOT_FOUND")
+		case errors.Is(err, services.ErrUnauthorizedThreadCreation):
+			utils.RespondWithError(w, http.StatusForbidden, "You cannot start a thread on this message.", "ERR_UNAUTHORIZED")
+		case errors.Is(err, services.ErrThreadAlreadyExists):
+			utils.RespondWithError(w, http.StatusConflict, "This message already has a thread.", "ERR_THREAD_EXISTS")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, &DefaultResponse{Message: "success"})
+}
+
+func SearchMessages(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	query := r.URL.Query()
+
+	params := services.SearchMessagesParams{
+		Query:     query.Get("q"),
+		AuthorID:  query.Get("author_id"),
+		ServerID:  query.Get("server_id"),
+		ChannelID: query.Get("channel_id"),
+		Mentions:  query.Get("mentions"),
+		Cursor:    query.Get("cursor"),
+	}
+
+	if hasAttachment := query.Get("has_attachment"); hasAttachment != "" {
+		b, err := strconv.ParseBool(hasAttachment)
+		if err != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid has_attachment.")
+			return
+		}
+		params.HasAttachment = &b
+	}
+
+	for key, dst := range map[string]*time.Time{"since": &params.Since, "until": &params.Until} {
+		value := query.Get(key)
+		if value == "" {
+			continue
+		}
+
+		t, err := parseSearchDate(value)
+		if err != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("Invalid %s.", key))
+			return
+		}
+		*dst = t
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l <= 0 {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid limit.")
+			return
+		}
+		params.Limit = l
+	}
+
+	results, err := services.SearchMessages(r.Context(), user.ID, &params)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrEmptySearchQuery):
+			utils.RespondWithError(w, http.StatusBadRequest, "The search query cannot be empty.", "ERR_EMPTY_QUERY")
+		case errors.Is(err, services.ErrInvalidSearchRange):
+			utils.RespondWithError(w, http.StatusBadRequest, "The search range ends before it starts.", "ERR_INVALID_RANGE")
+		case errors.Is(err, services.ErrNotServerMember):
+			utils.RespondWithError(w, http.StatusForbidden, "You are not a member of this realm.", "ERR_NOT_MEMBER")
+		case errors.Is(err, services.ErrUnauthorizedMessagesAccess):
+			utils.RespondWithError(w, http.StatusForbidden, "You cannot read this channel.", "ERR_MISSING_ABILITY")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, results)
+}
+
+// parseSearchDate accepts either a full RFC 3339 timestamp or a plain date.
+func parseSearchDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.DateOnly, value)
 }
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/api/actors"
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+	proto "github.com/okzmo/kyob/types"
+)
+
+func respondWithModerationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrCannotModerateMember):
+		utils.RespondWithError(w, http.StatusBadRequest, "You cannot moderate yourself.", "ERR_CANNOT_MODERATE")
+	case errors.Is(err, services.ErrBanNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "This user is not banned.", "ERR_BAN_NOT_FOUND")
+	default:
+		respondWithPermissionError(w, err)
+	}
+}
+
+func sendModerationAction(serverID string, action *proto.BroadcastModerationAction) {
+	serverPID := actors.ServersEngine.Registry.GetPID("server", serverID)
+	actors.ServersEngine.Send(serverPID, action)
+}
+
+func KickMember(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	userID := chi.URLParam(r, "user_id")
+	var body services.KickBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	action, err := services.KickMember(r.Context(), user.ID, serverID, userID, &body)
+	if err != nil {
+		respondWithModerationError(w, err)
+		return
+	}
+
+	sendModerationAction(serverID, action)
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
+
+func BanMember(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	userID := chi.URLParam(r, "user_id")
+	var body services.BanBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	action, err := services.BanMember(r.Context(), user.ID, serverID, userID, &body)
+	if err != nil {
+		respondWithModerationError(w, err)
+		return
+	}
+
+	sendModerationAction(serverID, action)
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
+
+func UnbanMember(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	userID := chi.URLParam(r, "user_id")
+
+	action, err := services.UnbanMember(r.Context(), user.ID, serverID, userID)
+	if err != nil {
+		respondWithModerationError(w, err)
+		return
+	}
+
+	sendModerationAction(serverID, action)
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
+
+func GetBans(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+
+	bans, err := services.GetBans(r.Context(), user.ID, serverID)
+	if err != nil {
+		respondWithModerationError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, bans)
+}
+
+func TimeoutMember(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	userID := chi.URLParam(r, "user_id")
+	var body services.TimeoutBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	action, err := services.TimeoutMember(r.Context(), user.ID, serverID, userID, &body)
+	if err != nil {
+		respondWithModerationError(w, err)
+		return
+	}
+
+	sendModerationAction(serverID, action)
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
+
+func RemoveMemberTimeout(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	userID := chi.URLParam(r, "user_id")
+
+	action, err := services.RemoveMemberTimeout(r.Context(), user.ID, serverID, userID)
+	if err != nil {
+		respondWithModerationError(w, err)
+		return
+	}
+
+	sendModerationAction(serverID, action)
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
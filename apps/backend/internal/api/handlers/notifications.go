@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/api/actors"
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+func GetNotifications(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	query := r.URL.Query()
+
+	params := services.GetNotificationsParams{
+		Before:     query.Get("before"),
+		UnreadOnly: query.Get("unread") == "true",
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l <= 0 {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid limit.")
+			return
+		}
+		params.Limit = l
+	}
+
+	page, err := services.GetNotifications(r.Context(), user.ID, &params)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, page)
+}
+
+func MarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	var body services.MarkNotificationsReadBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	read, err := services.MarkNotificationsRead(r.Context(), user.ID, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	userPID := actors.UsersEngine.Registry.GetPID("user", user.ID)
+	actors.UsersEngine.Send(userPID, read)
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
+
+func UpdateServerNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	updateNotificationSettings(w, r, chi.URLParam(r, "id"), "")
+}
+
+func UpdateChannelNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	updateNotificationSettings(w, r, chi.URLParam(r, "server_id"), chi.URLParam(r, "channel_id"))
+}
+
+func ResetServerNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	resetNotificationSettings(w, r, chi.URLParam(r, "id"), "")
+}
+
+func ResetChannelNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	resetNotificationSettings(w, r, chi.URLParam(r, "server_id"), chi.URLParam(r, "channel_id"))
+}
+
+func updateNotificationSettings(w http.ResponseWriter, r *http.Request, serverID, channelID string) {
+	user := r.Context().Value("user").(queries.User)
+	var body services.NotificationSettingsBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	settings, err := services.UpdateNotificationSettings(r.Context(), user.ID, serverID, channelID, &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidNotificationLevel):
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid notification level.", "ERR_INVALID_NOTIFICATION_LEVEL")
+		case errors.Is(err, services.ErrInvalidMuteDuration):
+			utils.RespondWithError(w, http.StatusBadRequest, "Mute must end in the future.", "ERR_INVALID_MUTE")
+		default:
+			respondWithPermissionError(w, err)
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, settings)
+}
+
+func resetNotificationSettings(w http.ResponseWriter, r *http.Request, serverID, channelID string) {
+	user := r.Context().Value("user").(queries.User)
+
+	err := services.ResetNotificationSettings(r.Context(), user.ID, serverID, channelID)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
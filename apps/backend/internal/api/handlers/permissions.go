@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+func respondWithPermissionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrServerNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "This realm doesn't exist.", "ERR_SERVER_NOT_FOUND")
+	case errors.Is(err, services.ErrChannelNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "This channel doesn't exist.", "ERR_CHANNEL_NOT_FOUND")
+	case errors.Is(err, services.ErrRoleNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "This role doesn't exist.", "ERR_ROLE_NOT_FOUND")
+	case errors.Is(err, services.ErrNotServerMember):
+		utils.RespondWithError(w, http.StatusForbidden, "You are not a member of this realm.", "ERR_NOT_MEMBER")
+	case errors.Is(err, services.ErrMissingAbility):
+		utils.RespondWithError(w, http.StatusForbidden, "You don't have the permission to do this.", "ERR_MISSING_ABILITY")
+	case errors.Is(err, services.ErrRoleTooHigh):
+		utils.RespondWithError(w, http.StatusForbidden, "This role is higher than your highest role.", "ERR_ROLE_TOO_HIGH")
+	default:
+		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
+	queries "github.com/okzmo/kyob/db/gen_queries"
 	"github.com/okzmo/kyob/internal/api/actors"
 	services "github.com/okzmo/kyob/internal/service"
 	"github.com/okzmo/kyob/internal/utils"
@@ -20,12 +21,20 @@ func CreateRole(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	user := r.Context().Value("user").(queries.User)
+	err = services.CheckRoleCreation(r.Context(), serverID, user.ID, int32(body.Index), body.Abilities)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
 	message := &proto.CreateRole{
-		Name:      body.Name,
-		Idx:       int32(body.Index),
-		ServerId:  serverID,
-		Color:     body.Color,
-		Abilities: body.Abilities,
+		Name:        body.Name,
+		Idx:         int32(body.Index),
+		ServerId:    serverID,
+		Color:       body.Color,
+		Abilities:   body.Abilities,
+		RequesterId: user.ID,
 	}
 
 	serverPID := actors.ServersEngine.Registry.GetPID("server", serverID)
@@ -56,10 +65,18 @@ func AddRoleMember(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	user := r.Context().Value("user").(queries.User)
+	err = services.CheckRoleMemberManagement(r.Context(), serverID, user.ID, body.UserID, body.RoleID)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
 	message := &proto.AddRoleMember{
-		UserId:   body.UserID,
-		Id:       body.RoleID,
-		ServerId: serverID,
+		UserId:      body.UserID,
+		Id:          body.RoleID,
+		ServerId:    serverID,
+		RequesterId: user.ID,
 	}
 
 	serverPID := actors.ServersEngine.Registry.GetPID("server", serverID)
@@ -78,10 +95,18 @@ func RemoveRoleMember(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	user := r.Context().Value("user").(queries.User)
+	err = services.CheckRoleMemberManagement(r.Context(), serverID, user.ID, body.UserID, body.RoleID)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
 	message := &proto.RemoveRoleMember{
-		UserId:   body.UserID,
-		Id:       body.RoleID,
-		ServerId: serverID,
+		UserId:      body.UserID,
+		Id:          body.RoleID,
+		ServerId:    serverID,
+		RequesterId: user.ID,
 	}
 
 	serverPID := actors.ServersEngine.Registry.GetPID("server", serverID)
@@ -100,11 +125,19 @@ func MoveRole(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	user := r.Context().Value("user").(queries.User)
+	_, err = services.CheckRoleManagement(r.Context(), serverID, user.ID, body.RoleID)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
 	message := &proto.ChangeRoleRanking{
-		Id:       body.RoleID,
-		ServerId: serverID,
-		From:     int32(body.From),
-		To:       int32(body.To),
+		Id:          body.RoleID,
+		ServerId:    serverID,
+		From:        int32(body.From),
+		To:          int32(body.To),
+		RequesterId: user.ID,
 	}
 
 	serverPID := actors.ServersEngine.Registry.GetPID("server", serverID)
@@ -117,9 +150,11 @@ func DeleteRole(w http.ResponseWriter, r *http.Request) {
 	roleID := chi.URLParam(r, "role_id")
 	serverID := chi.URLParam(r, "id")
 
-	err := services.DeleteRole(r.Context(), roleID)
+	user := r.Context().Value("user").(queries.User)
+
+	err := services.DeleteRole(r.Context(), user.ID, serverID, roleID)
 	if err != nil {
-		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		respondWithPermissionError(w, err)
 		return
 	}
 
@@ -112,7 +112,12 @@ func UpdateServerProfile(w http.ResponseWriter, r *http.Request) {
 
 	err = services.UpdateServerProfile(r.Context(), serverID, &body)
 	if err != nil {
-		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		switch {
+		case errors.Is(err, services.ErrUnauthorizedServerEdition):
+			utils.RespondWithError(w, http.StatusForbidden, "You cannot edit this realm.", "ERR_MISSING_ABILITY")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
 		return
 	}
 
@@ -195,7 +200,12 @@ func UpdateServerAvatar(w http.ResponseWriter, r *http.Request) {
 
 	res, err := services.UpdateServerAvatar(r.Context(), serverID, fileData, fileHeader, &body)
 	if err != nil {
-		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		switch {
+		case errors.Is(err, services.ErrUnauthorizedServerEdition):
+			utils.RespondWithError(w, http.StatusForbidden, "You cannot edit this realm.", "ERR_MISSING_ABILITY")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
 		return
 	}
 
@@ -228,14 +238,126 @@ func DeleteServer(w http.ResponseWriter, r *http.Request) {
 
 func CreateServerInvite(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
+	query := r.URL.Query()
+
+	body := services.CreateInviteBody{
+		ExpiresIn: 15 * 60,
+	}
+
+	if expiresIn := query.Get("expires_in"); expiresIn != "" {
+		e, err := strconv.Atoi(expiresIn)
+		if err != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid expires_in.")
+			return
+		}
+		body.ExpiresIn = e
+	}
 
-	inviteID, err := services.CreateServerInvite(r.Context(), id)
+	if maxUses := query.Get("max_uses"); maxUses != "" {
+		m, err := strconv.Atoi(maxUses)
+		if err != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid max_uses.")
+			return
+		}
+		body.MaxUses = m
+	}
+
+	err := validate.Struct(body)
 	if err != nil {
-		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	invite, err := services.CreateServerInvite(r.Context(), id, &body)
+	if err != nil {
+		respondWithPermissionError(w, err)
 		return
 	}
 
-	utils.RespondWithJSON(w, http.StatusOK, &services.ServerInviteResponse{InviteLink: fmt.Sprintf("http://localhost:5173/invite/%s", *inviteID)})
+	utils.RespondWithJSON(w, http.StatusOK, &services.ServerInviteResponse{
+		InviteLink: fmt.Sprintf("http://localhost:5173/invite/%s", invite.Code),
+		Invite:     *invite,
+	})
+}
+
+func GetInvites(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+
+	invites, err := services.GetInvites(r.Context(), user.ID, serverID)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, invites)
+}
+
+func RevokeInvite(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	code := chi.URLParam(r, "code")
+
+	err := services.RevokeInvite(r.Context(), user.ID, serverID, code)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInviteNotFound):
+			utils.RespondWithError(w, http.StatusNotFound, "This invite doesn't exist.", "ERR_INVITE_NOT_FOUND")
+		default:
+			respondWithPermissionError(w, err)
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
+
+func SetVanityInvite(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	var body services.VanityInviteBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	invite, err := services.SetVanityInvite(r.Context(), user.ID, serverID, &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInviteCodeTaken):
+			utils.RespondWithError(w, http.StatusConflict, "This invite code is already taken.", "ERR_INVITE_CODE_TAKEN")
+		case errors.Is(err, services.ErrNoVanityInGlobal):
+			utils.RespondWithError(w, http.StatusBadRequest, "This realm cannot have a vanity invite.")
+		default:
+			respondWithPermissionError(w, err)
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &services.ServerInviteResponse{
+		InviteLink: fmt.Sprintf("http://localhost:5173/invite/%s", invite.Code),
+		Invite:     *invite,
+	})
+}
+
+func RemoveVanityInvite(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+
+	err := services.RemoveVanityInvite(r.Context(), user.ID, serverID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInviteNotFound):
+			utils.RespondWithError(w, http.StatusNotFound, "This realm has no vanity invite.", "ERR_INVITE_NOT_FOUND")
+		default:
+			respondWithPermissionError(w, err)
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
 }
 
 func JoinServer(w http.ResponseWriter, r *http.Request) {
@@ -247,35 +369,111 @@ func JoinServer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	server, err := services.JoinServer(r.Context(), body)
+	server, request, err := services.JoinServer(r.Context(), body)
 	if err != nil {
 		switch {
 		case errors.Is(err, services.ErrNoIDInInvite):
 			utils.RespondWithError(w, http.StatusBadRequest, "The invite url is invalid.", "ERR_INVITE_MISSING_ID")
 		case errors.Is(err, services.ErrServerNotFound):
 			utils.RespondWithError(w, http.StatusNotFound, "The given url doesn't match any existing realm.")
+		case errors.Is(err, services.ErrBannedFromServer):
+			utils.RespondWithError(w, http.StatusForbidden, "You are banned from this realm.", "ERR_BANNED")
+		case errors.Is(err, services.ErrAlreadyServerMember):
+			utils.RespondWithError(w, http.StatusConflict, "You are already a member of this realm.", "ERR_ALREADY_MEMBER")
 		default:
 			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
 
+	if request != nil {
+		serverPID := actors.ServersEngine.Registry.GetPID("server", request.ServerId)
+		actors.ServersEngine.Send(serverPID, request)
+
+		utils.RespondWithJSON(w, http.StatusAccepted, DefaultResponse{Message: "pending"})
+		return
+	}
+
 	user := r.Context().Value("user").(queries.User)
+	announceNewMember(server.ID, &proto.User{
+		Id:          user.ID,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		Avatar:      &user.Avatar.String,
+	})
 
-	userPID := actors.UsersEngine.Registry.GetPID("user", user.ID)
-	serverPID := actors.ServersEngine.Registry.GetPID("server", server.ID)
+	utils.RespondWithJSON(w, http.StatusOK, services.JoinServerResponse{Server: *server})
+}
+
+func announceNewMember(serverID string, user *proto.User) {
+	userPID := actors.UsersEngine.Registry.GetPID("user", user.Id)
+	serverPID := actors.ServersEngine.Registry.GetPID("server", serverID)
 	actors.ServersEngine.SendWithSender(serverPID, &proto.Connect{Type: "JOIN_SERVER"}, userPID)
 	actors.ServersEngine.Send(serverPID, &proto.BodyNewUserInServer{
-		ServerId: server.ID,
-		User: &proto.User{
-			Id:          user.ID,
-			Username:    user.Username,
-			DisplayName: user.DisplayName,
-			Avatar:      &user.Avatar.String,
-		},
+		ServerId: serverID,
+		User:     user,
 	})
+}
 
-	utils.RespondWithJSON(w, http.StatusOK, services.JoinServerResponse{Server: *server})
+func GetJoinRequests(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+
+	requests, err := services.GetJoinRequests(r.Context(), user.ID, serverID)
+	if err != nil {
+		respondWithPermissionError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, requests)
+}
+
+func respondWithJoinRequestError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrJoinRequestNotFound):
+		utils.RespondWithError(w, http.StatusNotFound, "This join request doesn't exist.", "ERR_JOIN_REQUEST_NOT_FOUND")
+	case errors.Is(err, services.ErrBannedFromServer):
+		utils.RespondWithError(w, http.StatusForbidden, "This user is banned from this realm.", "ERR_BANNED")
+	case errors.Is(err, services.ErrInviteNotFound):
+		utils.RespondWithError(w, http.StatusGone, "The invite used for this request is no longer valid.", "ERR_INVITE_EXPIRED")
+	default:
+		respondWithPermissionError(w, err)
+	}
+}
+
+func ApproveJoinRequest(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	requestID := chi.URLParam(r, "request_id")
+
+	resolved, member, err := services.ApproveJoinRequest(r.Context(), user.ID, serverID, requestID)
+	if err != nil {
+		respondWithJoinRequestError(w, err)
+		return
+	}
+
+	serverPID := actors.ServersEngine.Registry.GetPID("server", serverID)
+	actors.ServersEngine.Send(serverPID, resolved)
+	announceNewMember(serverID, member)
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
+}
+
+func DenyJoinRequest(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	serverID := chi.URLParam(r, "id")
+	requestID := chi.URLParam(r, "request_id")
+
+	resolved, err := services.DenyJoinRequest(r.Context(), user.ID, serverID, requestID)
+	if err != nil {
+		respondWithJoinRequestError(w, err)
+		return
+	}
+
+	serverPID := actors.ServersEngine.Registry.GetPID("server", serverID)
+	actors.ServersEngine.Send(serverPID, resolved)
+
+	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
 }
 
 func LeaveServer(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+func GetSessions(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	session := r.Context().Value("session").(queries.Token)
+
+	sessions, err := services.GetSessions(r.Context(), user.ID, session.ID)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, sessions)
+}
+
+// RevokeSession signs out one of the sessions of the user, its sockets are
+// closed right away.
+func RevokeSession(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	session := r.Context().Value("session").(queries.Token)
+	sessionID := chi.URLParam(r, "session_id")
+
+	err := services.RevokeSession(r.Context(), user.ID, sessionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrSessionNotFound):
+			utils.RespondWithError(w, http.StatusNotFound, "This session doesn't exist.", "ERR_SESSION_NOT_FOUND")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	connections.closeSessions(CloseSessionRevoked, sessionID)
+
+	if sessionID == session.ID {
+		utils.ClearTokenCookie(w)
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
+
+func RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	session := r.Context().Value("session").(queries.Token)
+
+	revoked, err := services.RevokeOtherSessions(r.Context(), user.ID, session.ID)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	connections.closeSessions(CloseSessionRevoked, revoked...)
+
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+func EnrollTwoFactor(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+
+	res, err := services.EnrollTwoFactor(r.Context(), user)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrTwoFactorAlreadyEnabled):
+			utils.RespondWithError(w, http.StatusConflict, "Two-factor authentication is already enabled.", "ERR_TWO_FACTOR_ALREADY_ENABLED")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, res)
+}
+
+func ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	user := r.Context().Value("user").(queries.User)
+	var body services.ConfirmTwoFactorBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	codes, err := services.ConfirmTwoFactor(r.Context(), user, body.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrTwoFactorAlreadyEnabled):
+			utils.RespondWithError(w, http.StatusConflict, "Two-factor authentication is already enabled.", "ERR_TWO_FACTOR_ALREADY_ENABLED")
+		case errors.Is(err, services.ErrTwoFactorNotEnrolled):
+			utils.RespondWithError(w, http.StatusBadRequest, "Enroll in two-factor authentication first.", "ERR_TWO_FACTOR_NOT_ENROLLED")
+		case errors.Is(err, services.ErrInvalidTwoFactorCode):
+			utils.RespondWithError(w, http.StatusUnauthorized, "This code is invalid.", "ERR_INVALID_TWO_FACTOR_CODE")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusOK, &services.ConfirmTwoFactorResponse{RecoveryCodes: codes})
+}
+
+func RedeemTwoFactorChallenge(w http.ResponseWriter, r *http.Request) {
+	var body services.RedeemChallengeBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	token, err := services.RedeemTwoFactorChallenge(r.Context(), &body, sessionInfo(r, body.DeviceName))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidChallenge):
+			utils.RespondWithError(w, http.StatusUnauthorized, "This sign in expired, please sign in again.", "ERR_INVALID_CHALLENGE")
+		case errors.Is(err, services.ErrInvalidTwoFactorCode):
+			utils.RespondWithError(w, http.StatusUnauthorized, "This code is invalid.", "ERR_INVALID_TWO_FACTOR_CODE")
+		case errors.Is(err, services.ErrTooManyTwoFactorCodes):
+			utils.RespondWithError(w, http.StatusTooManyRequests, "Too many codes tried, please wait before trying again.", "ERR_TOO_MANY_TWO_FACTOR_CODES")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	utils.SetTokenCookie(w, *token, time.Now().Add(services.SessionDuration))
+	utils.RespondWithJSON(w, http.StatusOK, &DefaultResponse{Message: "success"})
+}
@@ -106,6 +106,36 @@ func UpdateProfile(w http.ResponseWriter, r *http.Request) {
 	utils.RespondWithJSON(w, http.StatusOK, DefaultResponse{Message: "success"})
 }
 
+func UpdatePresence(w http.ResponseWriter, r *http.Request) {
+	var body services.PresenceBody
+
+	err := utils.ParseAndValidate(r, validate, &body)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user := r.Context().Value("user").(queries.User)
+	presence, err := services.UpdatePresence(r.Context(), user.ID, &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidStatus), errors.Is(err, services.ErrInvalidCustomStatus):
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid status.", "ERR_INVALID_PRESENCE")
+		default:
+			utils.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	userPID := actors.UsersEngine.Registry.GetPID("user", user.ID)
+	actors.UsersEngine.Send(userPID, &proto.UserChangedPresence{
+		UserId:   user.ID,
+		Presence: presence,
+	})
+
+	utils.RespondWithJSON(w, http.StatusOK, services.NewPresenceResponse(presence))
+}
+
 func UpdateAvatar(w http.ResponseWriter, r *http.Request) {
 	var body services.UpdateAvatarBody
 	var cropAvatar, cropBanner services.Crop
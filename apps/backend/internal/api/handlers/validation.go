@@ -11,6 +11,7 @@ var validate *validator.Validate
 func SetupValidation() {
 	validate = validator.New()
 	validate.RegisterValidation("emoji_shortcode", validateEmojiShortcode)
+	validate.RegisterValidation("invite_code", validateInviteCode)
 }
 
 func validateEmojiShortcode(fl validator.FieldLevel) bool {
@@ -24,3 +25,12 @@ func validateEmojiShortcode(fl validator.FieldLevel) bool {
 
 	return regexp.MustCompile(pattern).MatchString(shortcode)
 }
+
+func validateInviteCode(fl validator.FieldLevel) bool {
+	code := fl.Field().String()
+
+	// letters, digits and dashes, without leading or trailing dashes
+	pattern := `^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`
+
+	return regexp.MustCompile(pattern).MatchString(code)
+}
@@ -1,28 +1,198 @@
 package handlers
 
 import (
+	"context"
+	"errors"
 	"log/slog"
+	"maps"
 	"net/http"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/anthdm/hollywood/actor"
-	"github.com/go-chi/chi/v5"
 	"github.com/lxzan/gws"
+	queries "github.com/okzmo/kyob/db/gen_queries"
 	"github.com/okzmo/kyob/internal/api/actors"
+	services "github.com/okzmo/kyob/internal/service"
+	"github.com/okzmo/kyob/internal/utils"
+	proto "github.com/okzmo/kyob/types"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 const (
 	PingInterval = 10 * time.Second
 	PingWait     = 10 * time.Second
+	// SessionCheckInterval is how often the token behind every socket is
+	// checked again.
+	SessionCheckInterval = time.Minute
+)
+
+// Close codes sent when the session of a socket ends, clients sign in again
+// instead of reconnecting.
+const (
+	CloseSessionRevoked uint16 = 4001
+	CloseSessionExpired uint16 = 4002
 )
 
 var (
-	Upgrader *gws.Upgrader
-	usersMap map[*gws.Conn]*actor.PID
+	Upgrader    *gws.Upgrader
+	connections *userConnections
 )
 
+// socketSession is the actor of the user behind a socket and the session
+// they authenticated with.
+type socketSession struct {
+	userPID   *actor.PID
+	sessionID string
+}
+
+// userConnections tracks the sockets of every connected user, a user actor
+// is spawned with the first socket of the user and poisoned with the last.
+type userConnections struct {
+	mu      sync.Mutex
+	sockets map[*gws.Conn]socketSession
+	counts  map[string]int
+	// stopping holds the actors poisoned after their last socket closed, a
+	// new socket waits for them to be gone before spawning again.
+	stopping map[string]context.Context
+}
+
+func (c *userConnections) open(userID, sessionID string, socket *gws.Conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// the lock is released while waiting so that the other users can still
+	// connect, another socket of the user may poison the actor again meanwhile
+	for {
+		stopped, ok := c.stopping[userID]
+		if !ok {
+			break
+		}
+
+		c.mu.Unlock()
+		<-stopped.Done()
+		c.mu.Lock()
+
+		if c.stopping[userID] == stopped {
+			delete(c.stopping, userID)
+		}
+	}
+
+	var userPID *actor.PID
+	if c.counts[userID] == 0 {
+		userPID = actors.UsersEngine.Spawn(actors.NewUser(socket), "user", actor.WithID(userID))
+	} else {
+		userPID = actors.UsersEngine.Registry.GetPID("user", userID)
+		actors.UsersEngine.Send(userPID, actors.AttachConnection{Conn: socket})
+	}
+
+	c.sockets[socket] = socketSession{userPID: userPID, sessionID: sessionID}
+	c.counts[userID]++
+}
+
+func (c *userConnections) close(socket *gws.Conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	session, ok := c.sockets[socket]
+	if !ok {
+		return
+	}
+	delete(c.sockets, socket)
+	userPID := session.userPID
+
+	userID := utils.GetEntityIdFromPID(userPID)
+	c.counts[userID]--
+	if c.counts[userID] > 0 {
+		actors.UsersEngine.Send(userPID, actors.DetachConnection{Conn: socket})
+		return
+	}
+
+	delete(c.counts, userID)
+	stopped := actors.UsersEngine.Poison(userPID)
+	c.stopping[userID] = stopped
+
+	go func() {
+		<-stopped.Done()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.stopping[userID] == stopped {
+			delete(c.stopping, userID)
+		}
+	}()
+}
+
+func (c *userConnections) user(socket *gws.Conn) (*actor.PID, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	session, ok := c.sockets[socket]
+	return session.userPID, ok
+}
+
+// closeSessions closes every socket opened with one of the given sessions.
+func (c *userConnections) closeSessions(code uint16, sessionIDs ...string) {
+	c.mu.Lock()
+	var sockets []*gws.Conn
+	for socket, session := range c.sockets {
+		if slices.Contains(sessionIDs, session.sessionID) {
+			sockets = append(sockets, socket)
+		}
+	}
+	c.mu.Unlock()
+
+	reason := []byte(services.ErrSessionRevoked.Error())
+	if code == CloseSessionExpired {
+		reason = []byte(services.ErrSessionExpired.Error())
+	}
+
+	for _, socket := range sockets {
+		_ = socket.WriteClose(code, reason)
+	}
+}
+
+// revalidate closes the sockets whose session was revoked or expired since
+// they connected.
+func (c *userConnections) revalidate() {
+	for range time.Tick(SessionCheckInterval) {
+		c.mu.Lock()
+		sessions := make(map[string]bool)
+		for _, session := range c.sockets {
+			sessions[session.sessionID] = true
+		}
+		c.mu.Unlock()
+
+		if len(sessions) == 0 {
+			continue
+		}
+
+		invalid, err := services.CheckSessions(context.TODO(), slices.Collect(maps.Keys(sessions)))
+		if err != nil {
+			slog.Error("failed to check sessions", "err", err)
+			continue
+		}
+
+		for sessionID, err := range invalid {
+			code := CloseSessionRevoked
+			if errors.Is(err, services.ErrSessionExpired) {
+				code = CloseSessionExpired
+			}
+
+			c.closeSessions(code, sessionID)
+		}
+	}
+}
+
 func SetupWebsocket() {
-	usersMap = make(map[*gws.Conn]*actor.PID)
+	connections = &userConnections{
+		sockets:  make(map[*gws.Conn]socketSession),
+		counts:   make(map[string]int),
+		stopping: make(map[string]context.Context),
+	}
+	go connections.revalidate()
+
 	Upgrader = gws.NewUpgrader(&WSHandler{}, &gws.ServerOption{
 		ParallelEnabled:   true,
 		Recovery:          gws.Recovery,
@@ -37,9 +207,7 @@ func (c *WSHandler) OnOpen(socket *gws.Conn) {
 }
 
 func (c *WSHandler) OnClose(socket *gws.Conn, err error) {
-	userPID := usersMap[socket]
-	actors.UsersEngine.Poison(userPID)
-	delete(usersMap, socket)
+	connections.close(socket)
 }
 
 func (c *WSHandler) OnPing(socket *gws.Conn, payload []byte) {
@@ -55,18 +223,42 @@ func (c *WSHandler) OnMessage(socket *gws.Conn, message *gws.Message) {
 		c.OnPing(socket, nil)
 		return
 	}
+
+	userPID, ok := connections.user(socket)
+	if !ok {
+		return
+	}
+
+	var msg proto.ClientMessage
+	if err := protobuf.Unmarshal(message.Bytes(), &msg); err != nil {
+		m, _ := protobuf.Marshal(&proto.WSMessage{
+			Content: &proto.WSMessage_Error{
+				Error: &proto.RequestError{
+					Code:    "ERR_INVALID_PAYLOAD",
+					Message: "the message could not be decoded",
+				},
+			},
+		})
+		_ = socket.WriteMessage(gws.OpcodeBinary, m)
+		return
+	}
+
+	actors.UsersEngine.Send(userPID, actors.ClientRequest{Conn: socket, Message: &msg})
 }
 
+// WS connects the authenticated user, the socket belongs to whoever the
+// session token belongs to.
 func WS(w http.ResponseWriter, r *http.Request) {
-	idParam := chi.URLParam(r, "user_id")
+	user := r.Context().Value("user").(queries.User)
+	session := r.Context().Value("session").(queries.Token)
 
 	socket, err := Upgrader.Upgrade(w, r)
 	if err != nil {
 		slog.Error("failed upgrading connection", "err", err)
+		return
 	}
 
-	userPID := actors.UsersEngine.Spawn(actors.NewUser(socket), "user", actor.WithID(idParam))
-	usersMap[socket] = userPID
+	connections.open(user.ID, session.ID, socket)
 
 	go func() {
 		socket.ReadLoop()
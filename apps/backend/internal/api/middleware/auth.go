@@ -2,9 +2,11 @@ package middleware
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
+	"strings"
 
-	"github.com/okzmo/kyob/db"
+	services "github.com/okzmo/kyob/internal/service"
 	"github.com/okzmo/kyob/internal/utils"
 )
 
@@ -16,13 +18,24 @@ func Auth(next http.Handler) http.Handler {
 			return
 		}
 
-		user, err := db.Query.VerifyToken(r.Context(), token.Value)
+		session, err := services.Authenticate(r.Context(), token.Value)
 		if err != nil {
 			utils.RespondWithError(w, http.StatusUnauthorized, err.Error())
 			return
 		}
 
-		ctx := context.WithValue(r.Context(), "user", user)
+		// the upgrade response of a websocket cannot carry a new cookie
+		if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			renewed, expiresAt, err := services.RenewSession(r.Context(), &session.Token)
+			if err != nil {
+				slog.Error("failed to renew session", "err", err)
+			} else if renewed != "" {
+				utils.SetTokenCookie(w, renewed, expiresAt)
+			}
+		}
+
+		ctx := context.WithValue(r.Context(), "user", session.User)
+		ctx = context.WithValue(ctx, "session", session.Token)
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
@@ -27,23 +27,43 @@ func Setup() {
 
 	r.Route("/v1", func(r chi.Router) {
 		r.Post("/signin", handlers.SignIn)
+		r.Post("/signin/two_factor", handlers.RedeemTwoFactorChallenge)
 		r.Post("/signup", handlers.SignUp)
+		r.Post("/verify_email", handlers.ConfirmEmail)
+		r.Post("/password_reset", handlers.RequestPasswordReset)
+		r.Post("/password_reset/confirm", handlers.ResetPassword)
+		r.Post("/livekit/webhook", handlers.LivekitWebhook)
 		r.Route("/authenticated", func(r chi.Router) {
 			r.Use(mid.Auth)
-			r.Get("/connect/{user_id}", handlers.WS)
+			r.Get("/connect", handlers.WS)
 			r.Get("/setup", handlers.Setup)
 			r.Post("/save_state", handlers.SaveLastState)
 			r.Get("/user/{user_id}", handlers.GetUser)
 			r.Post("/user/update_account", handlers.UpdateAccount)
+			r.Post("/user/verify_email", handlers.RequestEmailVerification)
+			r.Post("/user/two_factor/enroll", handlers.EnrollTwoFactor)
+			r.Post("/user/two_factor/confirm", handlers.ConfirmTwoFactor)
 			r.Post("/user/update_avatar", handlers.UpdateAvatar)
 			r.Post("/user/update_profile", handlers.UpdateProfile)
+			r.Patch("/user/presence", handlers.UpdatePresence)
+			r.Get("/notifications", handlers.GetNotifications)
+			r.Post("/notifications/read", handlers.MarkNotificationsRead)
 			r.Post("/user/upload_emojis", handlers.UploadEmojis)
 			r.Patch("/user/update_emoji/{emoji_id}", handlers.UpdateEmoji)
 			r.Delete("/user/delete_emoji/{emoji_id}", handlers.DeleteEmoji)
 			r.Post("/server", handlers.CreateServer)
 			r.Post("/server/join", handlers.JoinServer)
 			r.Post("/server/{id}/leave", handlers.LeaveServer)
+			r.Get("/server/{id}/join_requests", handlers.GetJoinRequests)
+			r.Post("/server/{id}/join_requests/{request_id}/approve", handlers.ApproveJoinRequest)
+			r.Delete("/server/{id}/join_requests/{request_id}", handlers.DenyJoinRequest)
+			r.Put("/server/{id}/notification_settings", handlers.UpdateServerNotificationSettings)
+			r.Delete("/server/{id}/notification_settings", handlers.ResetServerNotificationSettings)
 			r.Get("/server/create_invite/{id}", handlers.CreateServerInvite)
+			r.Get("/server/{id}/invites", handlers.GetInvites)
+			r.Delete("/server/{id}/invites/{code}", handlers.RevokeInvite)
+			r.Put("/server/{id}/vanity", handlers.SetVanityInvite)
+			r.Delete("/server/{id}/vanity", handlers.RemoveVanityInvite)
 			r.Post("/server/update_avatar/{id}", handlers.UpdateServerAvatar)
 			r.Post("/server/update_profile/{id}", handlers.UpdateServerProfile)
 			r.Post("/server/create_role/{id}", handlers.CreateRole)
@@ -52,20 +72,46 @@ func Setup() {
 			r.Delete("/server/delete_role/{id}/{role_id}", handlers.DeleteRole)
 			r.Patch("/server/add_role_member/{id}", handlers.AddRoleMember)
 			r.Patch("/server/remove_role_member/{id}", handlers.RemoveRoleMember)
+			r.Post("/server/{id}/members/{user_id}/kick", handlers.KickMember)
+			r.Put("/server/{id}/members/{user_id}/timeout", handlers.TimeoutMember)
+			r.Delete("/server/{id}/members/{user_id}/timeout", handlers.RemoveMemberTimeout)
+			r.Get("/server/{id}/audit_log", handlers.GetAuditLogs)
+			r.Get("/server/{id}/bans", handlers.GetBans)
+			r.Put("/server/{id}/bans/{user_id}", handlers.BanMember)
+			r.Delete("/server/{id}/bans/{user_id}", handlers.UnbanMember)
 			r.Delete("/servers/{id}", handlers.DeleteServer)
 			r.Post("/channels/{server_id}", handlers.CreateChannel)
 			r.Patch("/channels/{channel_id}", handlers.EditChannel)
 			r.Delete("/channels/{server_id}/{channel_id}", handlers.DeleteChannel)
+			r.Get("/channels/{server_id}/{channel_id}/overwrites", handlers.GetChannelOverwrites)
+			r.Put("/channels/{server_id}/{channel_id}/overwrites/{target_id}", handlers.SetChannelOverwrite)
+			r.Delete("/channels/{server_id}/{channel_id}/overwrites/{target_id}", handlers.DeleteChannelOverwrite)
 			r.Post("/channels/{server_id}/{channel_id}/join_call", handlers.ConnectToCall)
 			r.Post("/channels/{server_id}/{channel_id}/quit_call", handlers.DisconnectFromCall)
+			r.Post("/channels/{server_id}/{channel_id}/call_token", handlers.RefreshCallToken)
+			r.Patch("/channels/{server_id}/{channel_id}/voice_state", handlers.UpdateVoiceState)
+			r.Put("/channels/{server_id}/{channel_id}/call/{user_id}/server_mute", handlers.ServerMuteMember)
+			r.Post("/channels/{server_id}/{channel_id}/call/{user_id}/move", handlers.MoveMember)
+			r.Post("/channels/{server_id}/{channel_id}/stage", handlers.StageAction)
+			r.Post("/channels/{server_id}/{channel_id}/ack", handlers.AckChannel)
+			r.Put("/channels/{server_id}/{channel_id}/notification_settings", handlers.UpdateChannelNotificationSettings)
+			r.Delete("/channels/{server_id}/{channel_id}/notification_settings", handlers.ResetChannelNotificationSettings)
+			r.Get("/messages/search", handlers.SearchMessages)
 			r.Get("/messages/{channel_id}", handlers.GetMessages)
+			r.Get("/messages/{channel_id}/threads/{thread_id}", handlers.GetThreadMessages)
 			r.Post("/messages/{server_id}/{channel_id}", handlers.CreateOrEditMessage)
 			r.Patch("/messages/{server_id}/{channel_id}/{message_id}", handlers.CreateOrEditMessage)
 			r.Delete("/messages/{server_id}/{channel_id}/{message_id}", handlers.DeleteMessage)
+			r.Post("/messages/{server_id}/{channel_id}/{message_id}/reactions", handlers.AddReaction)
+			r.Delete("/messages/{server_id}/{channel_id}/{message_id}/reactions", handlers.RemoveReaction)
+			r.Post("/threads/{server_id}/{channel_id}/{message_id}", handlers.CreateThread)
 			r.Post("/friends/add", handlers.AddFriend)
 			r.Post("/friends/accept", handlers.AcceptFriend)
 			r.Post("/friends/delete", handlers.DeleteFriend)
 			r.Post("/logout", handlers.Logout)
+			r.Get("/sessions", handlers.GetSessions)
+			r.Delete("/sessions", handlers.RevokeOtherSessions)
+			r.Delete("/sessions/{session_id}", handlers.RevokeSession)
 			r.Get("/rpm/assets", handlers.GetRPMAssets)
 			r.Patch("/rpm/avatar", handlers.UpdateRPMAvatar)
 		})
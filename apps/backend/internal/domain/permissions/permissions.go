@@ -13,4 +13,175 @@ const (
 	Mute              string = "MUTE"
 	AttachFiles       string = "ATTACH_FILES"
 	ManageMessages    string = "MANAGE_MESSAGES"
+	ViewAuditLog      string = "VIEW_AUDIT_LOG"
+	ViewChannel       string = "VIEW_CHANNEL"
+	SendMessages      string = "SEND_MESSAGES"
+	Connect           string = "CONNECT"
+	MoveMembers       string = "MOVE_MEMBERS"
+	Speak             string = "SPEAK"
+	Video             string = "VIDEO"
+	Screenshare       string = "SCREENSHARE"
+	PrioritySpeaker   string = "PRIORITY_SPEAKER"
+	ManageStage       string = "MANAGE_STAGE"
 )
+
+const (
+	OverwriteRole string = "role"
+	OverwriteUser string = "user"
+)
+
+var Overwritable = []string{ViewChannel, SendMessages, AttachFiles, Connect, Speak, Video, Screenshare}
+
+// MemberDefaults are the channel abilities every member holds without a role
+// granting them, the @everyone overwrite of a channel can still deny them.
+var MemberDefaults = []string{ViewChannel, SendMessages, AttachFiles, Connect, Speak, Video, Screenshare}
+
+type Role struct {
+	ID        string
+	Idx       int32
+	Abilities []string
+}
+
+type Abilities struct {
+	owner     bool
+	highest   int32
+	abilities map[string]bool
+	roles     map[string]bool
+}
+
+func Owner() *Abilities {
+	return &Abilities{owner: true}
+}
+
+// Resolve merges the abilities of every role held by a member. Roles are
+// ranked by idx, the lowest idx being the highest role.
+func Resolve(roles []Role) *Abilities {
+	a := &Abilities{
+		highest:   -1,
+		abilities: make(map[string]bool),
+		roles:     make(map[string]bool),
+	}
+
+	for _, role := range roles {
+		a.roles[role.ID] = true
+
+		if a.highest == -1 || role.Idx < a.highest {
+			a.highest = role.Idx
+		}
+
+		for _, ability := range role.Abilities {
+			a.abilities[ability] = true
+		}
+	}
+
+	return a
+}
+
+func (a *Abilities) IsOwner() bool {
+	return a.owner
+}
+
+func (a *Abilities) Has(ability string) bool {
+	return a.owner || a.abilities[Admin] || a.abilities[ability]
+}
+
+// Outranks reports whether the member's highest role sits above the given
+// role position.
+func (a *Abilities) Outranks(idx int32) bool {
+	if a.owner {
+		return true
+	}
+
+	return a.highest != -1 && a.highest < idx
+}
+
+// OutranksMember reports whether the member can moderate the target member:
+// the owner can never be moderated and members without roles can be
+// moderated by anyone holding a role.
+func (a *Abilities) OutranksMember(target *Abilities) bool {
+	if target.owner {
+		return false
+	}
+
+	if target.highest == -1 {
+		return a.owner || a.highest != -1
+	}
+
+	return a.Outranks(target.highest)
+}
+
+type Overwrite struct {
+	TargetID string
+	Type     string
+	Allow    []string
+	Deny     []string
+}
+
+type ChannelAbilities map[string]bool
+
+func (c ChannelAbilities) Has(ability string) bool {
+	return c[ability]
+}
+
+func AllChannelAbilities() ChannelAbilities {
+	c := make(ChannelAbilities, len(Overwritable))
+	for _, ability := range Overwritable {
+		c[ability] = true
+	}
+
+	return c
+}
+
+// InChannel applies channel overwrites on top of the member's abilities, in
+// order: the @everyone overwrite (whose target is the server id), the
+// member's roles, then the member itself. Denies are applied before allows
+// at each level, and nothing is granted without VIEW_CHANNEL.
+func (a *Abilities) InChannel(serverID, userID string, overwrites []Overwrite) ChannelAbilities {
+	if a.Has(Admin) {
+		return AllChannelAbilities()
+	}
+
+	c := make(ChannelAbilities, len(Overwritable))
+	for _, ability := range MemberDefaults {
+		c[ability] = true
+	}
+	for _, ability := range Overwritable {
+		if a.abilities[ability] {
+			c[ability] = true
+		}
+	}
+
+	var everyone, roles, member []Overwrite
+	for _, o := range overwrites {
+		switch {
+		case o.Type == OverwriteRole && o.TargetID == serverID:
+			everyone = append(everyone, o)
+		case o.Type == OverwriteRole && a.roles[o.TargetID]:
+			roles = append(roles, o)
+		case o.Type == OverwriteUser && o.TargetID == userID:
+			member = append(member, o)
+		}
+	}
+
+	for _, level := range [][]Overwrite{everyone, roles, member} {
+		for _, o := range level {
+			for _, ability := range o.Deny {
+				c[ability] = false
+			}
+		}
+
+		for _, o := range level {
+			for _, ability := range o.Allow {
+				c[ability] = true
+			}
+		}
+	}
+
+	if !c[ViewChannel] {
+		for ability := range c {
+			c[ability] = false
+		}
+	}
+
+	return c
+}
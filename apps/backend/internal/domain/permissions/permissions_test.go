@@ -0,0 +1,88 @@
+package permissions
+
+import "testing"
+
+func TestInChannelMemberDefaults(t *testing.T) {
+	member := Resolve(nil)
+	c := member.InChannel("server", "user", nil)
+
+	for _, ability := range MemberDefaults {
+		if !c.Has(ability) {
+			t.Fatalf("every member should hold %s", ability)
+		}
+	}
+
+	if !c.Has(Connect) {
+		t.Fatal("a member without roles or overwrites should be able to connect")
+	}
+}
+
+func TestInChannelRoleRestoresEveryoneDeny(t *testing.T) {
+	overwrites := []Overwrite{
+		{TargetID: "server", Type: OverwriteRole, Deny: []string{Speak, Video}},
+		{TargetID: "speaker", Type: OverwriteRole, Allow: []string{Speak}},
+	}
+
+	member := Resolve(nil)
+	c := member.InChannel("server", "user", overwrites)
+	if c.Has(Speak) || c.Has(Video) {
+		t.Fatal("the @everyone overwrite should deny SPEAK and VIDEO")
+	}
+
+	speaker := Resolve([]Role{{ID: "speaker", Idx: 1}})
+	c = speaker.InChannel("server", "user", overwrites)
+	if !c.Has(Speak) {
+		t.Fatal("the role overwrite should allow SPEAK over the @everyone one")
+	}
+	if c.Has(Video) {
+		t.Fatal("VIDEO should stay denied")
+	}
+}
+
+func TestInChannelAppliesOverwrites(t *testing.T) {
+	member := Resolve([]Role{{ID: "speaker", Idx: 1, Abilities: []string{Connect, Speak}}})
+
+	overwrites := []Overwrite{
+		{TargetID: "server", Type: OverwriteRole, Allow: []string{Connect, Video}},
+		{TargetID: "speaker", Type: OverwriteRole, Deny: []string{Video}},
+		{TargetID: "user", Type: OverwriteUser, Deny: []string{Speak}},
+	}
+
+	c := member.InChannel("server", "user", overwrites)
+	if !c.Has(Connect) {
+		t.Fatal("the @everyone overwrite should allow CONNECT")
+	}
+	if c.Has(Video) {
+		t.Fatal("the role overwrite should deny VIDEO over the @everyone one")
+	}
+	if c.Has(Speak) {
+		t.Fatal("the member overwrite should deny SPEAK over the role")
+	}
+}
+
+func TestInChannelWithoutView(t *testing.T) {
+	member := Resolve([]Role{{ID: "speaker", Idx: 1, Abilities: []string{Connect, Speak}}})
+
+	c := member.InChannel("server", "user", []Overwrite{
+		{TargetID: "server", Type: OverwriteRole, Deny: []string{ViewChannel}},
+	})
+
+	for _, ability := range Overwritable {
+		if c.Has(ability) {
+			t.Fatalf("nothing should be granted without VIEW_CHANNEL, got %s", ability)
+		}
+	}
+}
+
+func TestInChannelAdmin(t *testing.T) {
+	admin := Resolve([]Role{{ID: "admin", Idx: 1, Abilities: []string{Admin}}})
+	c := admin.InChannel("server", "user", []Overwrite{
+		{TargetID: "server", Type: OverwriteRole, Deny: []string{ViewChannel}},
+	})
+
+	for _, ability := range Overwritable {
+		if !c.Has(ability) {
+			t.Fatalf("an admin should hold %s whatever the overwrites", ability)
+		}
+	}
+}
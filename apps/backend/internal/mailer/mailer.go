@@ -0,0 +1,42 @@
+package mailer
+
+import (
+	"context"
+	"log/slog"
+	"os"
+)
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
+
+var Default Mailer
+
+// Setup sends the mails through the SMTP server of the environment, they are
+// only logged when none is configured.
+func Setup() {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		slog.Warn("SMTP_HOST is not set, mails won't be sent")
+		Default = NewLogMailer()
+		return
+	}
+
+	Default = NewSMTPMailer(
+		host,
+		os.Getenv("SMTP_PORT"),
+		os.Getenv("SMTP_USERNAME"),
+		os.Getenv("SMTP_PASSWORD"),
+		os.Getenv("SMTP_FROM"),
+	)
+}
+
+func Send(ctx context.Context, mail Mail) error {
+	return Default.Send(ctx, mail)
+}
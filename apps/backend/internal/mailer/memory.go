@@ -0,0 +1,39 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryMailer keeps the mails instead of sending them, for tests.
+type MemoryMailer struct {
+	mu    sync.Mutex
+	mails []Mail
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(ctx context.Context, mail Mail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mails = append(m.mails, mail)
+	return nil
+}
+
+// Mails returns the mails sent to the given address, oldest first.
+func (m *MemoryMailer) Mails(to string) []Mail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var mails []Mail
+	for _, mail := range m.mails {
+		if mail.To == to {
+			mails = append(mails, mail)
+		}
+	}
+
+	return mails
+}
@@ -0,0 +1,112 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type SMTPMailer struct {
+	host string
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(host, port, username, password, from string) *SMTPMailer {
+	if port == "" {
+		port = "587"
+	}
+
+	m := &SMTPMailer{
+		host: host,
+		addr: net.JoinHostPort(host, port),
+		from: from,
+	}
+
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return m
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, mail Mail) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: m.host})
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.auth != nil {
+		err = client.Auth(m.auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(m.from)
+	if err != nil {
+		return err
+	}
+
+	err = client.Rcpt(mail.To)
+	if err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(m.message(mail))
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (m *SMTPMailer) message(mail Mail) []byte {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", m.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", mail.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+
+	return msg.Bytes()
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+const (
+	AuditServerUpdate       = "SERVER_UPDATE"
+	AuditRoleCreate         = "ROLE_CREATE"
+	AuditRoleDelete         = "ROLE_DELETE"
+	AuditRoleMove           = "ROLE_MOVE"
+	AuditRoleMemberAdd      = "ROLE_MEMBER_ADD"
+	AuditRoleMemberRemove   = "ROLE_MEMBER_REMOVE"
+	AuditChannelCreate      = "CHANNEL_CREATE"
+	AuditChannelDelete      = "CHANNEL_DELETE"
+	AuditMemberKick         = "MEMBER_KICK"
+	AuditMemberBan          = "MEMBER_BAN"
+	AuditMemberUnban        = "MEMBER_UNBAN"
+	AuditMemberTimeout      = "MEMBER_TIMEOUT"
+	AuditMemberTimeoutClear = "MEMBER_TIMEOUT_REMOVE"
+	AuditInviteRevoke       = "INVITE_REVOKE"
+	AuditVanityUpdate       = "VANITY_UPDATE"
+	AuditJoinRequestApprove = "JOIN_REQUEST_APPROVE"
+	AuditJoinRequestDeny    = "JOIN_REQUEST_DENY"
+	AuditMemberServerMute   = "MEMBER_SERVER_MUTE"
+	AuditMemberMove         = "MEMBER_MOVE"
+)
+
+const (
+	AuditTargetServer  = "server"
+	AuditTargetRole    = "role"
+	AuditTargetChannel = "channel"
+	AuditTargetUser    = "user"
+	AuditTargetInvite  = "invite"
+)
+
+const (
+	DefaultAuditLogsLimit = 50
+	MaxAuditLogsLimit     = 100
+)
+
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+type AuditChanges map[string]AuditChange
+
+type AuditEntry struct {
+	ServerID   string
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Changes    AuditChanges
+	Reason     string
+}
+
+type GetAuditLogsParams struct {
+	Action   string
+	ActorID  string
+	TargetID string
+	Before   string
+	Limit    int
+}
+
+type AuditLogResponse struct {
+	ID         string          `json:"id"`
+	ActorID    string          `json:"actor_id"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	Changes    json.RawMessage `json:"changes"`
+	Reason     string          `json:"reason"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type AuditLogsPage struct {
+	Entries []AuditLogResponse `json:"entries"`
+	HasMore bool               `json:"has_more"`
+}
+
+// writeAuditLog records an action that already happened, so a failure is
+// logged rather than reported to the caller.
+func writeAuditLog(ctx context.Context, entry AuditEntry) {
+	if entry.Changes == nil {
+		entry.Changes = AuditChanges{}
+	}
+
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		slog.Error("failed to marshal audit log changes", "err", err)
+		return
+	}
+
+	err = db.Query.CreateAuditLog(ctx, queries.CreateAuditLogParams{
+		ID:         utils.Node.Generate().String(),
+		ServerID:   entry.ServerID,
+		ActorID:    entry.ActorID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Changes:    changes,
+		Reason:     entry.Reason,
+	})
+	if err != nil {
+		slog.Error("failed to write audit log", "action", entry.Action, "server", entry.ServerID, "err", err)
+	}
+}
+
+func GetAuditLogs(ctx context.Context, requesterID, serverID string, params *GetAuditLogsParams) (*AuditLogsPage, error) {
+	err := CheckAbility(ctx, serverID, requesterID, permissions.ViewAuditLog)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultAuditLogsLimit
+	}
+	limit = min(limit, MaxAuditLogsLimit)
+
+	rows, err := db.Query.GetAuditLogs(ctx, queries.GetAuditLogsParams{
+		ServerID: serverID,
+		Action:   pgtype.Text{String: params.Action, Valid: params.Action != ""},
+		ActorID:  pgtype.Text{String: params.ActorID, Valid: params.ActorID != ""},
+		TargetID: pgtype.Text{String: params.TargetID, Valid: params.TargetID != ""},
+		Before:   pgtype.Text{String: params.Before, Valid: params.Before != ""},
+		Limit:    int32(limit + 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &AuditLogsPage{}
+	page.HasMore = len(rows) > limit
+	if page.HasMore {
+		rows = rows[:limit]
+	}
+
+	page.Entries = make([]AuditLogResponse, 0, len(rows))
+	for _, row := range rows {
+		page.Entries = append(page.Entries, AuditLogResponse{
+			ID:         row.ID,
+			ActorID:    row.ActorID,
+			Action:     row.Action,
+			TargetType: row.TargetType,
+			TargetID:   row.TargetID,
+			Changes:    row.Changes,
+			Reason:     row.Reason,
+			CreatedAt:  row.CreatedAt,
+		})
+	}
+
+	return page, nil
+}
@@ -2,13 +2,11 @@ package services
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"log/slog"
 	"math/rand"
 	"os"
-	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/okzmo/kyob/db"
@@ -17,13 +15,18 @@ import (
 )
 
 var (
-	ErrInvalidHash  = errors.New("invalid hash")
-	ErrUserNotFound = errors.New("user not found")
+	ErrInvalidHash    = errors.New("invalid hash")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrSessionRevoked = errors.New("session revoked")
+	ErrSessionExpired = errors.New("session expired")
 )
 
-func SignIn(ctx context.Context, emailOrUsername string, password string) (*string, error) {
+// SignIn opens a session once the password is checked. Users with two-factor
+// authentication get a challenge instead, to redeem with
+// RedeemTwoFactorChallenge.
+func SignIn(ctx context.Context, emailOrUsername string, password string, info SessionInfo) (*string, *TwoFactorChallengeResponse, error) {
 	if emailOrUsername == "admin" {
-		return nil, ErrInvalidHash
+		return nil, nil, ErrInvalidHash
 	}
 
 	user, err := db.Query.GetUser(ctx, queries.GetUserParams{
@@ -31,40 +34,27 @@ func SignIn(ctx context.Context, emailOrUsername string, password string) (*stri
 		Username: emailOrUsername,
 	})
 	if err != nil {
-		return nil, ErrUserNotFound
+		return nil, nil, ErrUserNotFound
 	}
 
 	match, err := utils.VerifyPassword(password, user.Password)
 	if err != nil {
 		slog.Error("error on hashing", "err", err)
-		return nil, ErrInvalidHash
+		return nil, nil, ErrInvalidHash
 	} else if !match {
-		return nil, ErrInvalidHash
+		return nil, nil, ErrInvalidHash
 	}
 
-	token, err := utils.GenerateRandomBytes(64)
-	if err != nil {
-		slog.Error("failed generate token", "err", err)
-		return nil, err
-	}
-
-	b64Token := base64.RawStdEncoding.EncodeToString(token)
-	_, err = db.Query.CreateToken(ctx, queries.CreateTokenParams{
-		ID:       utils.Node.Generate().String(),
-		UserID:   user.ID,
-		Token:    b64Token,
-		ExpireAt: time.Now().Add(30 * (24 * time.Hour)),
-		Type:     "REMEMBER_ME_TOKEN",
-	})
-	if err != nil {
-		slog.Error("failed create token", "err", err)
-		return nil, err
+	if user.TotpEnabled {
+		challenge, err := createChallenge(ctx, user.ID)
+		return nil, challenge, err
 	}
 
-	return &b64Token, nil
+	token, err := createSession(ctx, user.ID, info)
+	return token, nil, err
 }
 
-func SignUp(ctx context.Context, email, username, displayName, password, bodyURL, rpmID, rpmToken string) (*string, error) {
+func SignUp(ctx context.Context, email, username, displayName, password, bodyURL, rpmID, rpmToken string, info SessionInfo) (*string, error) {
 	hashedPassword, err := utils.HashPassword(password)
 	if err != nil {
 		return nil, err
@@ -90,24 +80,10 @@ func SignUp(ctx context.Context, email, username, displayName, password, bodyURL
 		return nil, err
 	}
 
-	token, err := utils.GenerateRandomBytes(64)
+	err = sendEmailVerification(ctx, queriesUser.ID, queriesUser.Email)
 	if err != nil {
-		slog.Error("failed generate token", "err", err)
-		return nil, err
-	}
-
-	b64Token := base64.RawStdEncoding.EncodeToString(token)
-	_, err = db.Query.CreateToken(ctx, queries.CreateTokenParams{
-		ID:       utils.Node.Generate().String(),
-		UserID:   queriesUser.ID,
-		Token:    b64Token,
-		ExpireAt: time.Now().Add(30 * (24 * time.Hour)),
-		Type:     "REMEMBER_ME_TOKEN",
-	})
-	if err != nil {
-		slog.Error("failed create token", "err", err)
-		return nil, err
+		slog.Error("failed to send email verification", "err", err)
 	}
 
-	return &b64Token, nil
+	return createSession(ctx, queriesUser.ID, info)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/okzmo/kyob/db"
 	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
 	"github.com/okzmo/kyob/internal/utils"
 	proto "github.com/okzmo/kyob/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -16,12 +17,15 @@ var (
 	ErrUnauthorizedChannelCreation = errors.New("cannot create a channel in this server")
 	ErrUnauthorizedChannelEdition  = errors.New("cannot edit this channel")
 	ErrUnauthorizedChannelDeletion = errors.New("cannot delete this channel")
+	ErrChannelNotFound             = errors.New("channel not found")
+	ErrInvalidUserLimit            = errors.New("only voice channels have a user limit")
 )
 
 type CreateChannelBody struct {
 	Name        string              `validate:"required,max=50" json:"name"`
-	Type        queries.ChannelType `validate:"required,oneof=textual voice" json:"type"`
+	Type        queries.ChannelType `validate:"required,oneof=textual voice stage" json:"type"`
 	Description string              `validate:"max=280" json:"description"`
+	UserLimit   *int32              `validate:"omitempty,min=1,max=99" json:"user_limit"`
 	Users       []string            `json:"users"`
 	Roles       []string            `json:"roles"`
 	X           int32               `json:"x"`
@@ -33,6 +37,15 @@ type EditChannelBody struct {
 	ServerID    string `validate:"required" json:"server_id"`
 	Name        string `validate:"max=50" json:"name"`
 	Description string `validate:"max=280" json:"description"`
+	// UserLimit caps how many users can be in the call of a voice or stage
+	// channel, 0 removes the limit.
+	UserLimit *int32 `validate:"omitempty,min=0,max=99" json:"user_limit"`
+}
+
+type OverwriteBody struct {
+	Type  queries.OverwriteType `validate:"required,oneof=role user" json:"type"`
+	Allow []string              `validate:"dive,oneof=VIEW_CHANNEL SEND_MESSAGES ATTACH_FILES CONNECT SPEAK VIDEO SCREENSHARE" json:"allow"`
+	Deny  []string              `validate:"dive,oneof=VIEW_CHANNEL SEND_MESSAGES ATTACH_FILES CONNECT SPEAK VIDEO SCREENSHARE" json:"deny"`
 }
 
 type DeleteChannelBody struct {
@@ -41,15 +54,20 @@ type DeleteChannelBody struct {
 
 func CreateChannel(ctx context.Context, creatorID, serverID string, channel *CreateChannelBody) (*proto.BroadcastChannelCreation, error) {
 	if creatorID != "global" {
-		res, err := db.Query.OwnServer(ctx, queries.OwnServerParams{
-			ID:      serverID,
-			OwnerID: creatorID,
-		})
-		if err != nil || res.RowsAffected() == 0 {
+		err := CheckAbility(ctx, serverID, creatorID, permissions.ManageChannels)
+		if err != nil {
 			return nil, ErrUnauthorizedChannelCreation
 		}
 	}
 
+	var userLimit pgtype.Int4
+	if channel.UserLimit != nil {
+		if channel.Type != queries.ChannelTypeVoice && channel.Type != queries.ChannelTypeStage {
+			return nil, ErrInvalidUserLimit
+		}
+		userLimit = pgtype.Int4{Int32: *channel.UserLimit, Valid: true}
+	}
+
 	channelParams := queries.CreateChannelParams{
 		ServerID:    serverID,
 		Name:        channel.Name,
@@ -59,6 +77,7 @@ func CreateChannel(ctx context.Context, creatorID, serverID string, channel *Cre
 		Roles:       channel.Roles,
 		X:           channel.X,
 		Y:           channel.Y,
+		UserLimit:   userLimit,
 	}
 
 	if channel.ID != nil {
@@ -72,6 +91,21 @@ func CreateChannel(ctx context.Context, creatorID, serverID string, channel *Cre
 		return nil, err
 	}
 
+	if serverID != "global" {
+		writeAuditLog(ctx, AuditEntry{
+			ServerID:   serverID,
+			ActorID:    creatorID,
+			Action:     AuditChannelCreate,
+			TargetType: AuditTargetChannel,
+			TargetID:   c.ID,
+			Changes: AuditChanges{
+				"name":        {After: c.Name},
+				"type":        {After: c.Type},
+				"description": {After: c.Description.String},
+			},
+		})
+	}
+
 	newChannel := &proto.BroadcastChannelCreation{
 		Id:          c.ID,
 		ServerId:    serverID,
@@ -85,23 +119,25 @@ func CreateChannel(ctx context.Context, creatorID, serverID string, channel *Cre
 		UpdatedAt:   timestamppb.New(c.UpdatedAt),
 	}
 
+	if c.UserLimit.Valid {
+		newChannel.UserLimit = &c.UserLimit.Int32
+	}
+
 	return newChannel, nil
 }
 
 func EditChannel(ctx context.Context, id string, body *EditChannelBody) error {
 	user := ctx.Value("user").(queries.User)
-	res, err := db.Query.OwnServer(ctx, queries.OwnServerParams{
-		ID:      body.ServerID,
-		OwnerID: user.ID,
-	})
-	if err != nil || res.RowsAffected() == 0 {
+	err := CheckAbility(ctx, body.ServerID, user.ID, permissions.ManageChannels)
+	if err != nil {
 		return ErrUnauthorizedChannelEdition
 	}
 
 	if body.Name != "" {
 		err := db.Query.UpdateChannelName(ctx, queries.UpdateChannelNameParams{
-			ID:   id,
-			Name: body.Name,
+			ID:       id,
+			ServerID: body.ServerID,
+			Name:     body.Name,
 		})
 		if err != nil {
 			return err
@@ -111,6 +147,7 @@ func EditChannel(ctx context.Context, id string, body *EditChannelBody) error {
 	if body.Description != "" {
 		err := db.Query.UpdateChannelDescription(ctx, queries.UpdateChannelDescriptionParams{
 			ID:          id,
+			ServerID:    body.ServerID,
 			Description: pgtype.Text{String: body.Description, Valid: true},
 		})
 		if err != nil {
@@ -118,19 +155,143 @@ func EditChannel(ctx context.Context, id string, body *EditChannelBody) error {
 		}
 	}
 
+	if body.UserLimit != nil {
+		channel, err := getServerChannel(ctx, body.ServerID, id)
+		if err != nil {
+			return err
+		}
+
+		if channel.Type != queries.ChannelTypeVoice && channel.Type != queries.ChannelTypeStage {
+			return ErrInvalidUserLimit
+		}
+
+		err = db.Query.UpdateChannelUserLimit(ctx, queries.UpdateChannelUserLimitParams{
+			ID:        id,
+			ServerID:  body.ServerID,
+			UserLimit: pgtype.Int4{Int32: *body.UserLimit, Valid: *body.UserLimit > 0},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func DeleteChannel(ctx context.Context, serverID, channelID, userID string) error {
-	res, err := db.Query.OwnServer(ctx, queries.OwnServerParams{
-		ID:      serverID,
-		OwnerID: userID,
-	})
-	if err != nil || res.RowsAffected() == 0 {
+	err := CheckAbility(ctx, serverID, userID, permissions.ManageChannels)
+	if err != nil {
 		return ErrUnauthorizedChannelDeletion
 	}
 
-	err = db.Query.DeleteChannel(ctx, channelID)
+	channel, err := getServerChannel(ctx, serverID, channelID)
+	if err != nil {
+		return err
+	}
+
+	err = db.Query.DeleteChannel(ctx, queries.DeleteChannelParams{
+		ID:       channelID,
+		ServerID: serverID,
+	})
+	if err != nil {
+		return err
+	}
+
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    userID,
+		Action:     AuditChannelDelete,
+		TargetType: AuditTargetChannel,
+		TargetID:   channelID,
+		Changes: AuditChanges{
+			"name":        {Before: channel.Name},
+			"type":        {Before: channel.Type},
+			"description": {Before: channel.Description.String},
+		},
+	})
+
+	return nil
+}
+
+func getServerChannel(ctx context.Context, serverID, channelID string) (*queries.Channel, error) {
+	channel, err := db.Query.GetChannel(ctx, channelID)
+	if err != nil || channel.ServerID != serverID {
+		return nil, ErrChannelNotFound
+	}
+
+	return &channel, nil
+}
+
+func GetChannelOverwrites(ctx context.Context, userID, serverID, channelID string) ([]queries.ChannelOverwrite, error) {
+	err := CheckAbility(ctx, serverID, userID, permissions.ManageChannels)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = getServerChannel(ctx, serverID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	overwrites, err := db.Query.GetChannelOverwrites(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return overwrites, nil
+}
+
+func SetChannelOverwrite(ctx context.Context, userID, serverID, channelID, targetID string, body *OverwriteBody) (*queries.ChannelOverwrite, error) {
+	err := CheckAbility(ctx, serverID, userID, permissions.ManageChannels)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = getServerChannel(ctx, serverID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	allow := body.Allow
+	if allow == nil {
+		allow = []string{}
+	}
+
+	deny := body.Deny
+	if deny == nil {
+		deny = []string{}
+	}
+
+	overwrite, err := db.Query.UpsertChannelOverwrite(ctx, queries.UpsertChannelOverwriteParams{
+		ID:        utils.Node.Generate().String(),
+		ChannelID: channelID,
+		TargetID:  targetID,
+		Type:      body.Type,
+		Allow:     allow,
+		Deny:      deny,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &overwrite, nil
+}
+
+func DeleteChannelOverwrite(ctx context.Context, userID, serverID, channelID, targetID string) error {
+	err := CheckAbility(ctx, serverID, userID, permissions.ManageChannels)
+	if err != nil {
+		return err
+	}
+
+	_, err = getServerChannel(ctx, serverID, channelID)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Query.DeleteChannelOverwrite(ctx, queries.DeleteChannelOverwriteParams{
+		ChannelID: channelID,
+		TargetID:  targetID,
+	})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/mailer"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+var setupDBOnce sync.Once
+
+// setupTestDB connects to the migrated database of TEST_DATABASE_URL, the
+// test is skipped without one.
+func setupTestDB(t *testing.T) {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	setupDBOnce.Do(func() {
+		os.Setenv("DATABASE_URL", dsn)
+		if os.Getenv("NODE_ID") == "" {
+			os.Setenv("NODE_ID", "1")
+		}
+
+		db.Setup()
+		utils.SetupSnowflake()
+	})
+}
+
+func createTestUser(t *testing.T) queries.User {
+	t.Helper()
+	ctx := context.Background()
+
+	id := utils.Node.Generate().String()
+	password, err := utils.HashPassword("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := db.Query.CreateUser(ctx, queries.CreateUserParams{
+		ID:          id,
+		Email:       "user" + id + "@example.com",
+		Username:    "user" + id,
+		DisplayName: "user",
+		Password:    password,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = db.Query.DeleteUser(context.Background(), user.ID)
+	})
+
+	return user
+}
+
+func useMemoryMailer(t *testing.T) *mailer.MemoryMailer {
+	t.Helper()
+
+	previous := mailer.Default
+	m := mailer.NewMemoryMailer()
+	mailer.Default = m
+	t.Cleanup(func() {
+		mailer.Default = previous
+	})
+
+	return m
+}
+
+// mailToken returns the token of the link in the last mail sent to the
+// address.
+func mailToken(t *testing.T, m *mailer.MemoryMailer, to string) string {
+	t.Helper()
+
+	mails := m.Mails(to)
+	if len(mails) == 0 {
+		t.Fatalf("no mail sent to %s", to)
+	}
+
+	for _, field := range strings.Fields(mails[len(mails)-1].Body) {
+		link, err := url.Parse(field)
+		if err == nil && link.Query().Has("token") {
+			return link.Query().Get("token")
+		}
+	}
+
+	t.Fatalf("no token in the mail sent to %s", to)
+	return ""
+}
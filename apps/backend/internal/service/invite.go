@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+var (
+	ErrInviteNotFound   = errors.New("invite not found")
+	ErrInviteCodeTaken  = errors.New("invite code already taken")
+	ErrNoVanityInGlobal = errors.New("the global server cannot have a vanity invite")
+)
+
+var inviteCodePattern = regexp.MustCompile(`^[a-zA-Z0-9-]{3,32}$`)
+
+type CreateInviteBody struct {
+	// ExpiresIn is in seconds, zero meaning the invite never expires.
+	ExpiresIn int `validate:"min=0,max=2592000" json:"expires_in"`
+	// MaxUses of zero means the invite can be used any number of times.
+	MaxUses int `validate:"min=0,max=1000" json:"max_uses"`
+}
+
+type VanityInviteBody struct {
+	Code string `validate:"required,min=3,max=32,invite_code" json:"code"`
+}
+
+type InviteResponse struct {
+	Code      string      `json:"code"`
+	ServerID  string      `json:"server_id"`
+	CreatorID pgtype.Text `json:"creator_id"`
+	MaxUses   int32       `json:"max_uses"`
+	Uses      int32       `json:"uses"`
+	Vanity    bool        `json:"vanity"`
+	ExpiresAt *time.Time  `json:"expires_at"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type ServerInviteResponse struct {
+	InviteLink string         `json:"invite_link"`
+	Invite     InviteResponse `json:"invite"`
+}
+
+func toInviteResponse(invite queries.Invite) InviteResponse {
+	res := InviteResponse{
+		Code:      invite.InviteID,
+		ServerID:  invite.ServerID,
+		CreatorID: invite.CreatorID,
+		MaxUses:   invite.MaxUses,
+		Uses:      invite.Uses,
+		Vanity:    invite.Vanity,
+		CreatedAt: invite.CreatedAt,
+	}
+	if invite.ExpireAt.Valid {
+		res.ExpiresAt = &invite.ExpireAt.Time
+	}
+
+	return res
+}
+
+// inviteCodeFromURL accepts either a bare invite code or any link whose last
+// path segment is the code.
+func inviteCodeFromURL(inviteURL string) (string, error) {
+	code := strings.TrimSpace(inviteURL)
+
+	if u, err := url.Parse(code); err == nil && u.Host != "" {
+		code = u.Path
+	}
+
+	code = strings.TrimRight(code, "/")
+	if idx := strings.LastIndex(code, "/"); idx != -1 {
+		code = code[idx+1:]
+	}
+
+	if !inviteCodePattern.MatchString(code) {
+		return "", ErrNoIDInInvite
+	}
+
+	return code, nil
+}
+
+func CreateServerInvite(ctx context.Context, serverID string, body *CreateInviteBody) (*InviteResponse, error) {
+	user := ctx.Value("user").(queries.User)
+	res, err := db.Query.IsMember(ctx, queries.IsMemberParams{
+		ServerID: serverID,
+		UserID:   user.ID,
+	})
+	if err != nil || res.RowsAffected() == 0 {
+		return nil, ErrNotServerMember
+	}
+
+	var expireAt pgtype.Timestamptz
+	if body.ExpiresIn > 0 {
+		expireAt = pgtype.Timestamptz{Time: time.Now().Add(time.Duration(body.ExpiresIn) * time.Second), Valid: true}
+	}
+
+	invite, err := db.Query.CreateInvite(ctx, queries.CreateInviteParams{
+		ID:        utils.Node.Generate().String(),
+		ServerID:  serverID,
+		InviteID:  utils.GenerateRandomId(10),
+		ExpireAt:  expireAt,
+		CreatorID: pgtype.Text{String: user.ID, Valid: true},
+		MaxUses:   int32(body.MaxUses),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := toInviteResponse(invite)
+	return &response, nil
+}
+
+func GetInvites(ctx context.Context, requesterID, serverID string) ([]InviteResponse, error) {
+	err := CheckAbility(ctx, serverID, requesterID, permissions.ManageServer)
+	if err != nil {
+		return nil, err
+	}
+
+	invites, err := db.Query.GetActiveInvites(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]InviteResponse, 0, len(invites))
+	for _, invite := range invites {
+		res = append(res, toInviteResponse(invite))
+	}
+
+	return res, nil
+}
+
+// RevokeInvite lets the creator of an invite, or anyone who can manage the
+// server, disable it. Revoked invites are kept so their join count stays.
+func RevokeInvite(ctx context.Context, requesterID, serverID, code string) error {
+	invite, err := db.Query.GetInvite(ctx, code)
+	if err != nil || invite.ServerID != serverID {
+		return ErrInviteNotFound
+	}
+
+	if invite.CreatorID.String != requesterID || invite.Vanity {
+		err := CheckAbility(ctx, serverID, requesterID, permissions.ManageServer)
+		if err != nil {
+			return err
+		}
+	}
+
+	res, err := db.Query.RevokeInvite(ctx, queries.RevokeInviteParams{
+		InviteID: code,
+		ServerID: serverID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return ErrInviteNotFound
+	}
+
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditInviteRevoke,
+		TargetType: AuditTargetInvite,
+		TargetID:   invite.ID,
+		Changes: AuditChanges{
+			"code": {Before: invite.InviteID},
+			"uses": {Before: invite.Uses},
+		},
+	})
+
+	return nil
+}
+
+// SetVanityInvite replaces the server's vanity invite, which never expires
+// and has no use limit.
+func SetVanityInvite(ctx context.Context, requesterID, serverID string, body *VanityInviteBody) (*InviteResponse, error) {
+	if serverID == "global" {
+		return nil, ErrNoVanityInGlobal
+	}
+
+	err := CheckAbility(ctx, serverID, requesterID, permissions.ManageServer)
+	if err != nil {
+		return nil, err
+	}
+
+	code := strings.ToLower(body.Code)
+	taken, err := db.Query.IsInviteCodeTaken(ctx, queries.IsInviteCodeTakenParams{
+		Code:     code,
+		ServerID: serverID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if taken {
+		return nil, ErrInviteCodeTaken
+	}
+
+	var before any
+	previous, err := db.Query.GetVanityInvite(ctx, serverID)
+	if err == nil {
+		before = previous.InviteID
+	}
+
+	_, err = db.Query.DeleteVanityInvite(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := db.Query.CreateVanityInvite(ctx, queries.CreateVanityInviteParams{
+		ID:        utils.Node.Generate().String(),
+		ServerID:  serverID,
+		InviteID:  code,
+		CreatorID: pgtype.Text{String: requesterID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditVanityUpdate,
+		TargetType: AuditTargetServer,
+		TargetID:   serverID,
+		Changes: AuditChanges{
+			"code": {Before: before, After: invite.InviteID},
+		},
+	})
+
+	response := toInviteResponse(invite)
+	return &response, nil
+}
+
+func RemoveVanityInvite(ctx context.Context, requesterID, serverID string) error {
+	err := CheckAbility(ctx, serverID, requesterID, permissions.ManageServer)
+	if err != nil {
+		return err
+	}
+
+	previous, err := db.Query.GetVanityInvite(ctx, serverID)
+	if err != nil {
+		return ErrInviteNotFound
+	}
+
+	_, err = db.Query.DeleteVanityInvite(ctx, serverID)
+	if err != nil {
+		return err
+	}
+
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditVanityUpdate,
+		TargetType: AuditTargetServer,
+		TargetID:   serverID,
+		Changes: AuditChanges{
+			"code": {Before: previous.InviteID},
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	"github.com/okzmo/kyob/internal/utils"
+	proto "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrJoinRequestNotFound = errors.New("join request not found")
+	ErrAlreadyServerMember = errors.New("already a member of this server")
+)
+
+type JoinRequestResponse struct {
+	ID          string      `json:"id"`
+	UserID      string      `json:"user_id"`
+	Username    string      `json:"username"`
+	DisplayName string      `json:"display_name"`
+	Avatar      pgtype.Text `json:"avatar"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+func createJoinRequest(ctx context.Context, user queries.User, serverID, code string, x, y int) (*proto.JoinRequest, error) {
+	res, err := db.Query.IsMember(ctx, queries.IsMemberParams{
+		ServerID: serverID,
+		UserID:   user.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.RowsAffected() > 0 {
+		return nil, ErrAlreadyServerMember
+	}
+
+	request, err := db.Query.CreateJoinRequest(ctx, queries.CreateJoinRequestParams{
+		ID:       utils.Node.Generate().String(),
+		ServerID: serverID,
+		UserID:   user.ID,
+		InviteID: code,
+		X:        int32(x),
+		Y:        int32(y),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.JoinRequest{
+		Id:       request.ID,
+		ServerId: serverID,
+		User: &proto.User{
+			Id:          user.ID,
+			Username:    user.Username,
+			DisplayName: user.DisplayName,
+			Avatar:      &user.Avatar.String,
+		},
+		CreatedAt: timestamppb.New(request.CreatedAt),
+	}, nil
+}
+
+func GetJoinRequests(ctx context.Context, requesterID, serverID string) ([]JoinRequestResponse, error) {
+	err := CheckAbility(ctx, serverID, requesterID, permissions.ManageServer)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query.GetJoinRequests(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]JoinRequestResponse, 0, len(rows))
+	for _, row := range rows {
+		requests = append(requests, JoinRequestResponse{
+			ID:          row.ID,
+			UserID:      row.UserID,
+			Username:    row.Username,
+			DisplayName: row.DisplayName,
+			Avatar:      row.Avatar,
+			CreatedAt:   row.CreatedAt,
+		})
+	}
+
+	return requests, nil
+}
+
+func getServerJoinRequest(ctx context.Context, requesterID, serverID, requestID string) (*queries.JoinRequest, error) {
+	err := CheckAbility(ctx, serverID, requesterID, permissions.ManageServer)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := db.Query.GetJoinRequest(ctx, requestID)
+	if err != nil || request.ServerID != serverID {
+		return nil, ErrJoinRequestNotFound
+	}
+
+	return &request, nil
+}
+
+func deleteJoinRequest(ctx context.Context, serverID, requestID string) error {
+	res, err := db.Query.DeleteJoinRequest(ctx, queries.DeleteJoinRequestParams{
+		ID:       requestID,
+		ServerID: serverID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return ErrJoinRequestNotFound
+	}
+
+	return nil
+}
+
+// ApproveJoinRequest makes the requester a member. The resolution carries the
+// joined server so the requester's client can display it right away.
+func ApproveJoinRequest(ctx context.Context, requesterID, serverID, requestID string) (*proto.JoinRequestResolved, *proto.User, error) {
+	request, err := getServerJoinRequest(ctx, requesterID, serverID, requestID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = checkBan(ctx, serverID, request.UserID)
+	if err != nil {
+		deleteJoinRequest(ctx, serverID, requestID)
+		return nil, nil, err
+	}
+
+	server, err := addServerMember(ctx, request.UserID, serverID, request.InviteID, int(request.X), int(request.Y))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = deleteJoinRequest(ctx, serverID, requestID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serverJSON, err := json.Marshal(server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := db.Query.GetUserMinimal(ctx, request.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditJoinRequestApprove,
+		TargetType: AuditTargetUser,
+		TargetID:   request.UserID,
+	})
+
+	resolved := &proto.JoinRequestResolved{
+		Id:       request.ID,
+		ServerId: serverID,
+		UserId:   request.UserID,
+		Approved: true,
+		Server:   serverJSON,
+	}
+
+	return resolved, &proto.User{
+		Id:          user.ID,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		Avatar:      &user.Avatar.String,
+	}, nil
+}
+
+func DenyJoinRequest(ctx context.Context, requesterID, serverID, requestID string) (*proto.JoinRequestResolved, error) {
+	request, err := getServerJoinRequest(ctx, requesterID, serverID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = deleteJoinRequest(ctx, serverID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditJoinRequestDeny,
+		TargetType: AuditTargetUser,
+		TargetID:   request.UserID,
+	})
+
+	return &proto.JoinRequestResolved{
+		Id:       request.ID,
+		ServerId: serverID,
+		UserId:   request.UserID,
+	}, nil
+}
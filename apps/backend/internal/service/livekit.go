@@ -1,30 +1,236 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"os"
+	"slices"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	"github.com/twitchtv/twirp"
 )
 
+var (
+	ErrNotInCall = errors.New("user is not in this call")
+	ErrCallFull  = errors.New("call is full")
+)
+
+// callTokenTTL is kept short since the abilities baked in the token are only
+// checked when it is issued, clients refresh it before it expires.
+const callTokenTTL = 15 * time.Minute
+
 type LivekitResponse struct {
-	Token string `json:"token"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CallState is what the channel actor knows about the user in its call.
+type CallState struct {
+	// Occupants is the number of users already in the call, checked against
+	// the user limit of the channel.
+	Occupants  int
+	Speaker    bool
+	ServerMute bool
 }
 
-func GenerateCallToken(roomName string, userID string) (string, error) {
+type callAccess struct {
+	channel         queries.Channel
+	grant           *auth.VideoGrant
+	prioritySpeaker bool
+	bypassLimit     bool
+}
+
+func isCallChannel(channel queries.Channel) bool {
+	switch channel.Type {
+	case queries.ChannelTypeVoice, queries.ChannelTypeStage, queries.ChannelTypeDm:
+		return true
+	default:
+		return false
+	}
+}
+
+// resolveCallAccess resolves what the user may do in the call of the channel
+// from their roles, the channel overwrites and their state in the call. Only
+// speakers publish on a stage.
+func resolveCallAccess(ctx context.Context, channelID, userID string, state CallState) (*callAccess, error) {
+	channel, err := db.Query.GetChannel(ctx, channelID)
+	if err != nil {
+		return nil, ErrChannelNotFound
+	}
+
+	if !isCallChannel(channel) {
+		return nil, ErrNotVoiceChannel
+	}
+
+	var abilities *permissions.Abilities
+	if channel.ServerID != "global" {
+		abilities, err = GetMemberAbilities(ctx, channel.ServerID, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := db.Query.GetChannelOverwrites(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	inChannel := channelAbilities(abilities, channel, userID, rows)
+	if !inChannel.Has(permissions.Connect) {
+		return nil, ErrMissingAbility
+	}
+
+	sources := callSources(channel.Type, inChannel, state)
+
+	grant := &auth.VideoGrant{
+		RoomJoin: true,
+		Room:     channelID,
+	}
+	grant.SetCanSubscribe(true)
+	grant.SetCanPublishData(true)
+	grant.SetCanPublish(len(sources) > 0)
+	grant.SetCanPublishSources(sources)
+
+	return &callAccess{
+		channel:         channel,
+		grant:           grant,
+		prioritySpeaker: abilities != nil && abilities.Has(permissions.PrioritySpeaker) && slices.Contains(sources, livekit.TrackSource_MICROPHONE),
+		bypassLimit:     abilities != nil && abilities.Has(permissions.MoveMembers),
+	}, nil
+}
+
+// callSources returns the tracks the user may publish in the call.
+func callSources(channelType queries.ChannelType, inChannel permissions.ChannelAbilities, state CallState) []livekit.TrackSource {
+	var sources []livekit.TrackSource
+	if channelType == queries.ChannelTypeStage && !state.Speaker {
+		return sources
+	}
+
+	if inChannel.Has(permissions.Speak) && !state.ServerMute {
+		sources = append(sources, livekit.TrackSource_MICROPHONE)
+	}
+	if inChannel.Has(permissions.Video) {
+		sources = append(sources, livekit.TrackSource_CAMERA)
+	}
+	if inChannel.Has(permissions.Screenshare) {
+		sources = append(sources, livekit.TrackSource_SCREEN_SHARE, livekit.TrackSource_SCREEN_SHARE_AUDIO)
+	}
+
+	return sources
+}
+
+// GenerateCallToken issues a token scoped to what the user may do in the
+// call, a user who cannot connect to the channel gets none. Members who can
+// move others may join a full call.
+func GenerateCallToken(ctx context.Context, channelID string, userID string, state CallState) (*LivekitResponse, error) {
+	access, err := resolveCallAccess(ctx, channelID, userID, state)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := access.channel.UserLimit
+	if limit.Valid && state.Occupants >= int(limit.Int32) && !access.bypassLimit {
+		return nil, ErrCallFull
+	}
+
 	apiKey := os.Getenv("LIVEKIT_API_KEY")
 	apiSecret := os.Getenv("LIVEKIT_API_SECRET")
 
 	at := auth.NewAccessToken(apiKey, apiSecret)
-	grant := &auth.VideoGrant{
-		RoomJoin:     true,
-		Room:         roomName,
-		CanPublish:   aws.Bool(true),
-		CanSubscribe: aws.Bool(true),
+	at.SetVideoGrant(access.grant).SetIdentity(userID).SetValidFor(callTokenTTL)
+	if access.prioritySpeaker {
+		at.SetAttributes(map[string]string{"priority_speaker": "true"})
+	}
+
+	token, err := at.ToJWT()
+	if err != nil {
+		return nil, err
+	}
+
+	return &LivekitResponse{
+		Token:     token,
+		ExpiresAt: time.Now().Add(callTokenTTL),
+	}, nil
+}
+
+// UpdateCallPermissions applies to a connected participant what they may
+// publish after their state in the call changed.
+func UpdateCallPermissions(ctx context.Context, channelID, userID string, state CallState) error {
+	access, err := resolveCallAccess(ctx, channelID, userID, state)
+	if err != nil {
+		return err
+	}
+
+	return setParticipantPermission(ctx, channelID, userID, access.grant.ToPermission())
+}
+
+// roomService returns a client of the LiveKit room API along with a context
+// authorized to administrate the given room.
+func roomService(ctx context.Context, roomName string) (livekit.RoomService, context.Context, error) {
+	at := auth.NewAccessToken(os.Getenv("LIVEKIT_API_KEY"), os.Getenv("LIVEKIT_API_SECRET"))
+	at.SetVideoGrant(&auth.VideoGrant{
+		RoomAdmin: true,
+		Room:      roomName,
+	}).SetValidFor(time.Minute)
+
+	token, err := at.ToJWT()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := make(http.Header)
+	header.Set("Authorization", "Bearer "+token)
+	ctx, err = twirp.WithHTTPRequestHeaders(ctx, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := livekit.NewRoomServiceProtobufClient(os.Getenv("LIVEKIT_URL"), &http.Client{Timeout: 5 * time.Second})
+	return client, ctx, nil
+}
+
+func livekitError(err error) error {
+	var twerr twirp.Error
+	if errors.As(err, &twerr) && twerr.Code() == twirp.NotFound {
+		return ErrNotInCall
+	}
+
+	return err
+}
+
+// setParticipantPermission changes what a participant may publish in the
+// room, revoking a source unpublishes the tracks already sent.
+func setParticipantPermission(ctx context.Context, roomName, userID string, permission *livekit.ParticipantPermission) error {
+	client, ctx, err := roomService(ctx, roomName)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.UpdateParticipant(ctx, &livekit.UpdateParticipantRequest{
+		Room:       roomName,
+		Identity:   userID,
+		Permission: permission,
+	})
+
+	return livekitError(err)
+}
+
+func removeParticipant(ctx context.Context, roomName, userID string) error {
+	client, ctx, err := roomService(ctx, roomName)
+	if err != nil {
+		return err
 	}
 
-	at.SetVideoGrant(grant).SetIdentity(userID).SetValidFor(time.Hour)
+	_, err = client.RemoveParticipant(ctx, &livekit.RoomParticipantIdentity{
+		Room:     roomName,
+		Identity: userID,
+	})
 
-	return at.ToJWT()
+	return livekitError(err)
 }
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/livekit/protocol/livekit"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+)
+
+func TestCallSourcesWithoutSpeak(t *testing.T) {
+	member := permissions.Resolve(nil)
+
+	inChannel := member.InChannel("server", "user", []permissions.Overwrite{
+		{TargetID: "server", Type: permissions.OverwriteRole, Deny: []string{permissions.Speak}},
+	})
+	sources := callSources(queries.ChannelTypeVoice, inChannel, CallState{})
+
+	if slices.Contains(sources, livekit.TrackSource_MICROPHONE) {
+		t.Fatal("a member without SPEAK should not publish a microphone")
+	}
+	if !slices.Contains(sources, livekit.TrackSource_CAMERA) {
+		t.Fatal("a member with VIDEO should publish a camera")
+	}
+}
+
+func TestCallSources(t *testing.T) {
+	inChannel := permissions.AllChannelAbilities()
+
+	sources := callSources(queries.ChannelTypeVoice, inChannel, CallState{})
+	if !slices.Contains(sources, livekit.TrackSource_MICROPHONE) {
+		t.Fatal("a member with SPEAK should publish a microphone")
+	}
+
+	sources = callSources(queries.ChannelTypeVoice, inChannel, CallState{ServerMute: true})
+	if slices.Contains(sources, livekit.TrackSource_MICROPHONE) {
+		t.Fatal("a server muted member should not publish a microphone")
+	}
+
+	sources = callSources(queries.ChannelTypeStage, inChannel, CallState{})
+	if len(sources) > 0 {
+		t.Fatal("only speakers publish on a stage")
+	}
+
+	sources = callSources(queries.ChannelTypeStage, inChannel, CallState{Speaker: true})
+	if !slices.Contains(sources, livekit.TrackSource_MICROPHONE) {
+		t.Fatal("a speaker should publish a microphone on a stage")
+	}
+}
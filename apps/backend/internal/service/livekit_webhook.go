@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/okzmo/kyob/db"
+	proto "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	ErrInvalidWebhookPayload   = errors.New("invalid webhook payload")
+)
+
+const (
+	LivekitParticipantJoined = "participant_joined"
+	LivekitParticipantLeft   = "participant_left"
+	LivekitRoomFinished      = "room_finished"
+)
+
+// CallEvent is a webhook event turned into the message the channel actor of
+// the room understands.
+type CallEvent struct {
+	ServerID  string
+	ChannelID string
+	Message   any
+}
+
+func payloadChecksum(body []byte) string {
+	sum := sha256.Sum256(body)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// SignLivekitWebhook signs a payload the way LiveKit does, so that events can
+// be sent to the webhook endpoint by hand.
+func SignLivekitWebhook(body []byte) (string, error) {
+	at := auth.NewAccessToken(os.Getenv("LIVEKIT_API_KEY"), os.Getenv("LIVEKIT_API_SECRET"))
+	at.SetSha256(payloadChecksum(body)).SetValidFor(5 * time.Minute)
+
+	return at.ToJWT()
+}
+
+// ReceiveLivekitWebhook checks that the payload was signed with our api key
+// and that it wasn't tampered with, then parses the event.
+func ReceiveLivekitWebhook(authorization string, body []byte) (*livekit.WebhookEvent, error) {
+	if authorization == "" {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	verifier, err := auth.ParseAPIToken(authorization)
+	if err != nil || verifier.APIKey() != os.Getenv("LIVEKIT_API_KEY") {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	claims, err := verifier.Verify(os.Getenv("LIVEKIT_API_SECRET"))
+	if err != nil || claims.Sha256 != payloadChecksum(body) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	var event livekit.WebhookEvent
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, &event)
+	if err != nil {
+		return nil, ErrInvalidWebhookPayload
+	}
+
+	return &event, nil
+}
+
+// NewCallEvent resolves the channel behind the room of the event, rooms are
+// named after the channel they belong to. Events we don't track give nil.
+func NewCallEvent(ctx context.Context, event *livekit.WebhookEvent) (*CallEvent, error) {
+	switch event.Event {
+	case LivekitParticipantJoined, LivekitParticipantLeft:
+		if event.Participant == nil || event.Participant.Identity == "" {
+			return nil, ErrInvalidWebhookPayload
+		}
+	case LivekitRoomFinished:
+	default:
+		return nil, nil
+	}
+
+	if event.Room == nil || event.Room.Name == "" {
+		return nil, ErrInvalidWebhookPayload
+	}
+
+	channel, err := db.Query.GetChannel(ctx, event.Room.Name)
+	if err != nil {
+		return nil, ErrChannelNotFound
+	}
+
+	return &CallEvent{
+		ServerID:  channel.ServerID,
+		ChannelID: channel.ID,
+		Message:   callEventMessage(event, channel.ServerID, channel.ID),
+	}, nil
+}
+
+// callEventMessage maps a tracked event to the message of the channel actor.
+func callEventMessage(event *livekit.WebhookEvent, serverID, channelID string) any {
+	switch event.Event {
+	case LivekitParticipantJoined:
+		return &proto.ConnectToCall{
+			UserId:    event.Participant.Identity,
+			ServerId:  serverID,
+			ChannelId: channelID,
+		}
+	case LivekitParticipantLeft:
+		return &proto.DisconnectFromCall{
+			UserId:    event.Participant.Identity,
+			ServerId:  serverID,
+			ChannelId: channelID,
+			LeftAt:    timestamppb.New(time.Unix(event.CreatedAt, 0)),
+		}
+	case LivekitRoomFinished:
+		return &proto.CallEnded{
+			ServerId:  serverID,
+			ChannelId: channelID,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	proto "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func setupLivekitKeys(t *testing.T) {
+	t.Helper()
+	t.Setenv("LIVEKIT_API_KEY", "test-key")
+	t.Setenv("LIVEKIT_API_SECRET", "test-secret-long-enough-for-hs256")
+}
+
+func webhookPayload(t *testing.T, event *livekit.WebhookEvent) []byte {
+	t.Helper()
+
+	body, err := protojson.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return body
+}
+
+func TestReceiveLivekitWebhook(t *testing.T) {
+	setupLivekitKeys(t)
+
+	body := webhookPayload(t, &livekit.WebhookEvent{
+		Event:       LivekitParticipantLeft,
+		Room:        &livekit.Room{Name: "channel"},
+		Participant: &livekit.ParticipantInfo{Identity: "user"},
+	})
+
+	signature, err := SignLivekitWebhook(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := ReceiveLivekitWebhook(signature, body)
+	if err != nil {
+		t.Fatalf("expected a valid webhook, got %v", err)
+	}
+
+	if event.Event != LivekitParticipantLeft || event.Participant.Identity != "user" {
+		t.Fatalf("unexpected event %v", event)
+	}
+}
+
+func TestReceiveLivekitWebhookRejectsSignature(t *testing.T) {
+	setupLivekitKeys(t)
+
+	body := webhookPayload(t, &livekit.WebhookEvent{
+		Event: LivekitRoomFinished,
+		Room:  &livekit.Room{Name: "channel"},
+	})
+
+	otherSecret := auth.NewAccessToken("test-key", "another-secret-long-enough-for-hs256")
+	otherSecret.SetSha256(payloadChecksum(body)).SetValidFor(time.Minute)
+	wrongSecret, err := otherSecret.ToJWT()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKey := auth.NewAccessToken("another-key", "test-secret-long-enough-for-hs256")
+	otherKey.SetSha256(payloadChecksum(body)).SetValidFor(time.Minute)
+	wrongKey, err := otherKey.ToJWT()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"missing":      "",
+		"malformed":    "not-a-token",
+		"wrong secret": wrongSecret,
+		"wrong key":    wrongKey,
+	}
+
+	for name, signature := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ReceiveLivekitWebhook(signature, body)
+			if !errors.Is(err, ErrInvalidWebhookSignature) {
+				t.Fatalf("expected ErrInvalidWebhookSignature, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReceiveLivekitWebhookRejectsTamperedBody(t *testing.T) {
+	setupLivekitKeys(t)
+
+	body := webhookPayload(t, &livekit.WebhookEvent{
+		Event:       LivekitParticipantLeft,
+		Room:        &livekit.Room{Name: "channel"},
+		Participant: &livekit.ParticipantInfo{Identity: "user"},
+	})
+
+	signature, err := SignLivekitWebhook(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := webhookPayload(t, &livekit.WebhookEvent{
+		Event:       LivekitParticipantLeft,
+		Room:        &livekit.Room{Name: "channel"},
+		Participant: &livekit.ParticipantInfo{Identity: "someone-else"},
+	})
+
+	_, err = ReceiveLivekitWebhook(signature, tampered)
+	if !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Fatalf("expected ErrInvalidWebhookSignature, got %v", err)
+	}
+}
+
+func TestReceiveLivekitWebhookRejectsInvalidPayload(t *testing.T) {
+	setupLivekitKeys(t)
+
+	body := []byte("not json")
+	signature, err := SignLivekitWebhook(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ReceiveLivekitWebhook(signature, body)
+	if !errors.Is(err, ErrInvalidWebhookPayload) {
+		t.Fatalf("expected ErrInvalidWebhookPayload, got %v", err)
+	}
+}
+
+func TestNewCallEventSkipsUntrackedEvents(t *testing.T) {
+	callEvent, err := NewCallEvent(context.Background(), &livekit.WebhookEvent{
+		Event: "track_published",
+		Room:  &livekit.Room{Name: "channel"},
+	})
+	if err != nil || callEvent != nil {
+		t.Fatalf("expected no event, got %v, %v", callEvent, err)
+	}
+}
+
+func TestNewCallEventRejectsIncompleteEvents(t *testing.T) {
+	tests := map[string]*livekit.WebhookEvent{
+		"joined without participant": {
+			Event: LivekitParticipantJoined,
+			Room:  &livekit.Room{Name: "channel"},
+		},
+		"left without identity": {
+			Event:       LivekitParticipantLeft,
+			Room:        &livekit.Room{Name: "channel"},
+			Participant: &livekit.ParticipantInfo{},
+		},
+		"finished without room": {
+			Event: LivekitRoomFinished,
+		},
+	}
+
+	for name, event := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewCallEvent(context.Background(), event)
+			if !errors.Is(err, ErrInvalidWebhookPayload) {
+				t.Fatalf("expected ErrInvalidWebhookPayload, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCallEventMessage(t *testing.T) {
+	participant := &livekit.ParticipantInfo{Identity: "user"}
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	joined, ok := callEventMessage(&livekit.WebhookEvent{
+		Event:       LivekitParticipantJoined,
+		Participant: participant,
+	}, "server", "channel").(*proto.ConnectToCall)
+	if !ok {
+		t.Fatal("participant_joined should give a ConnectToCall")
+	}
+	if joined.UserId != "user" || joined.ServerId != "server" || joined.ChannelId != "channel" {
+		t.Fatalf("unexpected join %v", joined)
+	}
+
+	left, ok := callEventMessage(&livekit.WebhookEvent{
+		Event:       LivekitParticipantLeft,
+		Participant: participant,
+		CreatedAt:   createdAt.Unix(),
+	}, "server", "channel").(*proto.DisconnectFromCall)
+	if !ok {
+		t.Fatal("participant_left should give a DisconnectFromCall")
+	}
+	if left.UserId != "user" || left.ServerId != "server" || left.ChannelId != "channel" {
+		t.Fatalf("unexpected departure %v", left)
+	}
+	if !left.LeftAt.AsTime().Equal(createdAt) {
+		t.Fatalf("expected the departure at %v, got %v", createdAt, left.LeftAt.AsTime())
+	}
+
+	ended, ok := callEventMessage(&livekit.WebhookEvent{
+		Event: LivekitRoomFinished,
+	}, "server", "channel").(*proto.CallEnded)
+	if !ok {
+		t.Fatal("room_finished should give a CallEnded")
+	}
+	if ended.ServerId != "server" || ended.ChannelId != "channel" {
+		t.Fatalf("unexpected end %v", ended)
+	}
+}
@@ -1,16 +1,20 @@
 package services
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/okzmo/kyob/db"
 	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
 	"github.com/okzmo/kyob/internal/utils"
 	proto "github.com/okzmo/kyob/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -20,6 +24,15 @@ var (
 	ErrUnauthorizedMessageCreation = errors.New("unauthorized message creation")
 	ErrUnauthorizedMessageEdition  = errors.New("unauthorized message edition")
 	ErrUnauthorizedMessageDeletion = errors.New("unauthorized message deletion")
+	ErrUnauthorizedMessagesAccess  = errors.New("unauthorized messages access")
+	ErrInvalidMessagesCursor       = errors.New("only one of before, after or around can be used")
+	ErrMessagesCursorNotFound      = errors.New("cursor message not found")
+	ErrInvalidReply                = errors.New("replied message is not in this channel or thread")
+)
+
+const (
+	DefaultMessagesLimit = 50
+	MaxMessagesLimit     = 100
 )
 
 type MessageBody struct {
@@ -27,8 +40,11 @@ type MessageBody struct {
 	Everyone         bool            `json:"everyone"`
 	MentionsUsers    []string        `json:"mentions_users"`
 	MentionsChannels []string        `json:"mentions_channels"`
+	MentionsRoles    []string        `json:"mentions_roles"`
 	Attachments      json.RawMessage `json:"attachments"`
 	Type             string          `json:"type"`
+	ReplyTo          string          `json:"reply_to"`
+	ThreadID         string          `json:"thread_id"`
 }
 
 type EditMessageBody struct {
@@ -36,27 +52,76 @@ type EditMessageBody struct {
 }
 
 type MessageResponse struct {
-	ID               string          `json:"id"`
-	AuthorID         string          `json:"author_id"`
-	ServerID         string          `json:"server_id"`
-	ChannelID        string          `json:"channel_id"`
-	Content          json.RawMessage `json:"content"`
-	Everyone         bool            `json:"everyone"`
-	MentionsUsers    []string        `json:"mentions_users"`
-	MentionsChannels []string        `json:"mentions_channels"`
-	Attachments      json.RawMessage `json:"attachments"`
-	CreatedAt        time.Time       `json:"created_at"`
-	UpdatedAt        time.Time       `json:"updated_at"`
+	ID               string             `json:"id"`
+	AuthorID         string             `json:"author_id"`
+	ServerID         string             `json:"server_id"`
+	ChannelID        string             `json:"channel_id"`
+	Content          json.RawMessage    `json:"content"`
+	Everyone         bool               `json:"everyone"`
+	MentionsUsers    []string           `json:"mentions_users"`
+	MentionsChannels []string           `json:"mentions_channels"`
+	MentionsRoles    []string           `json:"mentions_roles"`
+	Attachments      json.RawMessage    `json:"attachments"`
+	Reactions        []ReactionResponse `json:"reactions"`
+	ReplyTo          *MessageReference  `json:"reply_to"`
+	ThreadID         pgtype.Text        `json:"thread_id"`
+	CreatedAt        time.Time          `json:"created_at"`
+	UpdatedAt        time.Time          `json:"updated_at"`
+}
+
+type MessageReference struct {
+	ID        string          `json:"id"`
+	AuthorID  string          `json:"author_id"`
+	Content   json.RawMessage `json:"content"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type GetMessagesParams struct {
+	ThreadID string
+	Before   string
+	After    string
+	Around   string
+	Limit    int
+}
+
+type MessagesPage struct {
+	Messages      []MessageResponse `json:"messages"`
+	HasMoreBefore bool              `json:"has_more_before"`
+	HasMoreAfter  bool              `json:"has_more_after"`
 }
 
 func CreateMessage(ctx context.Context, userID, serverID, channelID string, body *MessageBody) (*proto.BroadcastChatMessage, error) {
-	if serverID != "global" {
-		res, err := db.Query.CheckChannelMembership(ctx, queries.CheckChannelMembershipParams{
-			ID:     channelID,
-			UserID: userID,
-		})
-		if err != nil || res.RowsAffected() == 0 {
-			return nil, ErrUnauthorizedMessageCreation
+	abilities, err := GetChannelAbilities(ctx, channelID, userID)
+	if err != nil || !abilities.Has(permissions.SendMessages) {
+		return nil, ErrUnauthorizedMessageCreation
+	}
+
+	if len(body.Attachments) > 0 && !abilities.Has(permissions.AttachFiles) {
+		return nil, ErrUnauthorizedMessageCreation
+	}
+
+	threadID := pgtype.Text{String: body.ThreadID, Valid: body.ThreadID != ""}
+
+	var reference *proto.MessageReference
+	if body.ReplyTo != "" {
+		// a reply stays in the thread, or the channel, of the message it answers
+		replied, err := db.Query.GetMessage(ctx, body.ReplyTo)
+		if err != nil || replied.ChannelID != channelID || replied.ThreadID != threadID {
+			return nil, ErrInvalidReply
+		}
+
+		reference = &proto.MessageReference{
+			Id:        replied.ID,
+			AuthorId:  replied.AuthorID,
+			Content:   replied.Content,
+			CreatedAt: timestamppb.New(replied.CreatedAt),
+		}
+	}
+
+	if body.ThreadID != "" {
+		thread, err := db.Query.GetThread(ctx, body.ThreadID)
+		if err != nil || thread.ChannelID != channelID {
+			return nil, ErrThreadNotFound
 		}
 	}
 
@@ -69,7 +134,10 @@ func CreateMessage(ctx context.Context, userID, serverID, channelID string, body
 		Everyone:         body.Everyone,
 		MentionsUsers:    body.MentionsUsers,
 		MentionsChannels: body.MentionsChannels,
+		MentionsRoles:    body.MentionsRoles,
 		Attachments:      body.Attachments,
+		ReplyTo:          pgtype.Text{String: body.ReplyTo, Valid: body.ReplyTo != ""},
+		ThreadID:         threadID,
 	})
 	if err != nil {
 		return nil, err
@@ -84,8 +152,11 @@ func CreateMessage(ctx context.Context, userID, serverID, channelID string, body
 		Everyone:         body.Everyone,
 		MentionsUsers:    body.MentionsUsers,
 		MentionsChannels: body.MentionsChannels,
+		MentionsRoles:    body.MentionsRoles,
 		Attachments:      body.Attachments,
 		CreatedAt:        timestamppb.New(m.CreatedAt),
+		ReplyTo:          reference,
+		ThreadId:         body.ThreadID,
 	}
 	return message, nil
 }
@@ -96,6 +167,7 @@ func EditMessage(ctx context.Context, userID, serverID, channelID, messageID str
 		Everyone:         body.Everyone,
 		MentionsUsers:    body.MentionsUsers,
 		MentionsChannels: body.MentionsChannels,
+		MentionsRoles:    body.MentionsRoles,
 		Content:          body.Content,
 		AuthorID:         userID,
 	})
@@ -111,13 +183,14 @@ func EditMessage(ctx context.Context, userID, serverID, channelID, messageID str
 		Everyone:         body.Everyone,
 		MentionsUsers:    body.MentionsUsers,
 		MentionsChannels: body.MentionsChannels,
+		MentionsRoles:    body.MentionsRoles,
 		UpdatedAt:        timestamppb.New(time.Now()),
 	}
 
 	return message, nil
 }
 
-func DeleteMessage(ctx context.Context, messageID, userID string) error {
+func DeleteMessage(ctx context.Context, channelID, messageID, userID string) error {
 	s3Client := s3.NewFromConfig(GetAWSConfig())
 
 	mess, err := db.Query.GetMessage(ctx, messageID)
@@ -125,6 +198,21 @@ func DeleteMessage(ctx context.Context, messageID, userID string) error {
 		return err
 	}
 
+	if mess.ChannelID != channelID {
+		return ErrUnauthorizedMessageDeletion
+	}
+
+	if mess.AuthorID != userID {
+		if mess.ServerID == "global" {
+			return ErrUnauthorizedMessageDeletion
+		}
+
+		err := CheckAbility(ctx, mess.ServerID, userID, permissions.ManageMessages)
+		if err != nil {
+			return ErrUnauthorizedMessageDeletion
+		}
+	}
+
 	if len(mess.Attachments) > 0 {
 		var attachments []Attachment
 		err := json.Unmarshal(mess.Attachments, &attachments)
@@ -142,8 +230,8 @@ func DeleteMessage(ctx context.Context, messageID, userID string) error {
 	}
 
 	res, err := db.Query.DeleteMessage(ctx, queries.DeleteMessageParams{
-		ID:       messageID,
-		AuthorID: userID,
+		ID:        messageID,
+		ChannelID: channelID,
 	})
 	if err != nil || res.RowsAffected() == 0 {
 		return ErrUnauthorizedMessageDeletion
@@ -152,14 +240,162 @@ func DeleteMessage(ctx context.Context, messageID, userID string) error {
 	return nil
 }
 
-func GetMessages(ctx context.Context, channelID string) ([]MessageResponse, error) {
-	var messages []MessageResponse
+func GetMessages(ctx context.Context, userID, channelID string, params *GetMessagesParams) (*MessagesPage, error) {
+	err := CheckChannelAbility(ctx, channelID, userID, permissions.ViewChannel)
+	if err != nil {
+		return nil, ErrUnauthorizedMessagesAccess
+	}
+
+	if params.ThreadID != "" {
+		thread, err := db.Query.GetThread(ctx, params.ThreadID)
+		if err != nil || thread.ChannelID != channelID {
+			return nil, ErrThreadNotFound
+		}
+	}
+	threadID := pgtype.Text{String: params.ThreadID, Valid: params.ThreadID != ""}
+
+	cursors := 0
+	for _, cursor := range []string{params.Before, params.After, params.Around} {
+		if cursor != "" {
+			cursors++
+		}
+	}
+	if cursors > 1 {
+		return nil, ErrInvalidMessagesCursor
+	}
+
+	if cursor := cmp.Or(params.Before, params.After, params.Around); cursor != "" {
+		message, err := db.Query.GetMessage(ctx, cursor)
+		if err != nil || message.ChannelID != channelID || message.ThreadID != threadID {
+			return nil, ErrMessagesCursorNotFound
+		}
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultMessagesLimit
+	}
+	limit = min(limit, MaxMessagesLimit)
+
+	page := &MessagesPage{}
+
+	switch {
+	case params.After != "":
+		m, err := db.Query.GetMessagesAfter(ctx, queries.GetMessagesAfterParams{
+			ChannelID: channelID,
+			ThreadID:  threadID,
+			ID:        params.After,
+			Limit:     int32(limit + 1),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		page.HasMoreBefore, err = db.Query.HasMessagesUntil(ctx, queries.HasMessagesUntilParams{
+			ChannelID: channelID,
+			ThreadID:  threadID,
+			ID:        params.After,
+		})
+		if err != nil {
+			return nil, err
+		}
 
-	m, err := db.Query.GetMessagesFromChannel(ctx, channelID)
+		page.HasMoreAfter = len(m) > limit
+		if page.HasMoreAfter {
+			m = m[:limit]
+		}
+		page.Messages = toMessageResponses(m)
+	case params.Around != "":
+		beforeLimit := limit / 2
+		afterLimit := limit - beforeLimit
+
+		before, err := db.Query.GetMessagesBefore(ctx, queries.GetMessagesBeforeParams{
+			ChannelID: channelID,
+			ThreadID:  threadID,
+			ID:        params.Around,
+			Limit:     int32(beforeLimit + 1),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		after, err := db.Query.GetMessagesAround(ctx, queries.GetMessagesAroundParams{
+			ChannelID: channelID,
+			ThreadID:  threadID,
+			ID:        params.Around,
+			Limit:     int32(afterLimit + 1),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		page.HasMoreBefore = len(before) > beforeLimit
+		if page.HasMoreBefore {
+			before = before[:beforeLimit]
+		}
+		page.HasMoreAfter = len(after) > afterLimit
+		if page.HasMoreAfter {
+			after = after[:afterLimit]
+		}
+
+		slices.Reverse(before)
+		page.Messages = toMessageResponses(append(before, after...))
+	default:
+		var m []queries.Message
+		var err error
+
+		if params.Before != "" {
+			m, err = db.Query.GetMessagesBefore(ctx, queries.GetMessagesBeforeParams{
+				ChannelID: channelID,
+				ThreadID:  threadID,
+				ID:        params.Before,
+				Limit:     int32(limit + 1),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			page.HasMoreAfter, err = db.Query.HasMessagesSince(ctx, queries.HasMessagesSinceParams{
+				ChannelID: channelID,
+				ThreadID:  threadID,
+				ID:        params.Before,
+			})
+		} else {
+			m, err = db.Query.GetLatestMessagesFromChannel(ctx, queries.GetLatestMessagesFromChannelParams{
+				ChannelID: channelID,
+				ThreadID:  threadID,
+				Limit:     int32(limit + 1),
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		page.HasMoreBefore = len(m) > limit
+		if page.HasMoreBefore {
+			m = m[:limit]
+		}
+
+		slices.Reverse(m)
+		page.Messages = toMessageResponses(m)
+	}
+
+	err = attachReactions(ctx, page.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	err = attachReplies(ctx, page.Messages)
 	if err != nil {
 		return nil, err
 	}
 
+	return page, nil
+}
+
+func toMessageResponses(m []queries.Message) []MessageResponse {
+	messages := make([]MessageResponse, 0, len(m))
+
 	for _, message := range m {
 		messages = append(messages, MessageResponse{
 			ID:               message.ID,
@@ -170,11 +406,55 @@ func GetMessages(ctx context.Context, channelID string) ([]MessageResponse, erro
 			Everyone:         message.Everyone,
 			MentionsUsers:    message.MentionsUsers,
 			MentionsChannels: message.MentionsChannels,
+			MentionsRoles:    message.MentionsRoles,
 			Attachments:      message.Attachments,
+			Reactions:        []ReactionResponse{},
+			ThreadID:         message.ThreadID,
 			UpdatedAt:        message.UpdatedAt,
 			CreatedAt:        message.CreatedAt,
 		})
+
+		if message.ReplyTo.Valid {
+			messages[len(messages)-1].ReplyTo = &MessageReference{ID: message.ReplyTo.String}
+		}
 	}
 
-	return messages, nil
+	return messages
+}
+
+func attachReplies(ctx context.Context, messages []MessageResponse) error {
+	replyIDs := make([]string, 0)
+	for _, message := range messages {
+		if message.ReplyTo != nil {
+			replyIDs = append(replyIDs, message.ReplyTo.ID)
+		}
+	}
+
+	if len(replyIDs) == 0 {
+		return nil
+	}
+
+	replied, err := db.Query.GetMessagesByIds(ctx, replyIDs)
+	if err != nil {
+		return err
+	}
+
+	repliedMap := make(map[string]queries.Message)
+	for _, message := range replied {
+		repliedMap[message.ID] = message
+	}
+
+	for i := range messages {
+		if messages[i].ReplyTo == nil {
+			continue
+		}
+
+		if message, ok := repliedMap[messages[i].ReplyTo.ID]; ok {
+			messages[i].ReplyTo.AuthorID = message.AuthorID
+			messages[i].ReplyTo.Content = message.Content
+			messages[i].ReplyTo.CreatedAt = message.CreatedAt
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,310 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	"github.com/okzmo/kyob/internal/utils"
+	proto "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrBannedFromServer     = errors.New("banned from this server")
+	ErrCannotModerateMember = errors.New("cannot moderate this member")
+	ErrBanNotFound          = errors.New("ban not found")
+	ErrMemberTimedOut       = errors.New("timed out in this server")
+)
+
+const (
+	ModerationKick          = "KICK"
+	ModerationBan           = "BAN"
+	ModerationUnban         = "UNBAN"
+	ModerationTimeout       = "TIMEOUT"
+	ModerationRemoveTimeout = "REMOVE_TIMEOUT"
+)
+
+type KickBody struct {
+	Reason string `validate:"max=512" json:"reason"`
+}
+
+type BanBody struct {
+	Reason string `validate:"max=512" json:"reason"`
+	// Duration is in seconds, zero meaning the ban never expires.
+	Duration int `validate:"min=0" json:"duration"`
+}
+
+type TimeoutBody struct {
+	Reason string `validate:"max=512" json:"reason"`
+	// Duration is in seconds, up to 28 days.
+	Duration int `validate:"required,min=1,max=2419200" json:"duration"`
+}
+
+type BanResponse struct {
+	ID          string      `json:"id"`
+	UserID      string      `json:"user_id"`
+	Username    string      `json:"username"`
+	DisplayName string      `json:"display_name"`
+	Avatar      pgtype.Text `json:"avatar"`
+	ModeratorID string      `json:"moderator_id"`
+	Reason      string      `json:"reason"`
+	ExpiresAt   *time.Time  `json:"expires_at"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+// checkModeration makes sure the requester holds the ability and sits above
+// the target. Targets who are not members only pass when allowed, which is
+// the case for bans.
+func checkModeration(ctx context.Context, serverID, requesterID, userID, ability string, allowNonMember bool) error {
+	if requesterID == userID {
+		return ErrCannotModerateMember
+	}
+
+	abilities, err := GetMemberAbilities(ctx, serverID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if !abilities.Has(ability) {
+		return ErrMissingAbility
+	}
+
+	target, err := GetMemberAbilities(ctx, serverID, userID)
+	if err != nil {
+		if errors.Is(err, ErrNotServerMember) && allowNonMember {
+			return nil
+		}
+		return err
+	}
+
+	if !abilities.OutranksMember(target) {
+		return ErrRoleTooHigh
+	}
+
+	return nil
+}
+
+func KickMember(ctx context.Context, requesterID, serverID, userID string, body *KickBody) (*proto.BroadcastModerationAction, error) {
+	err := checkModeration(ctx, serverID, requesterID, userID, permissions.Kick, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Query.LeaveServer(ctx, queries.LeaveServerParams{
+		UserID:   userID,
+		ServerID: serverID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := &proto.BroadcastModerationAction{
+		ServerId:    serverID,
+		UserId:      userID,
+		ModeratorId: requesterID,
+		Action:      ModerationKick,
+		Reason:      body.Reason,
+	}
+	auditModeration(ctx, AuditMemberKick, action, nil)
+
+	return action, nil
+}
+
+func BanMember(ctx context.Context, requesterID, serverID, userID string, body *BanBody) (*proto.BroadcastModerationAction, error) {
+	err := checkModeration(ctx, serverID, requesterID, userID, permissions.Ban, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt pgtype.Timestamptz
+	if body.Duration > 0 {
+		expiresAt = pgtype.Timestamptz{Time: time.Now().Add(time.Duration(body.Duration) * time.Second), Valid: true}
+	}
+
+	_, err = db.Query.CreateBan(ctx, queries.CreateBanParams{
+		ID:          utils.Node.Generate().String(),
+		ServerID:    serverID,
+		UserID:      userID,
+		ModeratorID: requesterID,
+		Reason:      body.Reason,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Query.LeaveServer(ctx, queries.LeaveServerParams{
+		UserID:   userID,
+		ServerID: serverID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := &proto.BroadcastModerationAction{
+		ServerId:    serverID,
+		UserId:      userID,
+		ModeratorId: requesterID,
+		Action:      ModerationBan,
+		Reason:      body.Reason,
+	}
+	changes := AuditChanges{"expires_at": {}}
+	if expiresAt.Valid {
+		action.ExpiresAt = timestamppb.New(expiresAt.Time)
+		changes["expires_at"] = AuditChange{After: expiresAt.Time}
+	}
+	auditModeration(ctx, AuditMemberBan, action, changes)
+
+	return action, nil
+}
+
+func UnbanMember(ctx context.Context, requesterID, serverID, userID string) (*proto.BroadcastModerationAction, error) {
+	err := CheckAbility(ctx, serverID, requesterID, permissions.Ban)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := db.Query.DeleteBan(ctx, queries.DeleteBanParams{
+		ServerID: serverID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.RowsAffected() == 0 {
+		return nil, ErrBanNotFound
+	}
+
+	action := &proto.BroadcastModerationAction{
+		ServerId:    serverID,
+		UserId:      userID,
+		ModeratorId: requesterID,
+		Action:      ModerationUnban,
+	}
+	auditModeration(ctx, AuditMemberUnban, action, nil)
+
+	return action, nil
+}
+
+func GetBans(ctx context.Context, requesterID, serverID string) ([]BanResponse, error) {
+	err := CheckAbility(ctx, serverID, requesterID, permissions.Ban)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query.GetBans(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	bans := make([]BanResponse, 0, len(rows))
+	for _, row := range rows {
+		ban := BanResponse{
+			ID:          row.ID,
+			UserID:      row.UserID,
+			Username:    row.Username,
+			DisplayName: row.DisplayName,
+			Avatar:      row.Avatar,
+			ModeratorID: row.ModeratorID,
+			Reason:      row.Reason,
+			CreatedAt:   row.CreatedAt,
+		}
+		if row.ExpiresAt.Valid {
+			ban.ExpiresAt = &row.ExpiresAt.Time
+		}
+
+		bans = append(bans, ban)
+	}
+
+	return bans, nil
+}
+
+func TimeoutMember(ctx context.Context, requesterID, serverID, userID string, body *TimeoutBody) (*proto.BroadcastModerationAction, error) {
+	err := checkModeration(ctx, serverID, requesterID, userID, permissions.Mute, false)
+	if err != nil {
+		return nil, err
+	}
+
+	until := time.Now().Add(time.Duration(body.Duration) * time.Second)
+	_, err = db.Query.SetMemberTimeout(ctx, queries.SetMemberTimeoutParams{
+		TimeoutUntil: pgtype.Timestamptz{Time: until, Valid: true},
+		ServerID:     serverID,
+		UserID:       userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := &proto.BroadcastModerationAction{
+		ServerId:    serverID,
+		UserId:      userID,
+		ModeratorId: requesterID,
+		Action:      ModerationTimeout,
+		Reason:      body.Reason,
+		ExpiresAt:   timestamppb.New(until),
+	}
+	auditModeration(ctx, AuditMemberTimeout, action, AuditChanges{
+		"timeout_until": {After: until},
+	})
+
+	return action, nil
+}
+
+func RemoveMemberTimeout(ctx context.Context, requesterID, serverID, userID string) (*proto.BroadcastModerationAction, error) {
+	err := checkModeration(ctx, serverID, requesterID, userID, permissions.Mute, false)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Query.SetMemberTimeout(ctx, queries.SetMemberTimeoutParams{
+		ServerID: serverID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := &proto.BroadcastModerationAction{
+		ServerId:    serverID,
+		UserId:      userID,
+		ModeratorId: requesterID,
+		Action:      ModerationRemoveTimeout,
+	}
+	auditModeration(ctx, AuditMemberTimeoutClear, action, nil)
+
+	return action, nil
+}
+
+func auditModeration(ctx context.Context, auditAction string, action *proto.BroadcastModerationAction, changes AuditChanges) {
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   action.ServerId,
+		ActorID:    action.ModeratorId,
+		Action:     auditAction,
+		TargetType: AuditTargetUser,
+		TargetID:   action.UserId,
+		Changes:    changes,
+		Reason:     action.Reason,
+	})
+}
+
+func checkBan(ctx context.Context, serverID, userID string) error {
+	res, err := db.Query.IsBanned(ctx, queries.IsBannedParams{
+		ServerID: serverID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() > 0 {
+		return ErrBannedFromServer
+	}
+
+	return nil
+}
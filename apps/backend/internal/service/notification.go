@@ -0,0 +1,340 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	"github.com/okzmo/kyob/internal/utils"
+	proto "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	MentionUser     = "user"
+	MentionRole     = "role"
+	MentionEveryone = "everyone"
+)
+
+const (
+	DefaultNotificationsLimit = 50
+	MaxNotificationsLimit     = 100
+)
+
+type GetNotificationsParams struct {
+	Before     string
+	UnreadOnly bool
+	Limit      int
+}
+
+type NotificationAuthor struct {
+	ID          string      `json:"id"`
+	Username    string      `json:"username"`
+	DisplayName string      `json:"display_name"`
+	Avatar      pgtype.Text `json:"avatar"`
+}
+
+type NotificationResponse struct {
+	ID        string             `json:"id"`
+	Type      string             `json:"type"`
+	ServerID  string             `json:"server_id"`
+	ChannelID string             `json:"channel_id"`
+	MessageID string             `json:"message_id"`
+	ThreadID  pgtype.Text        `json:"thread_id"`
+	Content   json.RawMessage    `json:"content"`
+	Author    NotificationAuthor `json:"author"`
+	Read      bool               `json:"read"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type NotificationsPage struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	HasMore       bool                   `json:"has_more"`
+}
+
+type MarkNotificationsReadBody struct {
+	IDs []string `validate:"max=100" json:"ids"`
+}
+
+// mentionType returns how the member is mentioned by the message, the most
+// direct mention winning, or an empty string when they are not.
+func mentionType(message *proto.BroadcastChatMessage, userID string, roles []string) string {
+	if slices.Contains(message.MentionsUsers, userID) {
+		return MentionUser
+	}
+
+	for _, role := range roles {
+		if slices.Contains(message.MentionsRoles, role) {
+			return MentionRole
+		}
+	}
+
+	if message.Everyone {
+		return MentionEveryone
+	}
+
+	return ""
+}
+
+// CreateMentionNotifications resolves who the message mentions among the
+// users allowed to view the channel and stores a notification for each of
+// them, whether they are connected or not. Users who muted the channel or
+// turned its notifications off are left out.
+func CreateMentionNotifications(ctx context.Context, message *proto.BroadcastChatMessage) ([]*proto.Notification, error) {
+	if !message.Everyone && len(message.MentionsUsers) == 0 && len(message.MentionsRoles) == 0 {
+		return nil, nil
+	}
+
+	channel, err := db.Query.GetChannel(ctx, message.ChannelId)
+	if err != nil {
+		return nil, ErrChannelNotFound
+	}
+
+	recipients := make(map[string]string)
+
+	if channel.ServerID == "global" {
+		for _, userID := range channel.Users {
+			if mention := mentionType(message, userID, nil); mention != "" {
+				recipients[userID] = mention
+			}
+		}
+	} else {
+		server, err := db.Query.GetServer(ctx, channel.ServerID)
+		if err != nil {
+			return nil, ErrServerNotFound
+		}
+
+		members, err := db.Query.GetServerMembersRoles(ctx, channel.ServerID)
+		if err != nil {
+			return nil, err
+		}
+
+		roles, err := db.Query.GetRolesFromServers(ctx, []string{channel.ServerID})
+		if err != nil {
+			return nil, err
+		}
+
+		rolesByID := make(map[string]permissions.Role)
+		for _, role := range roles {
+			rolesByID[role.ID] = permissions.Role{
+				ID:        role.ID,
+				Idx:       role.Idx,
+				Abilities: role.Abilities,
+			}
+		}
+
+		overwrites, err := db.Query.GetChannelOverwrites(ctx, channel.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			mention := mentionType(message, member.UserID, member.Roles)
+			if mention == "" {
+				continue
+			}
+
+			abilities := permissions.Owner()
+			if server.OwnerID != member.UserID {
+				memberRoles := make([]permissions.Role, 0, len(member.Roles))
+				for _, roleID := range member.Roles {
+					if role, ok := rolesByID[roleID]; ok {
+						memberRoles = append(memberRoles, role)
+					}
+				}
+				abilities = permissions.Resolve(memberRoles)
+			}
+
+			if channelAbilities(abilities, channel, member.UserID, overwrites).Has(permissions.ViewChannel) {
+				recipients[member.UserID] = mention
+			}
+		}
+	}
+	delete(recipients, message.AuthorId)
+
+	if len(recipients) == 0 {
+		return nil, nil
+	}
+
+	userIDs := make([]string, 0, len(recipients))
+	for userID := range recipients {
+		userIDs = append(userIDs, userID)
+	}
+
+	settings, err := getMembersNotificationSettings(ctx, channel.ServerID, channel.ID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for userID, mention := range recipients {
+		if !settings[userID].notifies(mention, now) {
+			delete(recipients, userID)
+		}
+	}
+
+	if len(recipients) == 0 {
+		return nil, nil
+	}
+
+	rows := make([]queries.CreateNotificationsParams, 0, len(recipients))
+	notifications := make([]*proto.Notification, 0, len(recipients))
+	for userID, mention := range recipients {
+		id := utils.Node.Generate().String()
+
+		rows = append(rows, queries.CreateNotificationsParams{
+			ID:        id,
+			UserID:    userID,
+			MessageID: message.Id,
+			ServerID:  message.ServerId,
+			ChannelID: message.ChannelId,
+			AuthorID:  message.AuthorId,
+			Type:      mention,
+		})
+
+		notifications = append(notifications, &proto.Notification{
+			Id:        id,
+			UserId:    userID,
+			Type:      mention,
+			Message:   message,
+			CreatedAt: timestamppb.New(now),
+		})
+	}
+
+	_, err = db.Query.CreateNotifications(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+type MessageBadges struct {
+	Unread  bool
+	Mention bool
+}
+
+// GetMessageBadges tells for each of the given users whether a new message
+// counts toward their unread and mention badges of the channel, applying
+// their notification settings the same way the counts of the setup do.
+func GetMessageBadges(ctx context.Context, message *proto.BroadcastChatMessage, userIDs []string) (map[string]MessageBadges, error) {
+	badges := make(map[string]MessageBadges)
+	if message.ThreadId != "" {
+		// thread messages never count toward the badges of the channel
+		return badges, nil
+	}
+
+	var membersRoles map[string][]string
+	if message.ServerId != "global" && len(message.MentionsRoles) > 0 {
+		members, err := db.Query.GetServerMembersRoles(ctx, message.ServerId)
+		if err != nil {
+			return nil, err
+		}
+
+		membersRoles = make(map[string][]string, len(members))
+		for _, member := range members {
+			membersRoles[member.UserID] = member.Roles
+		}
+	}
+
+	settings, err := getMembersNotificationSettings(ctx, message.ServerId, message.ChannelId, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, userID := range userIDs {
+		if userID == message.AuthorId {
+			continue
+		}
+
+		counts := queries.GetUnreadCountsRow{UnreadCount: 1}
+		switch mentionType(message, userID, membersRoles[userID]) {
+		case MentionUser, MentionRole:
+			counts.MentionCount = 1
+		case MentionEveryone:
+			counts.EveryoneCount = 1
+		}
+
+		unread, mentions := settings[userID].badges(counts, now)
+		badges[userID] = MessageBadges{
+			Unread:  unread > 0,
+			Mention: mentions > 0,
+		}
+	}
+
+	return badges, nil
+}
+
+func GetNotifications(ctx context.Context, userID string, params *GetNotificationsParams) (*NotificationsPage, error) {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultNotificationsLimit
+	}
+	limit = min(limit, MaxNotificationsLimit)
+
+	rows, err := db.Query.GetNotifications(ctx, queries.GetNotificationsParams{
+		UserID:     userID,
+		Before:     pgtype.Text{String: params.Before, Valid: params.Before != ""},
+		UnreadOnly: params.UnreadOnly,
+		Limit:      int32(limit + 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &NotificationsPage{}
+	page.HasMore = len(rows) > limit
+	if page.HasMore {
+		rows = rows[:limit]
+	}
+
+	page.Notifications = make([]NotificationResponse, 0, len(rows))
+	for _, row := range rows {
+		page.Notifications = append(page.Notifications, NotificationResponse{
+			ID:        row.ID,
+			Type:      row.Type,
+			ServerID:  row.ServerID,
+			ChannelID: row.ChannelID,
+			MessageID: row.MessageID,
+			ThreadID:  row.ThreadID,
+			Content:   row.Content,
+			Author: NotificationAuthor{
+				ID:          row.AuthorID,
+				Username:    row.Username,
+				DisplayName: row.DisplayName,
+				Avatar:      row.Avatar,
+			},
+			Read:      row.ReadAt.Valid,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+
+	return page, nil
+}
+
+// MarkNotificationsRead marks the given notifications as read, or every
+// notification of the user when no id is given.
+func MarkNotificationsRead(ctx context.Context, userID string, body *MarkNotificationsReadBody) (*proto.NotificationsRead, error) {
+	if len(body.IDs) == 0 {
+		body.IDs = nil
+	}
+
+	_, err := db.Query.MarkNotificationsRead(ctx, queries.MarkNotificationsReadParams{
+		UserID: userID,
+		Ids:    body.IDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.NotificationsRead{
+		Ids: body.IDs,
+		All: len(body.IDs) == 0,
+	}, nil
+}
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+)
+
+var (
+	ErrInvalidNotificationLevel = errors.New("invalid notification level")
+	ErrInvalidMuteDuration      = errors.New("invalid mute duration")
+)
+
+type NotificationSettingsBody struct {
+	Level            string     `validate:"omitempty,oneof=all mentions nothing" json:"level"`
+	MutedUntil       *time.Time `json:"muted_until"`
+	SuppressEveryone bool       `json:"suppress_everyone"`
+}
+
+type NotificationSettingsResponse struct {
+	ServerID         string     `json:"server_id"`
+	ChannelID        string     `json:"channel_id,omitempty"`
+	Level            string     `json:"level,omitempty"`
+	MutedUntil       *time.Time `json:"muted_until"`
+	SuppressEveryone bool       `json:"suppress_everyone"`
+}
+
+// notificationSettings holds the server wide settings of a user and the
+// override of a single channel, either can be missing.
+type notificationSettings struct {
+	server  *queries.NotificationSetting
+	channel *queries.NotificationSetting
+}
+
+// level returns the effective level, a channel override wins over the
+// server settings and a user that never chose anything gets everything.
+func (s notificationSettings) level() queries.NotificationLevel {
+	if s.channel != nil && s.channel.Level.Valid {
+		return s.channel.Level.NotificationLevel
+	}
+
+	if s.server != nil && s.server.Level.Valid {
+		return s.server.Level.NotificationLevel
+	}
+
+	return queries.NotificationLevelAll
+}
+
+func (s notificationSettings) muted(now time.Time) bool {
+	for _, setting := range []*queries.NotificationSetting{s.server, s.channel} {
+		if setting != nil && setting.MutedUntil.Valid && setting.MutedUntil.Time.After(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s notificationSettings) suppressEveryone() bool {
+	return (s.server != nil && s.server.SuppressEveryone) || (s.channel != nil && s.channel.SuppressEveryone)
+}
+
+// notifies reports whether a mention of the given type reaches the user.
+func (s notificationSettings) notifies(mention string, now time.Time) bool {
+	if s.muted(now) || s.level() == queries.NotificationLevelNothing {
+		return false
+	}
+
+	return mention != MentionEveryone || !s.suppressEveryone()
+}
+
+// badges returns the unread and mention counts the user should see, a muted
+// channel shows nothing and the mentions level only keeps the mentions.
+func (s notificationSettings) badges(counts queries.GetUnreadCountsRow, now time.Time) (int32, int32) {
+	if s.muted(now) || s.level() == queries.NotificationLevelNothing {
+		return 0, 0
+	}
+
+	mentions := counts.MentionCount
+	if !s.suppressEveryone() {
+		mentions += counts.EveryoneCount
+	}
+
+	if s.level() == queries.NotificationLevelMentions {
+		return 0, mentions
+	}
+
+	return counts.UnreadCount, mentions
+}
+
+// settingsByTarget indexes the settings of a single user by channel, and by
+// server for the server wide ones.
+type settingsByTarget struct {
+	servers  map[string]*queries.NotificationSetting
+	channels map[string]*queries.NotificationSetting
+}
+
+func newSettingsByTarget(rows []queries.NotificationSetting) settingsByTarget {
+	settings := settingsByTarget{
+		servers:  make(map[string]*queries.NotificationSetting),
+		channels: make(map[string]*queries.NotificationSetting),
+	}
+
+	for i := range rows {
+		if rows[i].ChannelID.Valid {
+			settings.channels[rows[i].ChannelID.String] = &rows[i]
+		} else {
+			settings.servers[rows[i].ServerID] = &rows[i]
+		}
+	}
+
+	return settings
+}
+
+func (s settingsByTarget) forChannel(serverID, channelID string) notificationSettings {
+	return notificationSettings{
+		server:  s.servers[serverID],
+		channel: s.channels[channelID],
+	}
+}
+
+// getMembersNotificationSettings returns the settings that apply to the
+// channel for each of the given users.
+func getMembersNotificationSettings(ctx context.Context, serverID, channelID string, userIDs []string) (map[string]notificationSettings, error) {
+	rows, err := db.Query.GetMembersNotificationSettings(ctx, queries.GetMembersNotificationSettingsParams{
+		UserIds:   userIDs,
+		ServerID:  serverID,
+		ChannelID: pgtype.Text{String: channelID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]notificationSettings)
+	for i := range rows {
+		userSettings := settings[rows[i].UserID]
+		if rows[i].ChannelID.Valid {
+			userSettings.channel = &rows[i]
+		} else {
+			userSettings.server = &rows[i]
+		}
+		settings[rows[i].UserID] = userSettings
+	}
+
+	return settings, nil
+}
+
+func newNotificationSettingsResponse(setting queries.NotificationSetting) NotificationSettingsResponse {
+	res := NotificationSettingsResponse{
+		ServerID:         setting.ServerID,
+		ChannelID:        setting.ChannelID.String,
+		SuppressEveryone: setting.SuppressEveryone,
+	}
+
+	if setting.Level.Valid {
+		res.Level = string(setting.Level.NotificationLevel)
+	}
+
+	if setting.MutedUntil.Valid {
+		mutedUntil := setting.MutedUntil.Time
+		res.MutedUntil = &mutedUntil
+	}
+
+	return res
+}
+
+func GetNotificationSettings(ctx context.Context, userID string) ([]NotificationSettingsResponse, error) {
+	rows, err := db.Query.GetNotificationSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]NotificationSettingsResponse, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, newNotificationSettingsResponse(row))
+	}
+
+	return res, nil
+}
+
+// UpdateNotificationSettings stores the settings of the user for a server, or
+// for one of its channels when a channel id is given. An empty level on a
+// channel falls back to the level of the server.
+func UpdateNotificationSettings(ctx context.Context, userID, serverID, channelID string, body *NotificationSettingsBody) (*NotificationSettingsResponse, error) {
+	level := queries.NullNotificationLevel{
+		NotificationLevel: queries.NotificationLevel(body.Level),
+		Valid:             body.Level != "",
+	}
+	switch level.NotificationLevel {
+	case "", queries.NotificationLevelAll, queries.NotificationLevelMentions, queries.NotificationLevelNothing:
+	default:
+		return nil, ErrInvalidNotificationLevel
+	}
+
+	var mutedUntil pgtype.Timestamptz
+	if body.MutedUntil != nil {
+		if !body.MutedUntil.After(time.Now()) {
+			return nil, ErrInvalidMuteDuration
+		}
+		mutedUntil = pgtype.Timestamptz{Time: *body.MutedUntil, Valid: true}
+	}
+
+	err := checkNotificationTarget(ctx, userID, serverID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var setting queries.NotificationSetting
+	if channelID == "" {
+		setting, err = db.Query.UpsertServerNotificationSettings(ctx, queries.UpsertServerNotificationSettingsParams{
+			UserID:           userID,
+			ServerID:         serverID,
+			Level:            level,
+			MutedUntil:       mutedUntil,
+			SuppressEveryone: body.SuppressEveryone,
+		})
+	} else {
+		setting, err = db.Query.UpsertChannelNotificationSettings(ctx, queries.UpsertChannelNotificationSettingsParams{
+			UserID:           userID,
+			ServerID:         serverID,
+			ChannelID:        pgtype.Text{String: channelID, Valid: true},
+			Level:            level,
+			MutedUntil:       mutedUntil,
+			SuppressEveryone: body.SuppressEveryone,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := newNotificationSettingsResponse(setting)
+	return &res, nil
+}
+
+// ResetNotificationSettings drops the settings of the user for a server or a
+// channel, bringing back the defaults.
+func ResetNotificationSettings(ctx context.Context, userID, serverID, channelID string) error {
+	err := checkNotificationTarget(ctx, userID, serverID, channelID)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Query.DeleteNotificationSettings(ctx, queries.DeleteNotificationSettingsParams{
+		UserID:    userID,
+		ServerID:  serverID,
+		ChannelID: pgtype.Text{String: channelID, Valid: channelID != ""},
+	})
+
+	return err
+}
+
+func checkNotificationTarget(ctx context.Context, userID, serverID, channelID string) error {
+	if channelID == "" {
+		if serverID == "global" {
+			return nil
+		}
+
+		_, err := GetMemberAbilities(ctx, serverID, userID)
+		return err
+	}
+
+	channel, err := db.Query.GetChannel(ctx, channelID)
+	if err != nil || channel.ServerID != serverID {
+		return ErrChannelNotFound
+	}
+
+	return CheckChannelAbility(ctx, channelID, userID, permissions.ViewChannel)
+}
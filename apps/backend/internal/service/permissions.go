@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+)
+
+var (
+	ErrNotServerMember = errors.New("not a member of this server")
+	ErrMissingAbility  = errors.New("missing ability")
+	ErrRoleTooHigh     = errors.New("role is not below your highest role")
+)
+
+func GetMemberAbilities(ctx context.Context, serverID, userID string) (*permissions.Abilities, error) {
+	server, err := db.Query.GetServer(ctx, serverID)
+	if err != nil {
+		return nil, ErrServerNotFound
+	}
+
+	if server.OwnerID == userID {
+		return permissions.Owner(), nil
+	}
+
+	res, err := db.Query.IsMember(ctx, queries.IsMemberParams{
+		ServerID: serverID,
+		UserID:   userID,
+	})
+	if err != nil || res.RowsAffected() == 0 {
+		return nil, ErrNotServerMember
+	}
+
+	rows, err := db.Query.GetUserAbilities(ctx, queries.GetUserAbilitiesParams{
+		ServerID: serverID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]permissions.Role, 0, len(rows))
+	for _, row := range rows {
+		roles = append(roles, permissions.Role{
+			ID:        row.ID,
+			Idx:       row.Idx,
+			Abilities: row.Abilities,
+		})
+	}
+
+	return permissions.Resolve(roles), nil
+}
+
+func CheckAbility(ctx context.Context, serverID, userID, ability string) error {
+	abilities, err := GetMemberAbilities(ctx, serverID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !abilities.Has(ability) {
+		return ErrMissingAbility
+	}
+
+	return nil
+}
+
+func CheckRoleCreation(ctx context.Context, serverID, userID string, idx int32, roleAbilities []string) error {
+	abilities, err := GetMemberAbilities(ctx, serverID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !abilities.Has(permissions.ManageRoles) {
+		return ErrMissingAbility
+	}
+
+	if !abilities.Outranks(idx) {
+		return ErrRoleTooHigh
+	}
+
+	for _, ability := range roleAbilities {
+		if !abilities.Has(ability) {
+			return ErrMissingAbility
+		}
+	}
+
+	return nil
+}
+
+func checkRoleManagement(ctx context.Context, serverID, userID, roleID string) (*permissions.Abilities, *queries.GetRoleRow, error) {
+	abilities, err := GetMemberAbilities(ctx, serverID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !abilities.Has(permissions.ManageRoles) {
+		return nil, nil, ErrMissingAbility
+	}
+
+	role, err := db.Query.GetRole(ctx, roleID)
+	if err != nil || role.ServerID != serverID {
+		return nil, nil, ErrRoleNotFound
+	}
+
+	if !abilities.Outranks(role.Idx) {
+		return nil, nil, ErrRoleTooHigh
+	}
+
+	return abilities, &role, nil
+}
+
+func CheckRoleManagement(ctx context.Context, serverID, userID, roleID string) (*queries.GetRoleRow, error) {
+	_, role, err := checkRoleManagement(ctx, serverID, userID, roleID)
+	return role, err
+}
+
+// CheckRoleMemberManagement also requires the requester to outrank the member
+// whose roles change, as moderation does. Members can give themselves or take
+// off a role below their highest one.
+func CheckRoleMemberManagement(ctx context.Context, serverID, requesterID, userID, roleID string) error {
+	abilities, _, err := checkRoleManagement(ctx, serverID, requesterID, roleID)
+	if err != nil {
+		return err
+	}
+
+	if requesterID == userID {
+		return nil
+	}
+
+	target, err := GetMemberAbilities(ctx, serverID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !abilities.OutranksMember(target) {
+		return ErrRoleTooHigh
+	}
+
+	return nil
+}
+
+// resolveServersAbilities resolves the user's abilities in each of the given
+// servers from the roles listed on their memberships.
+func resolveServersAbilities(userID string, servers []queries.GetServersFromUserRow, allRoles []queries.GetRolesFromServersRow) map[string]*permissions.Abilities {
+	rolesByID := make(map[string]permissions.Role)
+	for _, role := range allRoles {
+		rolesByID[role.ID] = permissions.Role{
+			ID:        role.ID,
+			Idx:       role.Idx,
+			Abilities: role.Abilities,
+		}
+	}
+
+	abilitiesByServer := make(map[string]*permissions.Abilities)
+	for _, server := range servers {
+		if server.OwnerID == userID {
+			abilitiesByServer[server.ID] = permissions.Owner()
+			continue
+		}
+
+		memberRoles := make([]permissions.Role, 0, len(server.Roles))
+		for _, roleID := range server.Roles {
+			if role, ok := rolesByID[roleID]; ok {
+				memberRoles = append(memberRoles, role)
+			}
+		}
+		abilitiesByServer[server.ID] = permissions.Resolve(memberRoles)
+	}
+
+	return abilitiesByServer
+}
+
+// channelOverwrites turns the channel's users and roles allow-lists into
+// overwrites so they are resolved like any other overwrite.
+func channelOverwrites(channel queries.Channel, rows []queries.ChannelOverwrite) []permissions.Overwrite {
+	overwrites := make([]permissions.Overwrite, 0, len(rows)+len(channel.Users)+len(channel.Roles)+1)
+
+	if len(channel.Users) > 0 || len(channel.Roles) > 0 {
+		overwrites = append(overwrites, permissions.Overwrite{
+			TargetID: channel.ServerID,
+			Type:     permissions.OverwriteRole,
+			Deny:     []string{permissions.ViewChannel},
+		})
+
+		for _, userID := range channel.Users {
+			overwrites = append(overwrites, permissions.Overwrite{
+				TargetID: userID,
+				Type:     permissions.OverwriteUser,
+				Allow:    []string{permissions.ViewChannel},
+			})
+		}
+
+		for _, roleID := range channel.Roles {
+			overwrites = append(overwrites, permissions.Overwrite{
+				TargetID: roleID,
+				Type:     permissions.OverwriteRole,
+				Allow:    []string{permissions.ViewChannel},
+			})
+		}
+	}
+
+	for _, row := range rows {
+		overwrites = append(overwrites, permissions.Overwrite{
+			TargetID: row.TargetID,
+			Type:     string(row.Type),
+			Allow:    row.Allow,
+			Deny:     row.Deny,
+		})
+	}
+
+	return overwrites
+}
+
+func channelAbilities(abilities *permissions.Abilities, channel queries.Channel, userID string, rows []queries.ChannelOverwrite) permissions.ChannelAbilities {
+	if channel.ServerID == "global" {
+		if slices.Contains(channel.Users, userID) {
+			return permissions.AllChannelAbilities()
+		}
+
+		return permissions.ChannelAbilities{}
+	}
+
+	return abilities.InChannel(channel.ServerID, userID, channelOverwrites(channel, rows))
+}
+
+func GetChannelAbilities(ctx context.Context, channelID, userID string) (permissions.ChannelAbilities, error) {
+	channel, err := db.Query.GetChannel(ctx, channelID)
+	if err != nil {
+		return nil, ErrChannelNotFound
+	}
+
+	var abilities *permissions.Abilities
+	if channel.ServerID != "global" {
+		abilities, err = GetMemberAbilities(ctx, channel.ServerID, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := db.Query.GetChannelOverwrites(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return channelAbilities(abilities, channel, userID, rows), nil
+}
+
+func CheckChannelAbility(ctx context.Context, channelID, userID, ability string) error {
+	abilities, err := GetChannelAbilities(ctx, channelID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !abilities.Has(ability) {
+		return ErrMissingAbility
+	}
+
+	return nil
+}
+
+func filterVisibleChannels(ctx context.Context, userID string, abilities map[string]*permissions.Abilities, channels []queries.Channel) ([]queries.Channel, error) {
+	channelIDs := make([]string, 0, len(channels))
+	for _, channel := range channels {
+		channelIDs = append(channelIDs, channel.ID)
+	}
+
+	allOverwrites, err := db.Query.GetChannelsOverwrites(ctx, channelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	overwritesByChannel := make(map[string][]queries.ChannelOverwrite)
+	for _, overwrite := range allOverwrites {
+		overwritesByChannel[overwrite.ChannelID] = append(overwritesByChannel[overwrite.ChannelID], overwrite)
+	}
+
+	visible := make([]queries.Channel, 0, len(channels))
+	for _, channel := range channels {
+		serverAbilities, ok := abilities[channel.ServerID]
+		if !ok && channel.ServerID != "global" {
+			continue
+		}
+
+		if channelAbilities(serverAbilities, channel, userID, overwritesByChannel[channel.ID]).Has(permissions.ViewChannel) {
+			visible = append(visible, channel)
+		}
+	}
+
+	return visible, nil
+}
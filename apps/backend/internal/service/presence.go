@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	proto "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrInvalidStatus       = errors.New("invalid status")
+	ErrInvalidCustomStatus = errors.New("invalid custom status")
+)
+
+type PresenceBody struct {
+	Status                string     `validate:"required,oneof=online idle dnd invisible" json:"status"`
+	CustomStatusText      string     `validate:"max=128" json:"custom_status_text"`
+	CustomStatusEmoji     string     `validate:"max=64" json:"custom_status_emoji"`
+	CustomStatusExpiresAt *time.Time `json:"custom_status_expires_at"`
+}
+
+type PresenceResponse struct {
+	Status                string     `json:"status"`
+	CustomStatusText      string     `json:"custom_status_text"`
+	CustomStatusEmoji     string     `json:"custom_status_emoji"`
+	CustomStatusExpiresAt *time.Time `json:"custom_status_expires_at"`
+}
+
+// IsInvisible reports whether the presence must be hidden from other users,
+// a missing presence is considered online.
+func IsInvisible(presence *proto.Presence) bool {
+	return presence != nil && presence.Status == string(queries.UserStatusInvisible)
+}
+
+func newPresence(userID string, status queries.UserStatus, text, emoji pgtype.Text, expiresAt pgtype.Timestamptz) *proto.Presence {
+	presence := &proto.Presence{
+		UserId: userID,
+		Status: string(status),
+	}
+
+	if expiresAt.Valid && !expiresAt.Time.After(time.Now()) {
+		return presence
+	}
+
+	presence.CustomStatusText = text.String
+	presence.CustomStatusEmoji = emoji.String
+	if expiresAt.Valid {
+		presence.CustomStatusExpiresAt = timestamppb.New(expiresAt.Time)
+	}
+
+	return presence
+}
+
+func NewPresenceResponse(presence *proto.Presence) PresenceResponse {
+	res := PresenceResponse{
+		Status:            presence.Status,
+		CustomStatusText:  presence.CustomStatusText,
+		CustomStatusEmoji: presence.CustomStatusEmoji,
+	}
+
+	if presence.CustomStatusExpiresAt != nil {
+		expiresAt := presence.CustomStatusExpiresAt.AsTime()
+		res.CustomStatusExpiresAt = &expiresAt
+	}
+
+	return res
+}
+
+// GetPresence drops the custom status once it has expired.
+func GetPresence(ctx context.Context, userID string) (*proto.Presence, error) {
+	user, err := db.Query.GetUserPresence(ctx, userID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	return newPresence(user.ID, user.Status, user.CustomStatusText, user.CustomStatusEmoji, user.CustomStatusExpiresAt), nil
+}
+
+func UpdatePresence(ctx context.Context, userID string, body *PresenceBody) (*proto.Presence, error) {
+	status := queries.UserStatus(body.Status)
+	switch status {
+	case queries.UserStatusOnline, queries.UserStatusIdle, queries.UserStatusDnd, queries.UserStatusInvisible:
+	default:
+		return nil, ErrInvalidStatus
+	}
+
+	if len(body.CustomStatusText) > 128 || len(body.CustomStatusEmoji) > 64 {
+		return nil, ErrInvalidCustomStatus
+	}
+
+	hasCustomStatus := body.CustomStatusText != "" || body.CustomStatusEmoji != ""
+	if body.CustomStatusExpiresAt != nil && (!hasCustomStatus || !body.CustomStatusExpiresAt.After(time.Now())) {
+		return nil, ErrInvalidCustomStatus
+	}
+
+	text := pgtype.Text{String: body.CustomStatusText, Valid: body.CustomStatusText != ""}
+	emoji := pgtype.Text{String: body.CustomStatusEmoji, Valid: body.CustomStatusEmoji != ""}
+	var expiresAt pgtype.Timestamptz
+	if body.CustomStatusExpiresAt != nil {
+		expiresAt = pgtype.Timestamptz{Time: *body.CustomStatusExpiresAt, Valid: true}
+	}
+
+	err := db.Query.UpdateUserPresence(ctx, queries.UpdateUserPresenceParams{
+		ID:                    userID,
+		Status:                status,
+		CustomStatusText:      text,
+		CustomStatusEmoji:     emoji,
+		CustomStatusExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newPresence(userID, status, text, emoji, expiresAt), nil
+}
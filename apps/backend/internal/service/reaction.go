@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	"github.com/okzmo/kyob/internal/utils"
+	proto "github.com/okzmo/kyob/types"
+)
+
+var (
+	ErrUnauthorizedReaction = errors.New("unauthorized reaction")
+	ErrEmojiNotFound        = errors.New("emoji not found")
+	ErrReactionExists       = errors.New("reaction already exists")
+	ErrReactionNotFound     = errors.New("reaction not found")
+)
+
+type ReactionBody struct {
+	Emoji   string `validate:"required_without=EmojiID,excluded_with=EmojiID,max=64" json:"emoji"`
+	EmojiID string `validate:"required_without=Emoji,excluded_with=Emoji" json:"emoji_id"`
+}
+
+type ReactionResponse struct {
+	Emoji    string   `json:"emoji"`
+	EmojiID  string   `json:"emoji_id"`
+	EmojiURL string   `json:"emoji_url"`
+	Count    int      `json:"count"`
+	Users    []string `json:"users"`
+}
+
+func checkReaction(ctx context.Context, userID, channelID, messageID string) error {
+	err := CheckChannelAbility(ctx, channelID, userID, permissions.ViewChannel)
+	if err != nil {
+		return ErrUnauthorizedReaction
+	}
+
+	message, err := db.Query.GetMessage(ctx, messageID)
+	if err != nil || message.ChannelID != channelID {
+		return ErrUnauthorizedReaction
+	}
+
+	return nil
+}
+
+func AddReaction(ctx context.Context, userID, serverID, channelID, messageID string, body *ReactionBody) (*proto.BroadcastReactionAdded, error) {
+	err := checkReaction(ctx, userID, channelID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	var emojiURL string
+	if body.EmojiID != "" {
+		emoji, err := db.Query.GetEmoji(ctx, body.EmojiID)
+		if err != nil {
+			return nil, ErrEmojiNotFound
+		}
+		emojiURL = emoji.Url
+	}
+
+	res, err := db.Query.AddReaction(ctx, queries.AddReactionParams{
+		ID:        utils.Node.Generate().String(),
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     pgtype.Text{String: body.Emoji, Valid: body.Emoji != ""},
+		EmojiID:   pgtype.Text{String: body.EmojiID, Valid: body.EmojiID != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.RowsAffected() == 0 {
+		return nil, ErrReactionExists
+	}
+
+	return &proto.BroadcastReactionAdded{
+		MessageId: messageID,
+		ServerId:  serverID,
+		ChannelId: channelID,
+		UserId:    userID,
+		Emoji:     body.Emoji,
+		EmojiId:   body.EmojiID,
+		EmojiUrl:  emojiURL,
+	}, nil
+}
+
+func RemoveReaction(ctx context.Context, userID, serverID, channelID, messageID string, body *ReactionBody) (*proto.BroadcastReactionRemoved, error) {
+	err := checkReaction(ctx, userID, channelID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := db.Query.RemoveReaction(ctx, queries.RemoveReactionParams{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     pgtype.Text{String: body.Emoji, Valid: body.Emoji != ""},
+		EmojiID:   pgtype.Text{String: body.EmojiID, Valid: body.EmojiID != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.RowsAffected() == 0 {
+		return nil, ErrReactionNotFound
+	}
+
+	return &proto.BroadcastReactionRemoved{
+		MessageId: messageID,
+		ServerId:  serverID,
+		ChannelId: channelID,
+		UserId:    userID,
+		Emoji:     body.Emoji,
+		EmojiId:   body.EmojiID,
+	}, nil
+}
+
+func attachReactions(ctx context.Context, messages []MessageResponse) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	messageIDs := make([]string, 0, len(messages))
+	for _, message := range messages {
+		messageIDs = append(messageIDs, message.ID)
+	}
+
+	rows, err := db.Query.GetReactionsFromMessages(ctx, messageIDs)
+	if err != nil {
+		return err
+	}
+
+	reactionsByMessage := make(map[string][]ReactionResponse)
+	for _, row := range rows {
+		reactionsByMessage[row.MessageID] = append(reactionsByMessage[row.MessageID], ReactionResponse{
+			Emoji:    row.Emoji.String,
+			EmojiID:  row.EmojiID.String,
+			EmojiURL: row.EmojiUrl.String,
+			Count:    int(row.Count),
+			Users:    row.Users,
+		})
+	}
+
+	for i := range messages {
+		if reactions, ok := reactionsByMessage[messages[i].ID]; ok {
+			messages[i].Reactions = reactions
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	proto "github.com/okzmo/kyob/types"
+)
+
+var ErrInvalidAck = errors.New("acked message is not in this channel")
+
+type AckBody struct {
+	MessageID string `validate:"required" json:"message_id"`
+}
+
+// AckChannel moves the read marker of the user forward, acking an older
+// message than the current marker leaves it untouched.
+func AckChannel(ctx context.Context, userID, channelID, messageID string) (*proto.ReadState, error) {
+	err := CheckChannelAbility(ctx, channelID, userID, permissions.ViewChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := db.Query.GetMessage(ctx, messageID)
+	if err != nil || message.ChannelID != channelID || message.ThreadID.Valid {
+		return nil, ErrInvalidAck
+	}
+
+	_, err = db.Query.AckChannel(ctx, queries.AckChannelParams{
+		UserID:    userID,
+		ChannelID: channelID,
+		MessageID: pgtype.Text{String: messageID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return getReadState(ctx, userID, message.ServerID, channelID)
+}
+
+func getReadState(ctx context.Context, userID, serverID, channelID string) (*proto.ReadState, error) {
+	lastRead, err := db.Query.GetChannelReadState(ctx, queries.GetChannelReadStateParams{
+		UserID:    userID,
+		ChannelID: channelID,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	counts, err := db.Query.GetUnreadCounts(ctx, queries.GetUnreadCountsParams{
+		UserID:     userID,
+		ChannelIds: []string{channelID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := getMembersNotificationSettings(ctx, serverID, channelID, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	readState := &proto.ReadState{
+		ServerId:          serverID,
+		ChannelId:         channelID,
+		LastReadMessageId: lastRead.String,
+	}
+	if len(counts) > 0 {
+		readState.UnreadCount, readState.MentionCount = settings[userID].badges(counts[0], time.Now())
+	}
+
+	return readState, nil
+}
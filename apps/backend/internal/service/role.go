@@ -2,12 +2,15 @@ package services
 
 import (
 	"context"
+	"errors"
 
 	"github.com/okzmo/kyob/db"
 	queries "github.com/okzmo/kyob/db/gen_queries"
 	"github.com/okzmo/kyob/internal/utils"
 )
 
+var ErrRoleNotFound = errors.New("role not found")
+
 type BodyRoleCreation struct {
 	Name      string   `validate:"required,max=20" json:"name"`
 	Color     string   `validate:"required" json:"color"`
@@ -26,7 +29,12 @@ type BodyAddOrRemoveRole struct {
 	UserID string `json:"user_id"`
 }
 
-func CreateRole(ctx context.Context, serverID string, body *BodyRoleCreation) (*queries.Role, error) {
+func CreateRole(ctx context.Context, requesterID, serverID string, body *BodyRoleCreation) (*queries.Role, error) {
+	err := CheckRoleCreation(ctx, serverID, requesterID, int32(body.Index), body.Abilities)
+	if err != nil {
+		return nil, err
+	}
+
 	role, err := db.Query.CreateRole(ctx, queries.CreateRoleParams{
 		ID:        utils.Node.Generate().String(),
 		ServerID:  serverID,
@@ -39,6 +47,20 @@ func CreateRole(ctx context.Context, serverID string, body *BodyRoleCreation) (*
 		return nil, err
 	}
 
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditRoleCreate,
+		TargetType: AuditTargetRole,
+		TargetID:   role.ID,
+		Changes: AuditChanges{
+			"name":      {After: role.Name},
+			"color":     {After: role.Color},
+			"abilities": {After: role.Abilities},
+			"idx":       {After: role.Idx},
+		},
+	})
+
 	return &role, nil
 }
 
@@ -51,8 +73,13 @@ func GetRoles(ctx context.Context, serverID string) ([]queries.GetRolesRow, erro
 	return roles, nil
 }
 
-func AddRoleMember(ctx context.Context, serverID string, body *BodyAddOrRemoveRole) error {
-	err := db.Query.AddRoleMember(ctx, queries.AddRoleMemberParams{
+func AddRoleMember(ctx context.Context, requesterID, serverID string, body *BodyAddOrRemoveRole) error {
+	err := CheckRoleMemberManagement(ctx, serverID, requesterID, body.UserID, body.RoleID)
+	if err != nil {
+		return err
+	}
+
+	err = db.Query.AddRoleMember(ctx, queries.AddRoleMemberParams{
 		ArrayAppend: body.RoleID,
 		ServerID:    serverID,
 		UserID:      body.UserID,
@@ -61,11 +88,27 @@ func AddRoleMember(ctx context.Context, serverID string, body *BodyAddOrRemoveRo
 		return err
 	}
 
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditRoleMemberAdd,
+		TargetType: AuditTargetUser,
+		TargetID:   body.UserID,
+		Changes: AuditChanges{
+			"role": {After: body.RoleID},
+		},
+	})
+
 	return nil
 }
 
-func RemoveRoleMember(ctx context.Context, serverID string, body *BodyAddOrRemoveRole) error {
-	err := db.Query.RemoveRoleMember(ctx, queries.RemoveRoleMemberParams{
+func RemoveRoleMember(ctx context.Context, requesterID, serverID string, body *BodyAddOrRemoveRole) error {
+	err := CheckRoleMemberManagement(ctx, serverID, requesterID, body.UserID, body.RoleID)
+	if err != nil {
+		return err
+	}
+
+	err = db.Query.RemoveRoleMember(ctx, queries.RemoveRoleMemberParams{
 		ArrayRemove: body.RoleID,
 		ServerID:    serverID,
 		UserID:      body.UserID,
@@ -74,39 +117,102 @@ func RemoveRoleMember(ctx context.Context, serverID string, body *BodyAddOrRemov
 		return err
 	}
 
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditRoleMemberRemove,
+		TargetType: AuditTargetUser,
+		TargetID:   body.UserID,
+		Changes: AuditChanges{
+			"role": {Before: body.RoleID},
+		},
+	})
+
 	return nil
 }
 
-func MoveRole(ctx context.Context, body *BodyMoveRole) error {
-	err := db.Query.UpdateRolePositions(ctx, queries.UpdateRolePositionsParams{
-		Idx:   int32(body.To),
-		Idx_2: int32(body.From),
+func MoveRole(ctx context.Context, requesterID, serverID string, body *BodyMoveRole) error {
+	role, err := CheckRoleManagement(ctx, serverID, requesterID, body.RoleID)
+	if err != nil {
+		return err
+	}
+
+	abilities, err := GetMemberAbilities(ctx, serverID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if !abilities.Outranks(int32(body.To)) {
+		return ErrRoleTooHigh
+	}
+
+	err = db.Query.UpdateRolePositions(ctx, queries.UpdateRolePositionsParams{
+		ServerID: serverID,
+		Idx:      int32(body.To),
+		Idx_2:    role.Idx,
 	})
 	if err != nil {
 		return err
 	}
 
 	err = db.Query.MoveRole(ctx, queries.MoveRoleParams{
-		ID:  body.RoleID,
-		Idx: int32(body.To),
+		ID:       body.RoleID,
+		ServerID: serverID,
+		Idx:      int32(body.To),
 	})
 	if err != nil {
 		return err
 	}
 
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditRoleMove,
+		TargetType: AuditTargetRole,
+		TargetID:   body.RoleID,
+		Changes: AuditChanges{
+			"idx": {Before: role.Idx, After: body.To},
+		},
+	})
+
 	return nil
 }
 
-func DeleteRole(ctx context.Context, roleID string) error {
-	err := db.Query.RemoveRoleFromAllMembers(ctx, roleID)
+func DeleteRole(ctx context.Context, requesterID, serverID, roleID string) error {
+	role, err := CheckRoleManagement(ctx, serverID, requesterID, roleID)
+	if err != nil {
+		return err
+	}
+
+	err = db.Query.RemoveRoleFromAllMembers(ctx, queries.RemoveRoleFromAllMembersParams{
+		ArrayRemove: roleID,
+		ServerID:    serverID,
+	})
 	if err != nil {
 		return err
 	}
 
-	err = db.Query.DeleteRole(ctx, roleID)
+	err = db.Query.DeleteRole(ctx, queries.DeleteRoleParams{
+		ID:       roleID,
+		ServerID: serverID,
+	})
 	if err != nil {
 		return err
 	}
 
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditRoleDelete,
+		TargetType: AuditTargetRole,
+		TargetID:   roleID,
+		Changes: AuditChanges{
+			"name":      {Before: role.Name},
+			"color":     {Before: role.Color},
+			"abilities": {Before: role.Abilities},
+			"idx":       {Before: role.Idx},
+		},
+	})
+
 	return nil
 }
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+)
+
+var (
+	ErrEmptySearchQuery   = errors.New("search query is empty")
+	ErrInvalidSearchRange = errors.New("search range ends before it starts")
+)
+
+const (
+	DefaultSearchLimit = 25
+	MaxSearchLimit     = 50
+)
+
+type SearchMessagesParams struct {
+	Query         string
+	AuthorID      string
+	ServerID      string
+	ChannelID     string
+	Mentions      string
+	HasAttachment *bool
+	Since         time.Time
+	Until         time.Time
+	Cursor        string
+	Limit         int
+}
+
+type SearchResults struct {
+	Messages []MessageResponse `json:"messages"`
+	HasMore  bool              `json:"has_more"`
+}
+
+func SearchMessages(ctx context.Context, userID string, params *SearchMessagesParams) (*SearchResults, error) {
+	query := strings.TrimSpace(params.Query)
+	if query == "" {
+		return nil, ErrEmptySearchQuery
+	}
+
+	if !params.Since.IsZero() && !params.Until.IsZero() && params.Until.Before(params.Since) {
+		return nil, ErrInvalidSearchRange
+	}
+
+	channelIDs, err := getSearchableChannels(ctx, userID, params.ServerID, params.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &SearchResults{Messages: []MessageResponse{}}
+	if len(channelIDs) == 0 {
+		return results, nil
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	limit = min(limit, MaxSearchLimit)
+
+	var hasAttachment pgtype.Bool
+	if params.HasAttachment != nil {
+		hasAttachment = pgtype.Bool{Bool: *params.HasAttachment, Valid: true}
+	}
+
+	m, err := db.Query.SearchMessages(ctx, queries.SearchMessagesParams{
+		ChannelIds:    channelIDs,
+		Query:         query,
+		AuthorID:      pgtype.Text{String: params.AuthorID, Valid: params.AuthorID != ""},
+		Mentions:      pgtype.Text{String: params.Mentions, Valid: params.Mentions != ""},
+		HasAttachment: hasAttachment,
+		Since:         pgtype.Timestamptz{Time: params.Since, Valid: !params.Since.IsZero()},
+		Until:         pgtype.Timestamptz{Time: params.Until, Valid: !params.Until.IsZero()},
+		Cursor:        pgtype.Text{String: params.Cursor, Valid: params.Cursor != ""},
+		Limit:         int32(limit + 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results.HasMore = len(m) > limit
+	if results.HasMore {
+		m = m[:limit]
+	}
+	results.Messages = toMessageResponses(m)
+
+	err = attachReactions(ctx, results.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	err = attachReplies(ctx, results.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// getSearchableChannels returns the channels the user can read, across their
+// servers and direct messages, narrowed down to the given server and channel.
+func getSearchableChannels(ctx context.Context, userID, serverID, channelID string) ([]string, error) {
+	servers, err := db.Query.GetServersFromUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if serverID != "" {
+		servers = slices.DeleteFunc(servers, func(server queries.GetServersFromUserRow) bool {
+			return server.ID != serverID
+		})
+
+		if len(servers) == 0 {
+			return nil, ErrNotServerMember
+		}
+	}
+
+	serverIDs := make([]string, 0, len(servers))
+	for _, server := range servers {
+		serverIDs = append(serverIDs, server.ID)
+	}
+
+	allRoles, err := db.Query.GetRolesFromServers(ctx, serverIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	allChannels, err := db.Query.GetChannelsFromServers(ctx, serverIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	abilitiesByServer := resolveServersAbilities(userID, servers, allRoles)
+	allChannels, err = filterVisibleChannels(ctx, userID, abilitiesByServer, allChannels)
+	if err != nil {
+		return nil, err
+	}
+
+	channelIDs := make([]string, 0, len(allChannels))
+	for _, channel := range allChannels {
+		if channelID == "" || channel.ID == channelID {
+			channelIDs = append(channelIDs, channel.ID)
+		}
+	}
+
+	if channelID != "" && len(channelIDs) == 0 {
+		return nil, ErrUnauthorizedMessagesAccess
+	}
+
+	return channelIDs, nil
+}
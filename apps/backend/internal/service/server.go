@@ -9,7 +9,6 @@ import (
 	"log/slog"
 	"mime/multipart"
 	"os"
-	"regexp"
 	"strings"
 	"time"
 
@@ -18,7 +17,9 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/okzmo/kyob/db"
 	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
 	"github.com/okzmo/kyob/internal/utils"
+	proto "github.com/okzmo/kyob/types"
 )
 
 var (
@@ -75,10 +76,6 @@ type JoinServerResponse struct {
 	Server ServerWithChannels `json:"server"`
 }
 
-type ServerInviteResponse struct {
-	InviteLink string `json:"invite_link"`
-}
-
 func CreateServer(ctx context.Context, file []byte, fileHeader *multipart.FileHeader, server *CreateServerBody) (*ServerResponse, error) {
 	image, err := utils.CropImage(file, server.Crop.X, server.Crop.Y, server.Crop.Width, server.Crop.Height)
 	if err != nil {
@@ -148,34 +145,51 @@ func CreateServer(ctx context.Context, file []byte, fileHeader *multipart.FileHe
 
 func UpdateServerProfile(ctx context.Context, id string, body *UpdateServerProfileBody) error {
 	user := ctx.Value("user").(queries.User)
-	res, err := db.Query.OwnServer(ctx, queries.OwnServerParams{
-		ID:      id,
-		OwnerID: user.ID,
-	})
-	if err != nil || res.RowsAffected() == 0 {
+	err := CheckAbility(ctx, id, user.ID, permissions.ManageServer)
+	if err != nil {
 		return ErrUnauthorizedServerEdition
 	}
 
+	server, err := db.Query.GetServer(ctx, id)
+	if err != nil {
+		return ErrServerNotFound
+	}
+
+	changes := AuditChanges{}
+
 	if body.Name != "" {
 		err := db.Query.UpdateServerName(ctx, queries.UpdateServerNameParams{
-			ID:      id,
-			Name:    body.Name,
-			OwnerID: user.ID,
+			ID:   id,
+			Name: body.Name,
 		})
 		if err != nil {
 			return err
 		}
+
+		changes["name"] = AuditChange{Before: server.Name, After: body.Name}
 	}
 
 	if len(body.Description) > 0 {
 		err := db.Query.UpdateServerDescription(ctx, queries.UpdateServerDescriptionParams{
 			ID:          id,
 			Description: body.Description,
-			OwnerID:     user.ID,
 		})
 		if err != nil {
 			return err
 		}
+
+		changes["description"] = AuditChange{Before: server.Description, After: body.Description}
+	}
+
+	if len(changes) > 0 {
+		writeAuditLog(ctx, AuditEntry{
+			ServerID:   id,
+			ActorID:    user.ID,
+			Action:     AuditServerUpdate,
+			TargetType: AuditTargetServer,
+			TargetID:   id,
+			Changes:    changes,
+		})
 	}
 
 	return nil
@@ -185,16 +199,13 @@ func UpdateServerAvatar(ctx context.Context, serverID string, file []byte, fileH
 	user := ctx.Value("user").(queries.User)
 	s3Client := s3.NewFromConfig(GetAWSConfig())
 
-	res, err := db.Query.OwnServer(ctx, queries.OwnServerParams{
-		ID:      serverID,
-		OwnerID: user.ID,
-	})
-	if err != nil || res.RowsAffected() == 0 {
+	err := CheckAbility(ctx, serverID, user.ID, permissions.ManageServer)
+	if err != nil {
 		return nil, ErrUnauthorizedServerEdition
 	}
 
 	server, err := db.Query.GetServer(ctx, serverID)
-	if err != nil || res.RowsAffected() == 0 {
+	if err != nil {
 		return nil, err
 	}
 
@@ -267,7 +278,6 @@ func UpdateServerAvatar(ctx context.Context, serverID string, file []byte, fileH
 	mainColor := pgtype.Text{String: body.MainColor, Valid: true}
 	err = db.Query.UpdateServerAvatarNBanner(ctx, queries.UpdateServerAvatarNBannerParams{
 		ID:        serverID,
-		OwnerID:   user.ID,
 		Avatar:    avatarURL,
 		Banner:    bannerURL,
 		MainColor: mainColor,
@@ -295,42 +305,71 @@ func DeleteServer(ctx context.Context, id string, userID string) error {
 	return nil
 }
 
-func CreateServerInvite(ctx context.Context, serverID string) (*string, error) {
-	inviteID := utils.GenerateRandomId(10)
+// JoinServer adds the user to the server behind the invite, unless the server
+// is private, in which case a join request is left for its managers.
+func JoinServer(ctx context.Context, body JoinServerBody) (*ServerWithChannels, *proto.JoinRequest, error) {
+	user := ctx.Value("user").(queries.User)
 
-	res, err := db.Query.CreateInvite(ctx, queries.CreateInviteParams{
-		ID:       utils.Node.Generate().String(),
-		ServerID: serverID,
-		InviteID: inviteID,
-		ExpireAt: time.Now().Add(time.Minute * 15),
-	})
+	code, err := inviteCodeFromURL(body.InviteURL)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &res, nil
-}
+	serverID, err := db.Query.CheckInvite(ctx, code)
+	if err != nil {
+		return nil, nil, ErrServerNotFound
+	}
 
-func JoinServer(ctx context.Context, body JoinServerBody) (*ServerWithChannels, error) {
-	user := ctx.Value("user").(queries.User)
+	err = checkBan(ctx, serverID, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	pattern := regexp.MustCompile(`^(?:https:\/\/kyob\.app\/invite\/|)([a-zA-Z0-9]{10})$`)
-	matches := pattern.FindStringSubmatch(body.InviteURL)
-	if matches == nil {
-		return nil, ErrNoIDInInvite
+	server, err := db.Query.GetServer(ctx, serverID)
+	if err != nil {
+		return nil, nil, ErrServerNotFound
+	}
+
+	if server.Private && server.OwnerID != user.ID {
+		request, err := createJoinRequest(ctx, user, serverID, code, body.X, body.Y)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return nil, request, nil
 	}
 
-	serverID, err := db.Query.CheckInvite(ctx, matches[1])
+	s, err := addServerMember(ctx, user.ID, serverID, code, body.X, body.Y)
 	if err != nil {
-		return nil, ErrServerNotFound
+		if errors.Is(err, ErrInviteNotFound) {
+			return nil, nil, ErrServerNotFound
+		}
+		return nil, nil, err
 	}
 
-	err = db.Query.JoinServer(ctx, queries.JoinServerParams{
-		ID:       utils.Node.Generate().String(),
-		UserID:   user.ID,
-		ServerID: serverID,
-		X:        int32(body.X),
-		Y:        int32(body.Y),
+	return s, nil, nil
+}
+
+// addServerMember claims a use of the invite and inserts the membership
+// together, so an invite out of uses or expired in the meantime admits no one.
+func addServerMember(ctx context.Context, userID, serverID, inviteCode string, x, y int) (*ServerWithChannels, error) {
+	err := db.Tx(ctx, func(q *queries.Queries) error {
+		res, err := q.UseInvite(ctx, inviteCode)
+		if err != nil {
+			return err
+		}
+
+		if res.RowsAffected() == 0 {
+			return ErrInviteNotFound
+		}
+
+		return q.JoinServer(ctx, queries.JoinServerParams{
+			ID:       utils.Node.Generate().String(),
+			UserID:   userID,
+			ServerID: serverID,
+			X:        int32(x),
+			Y:        int32(y),
+		})
 	})
 	if err != nil {
 		return nil, err
@@ -342,6 +381,16 @@ func JoinServer(ctx context.Context, body JoinServerBody) (*ServerWithChannels,
 		return nil, err
 	}
 
+	abilities, err := GetMemberAbilities(ctx, serverID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err = filterVisibleChannels(ctx, userID, map[string]*permissions.Abilities{serverID: abilities}, channels)
+	if err != nil {
+		return nil, err
+	}
+
 	allRoles, err := db.Query.GetRolesFromServers(ctx, []string{serverID})
 	if err != nil {
 		return nil, err
@@ -358,6 +407,8 @@ func JoinServer(ctx context.Context, body JoinServerBody) (*ServerWithChannels,
 			"",
 			"",
 			json.RawMessage(`[]`),
+			0,
+			0,
 			[]VoiceUser{},
 		}
 
@@ -366,7 +417,7 @@ func JoinServer(ctx context.Context, body JoinServerBody) (*ServerWithChannels,
 
 	server, err := db.Query.GetServerWithChannels(ctx, queries.GetServerWithChannelsParams{
 		ServerID: serverID,
-		UserID:   user.ID,
+		UserID:   userID,
 	})
 	if err != nil {
 		return nil, err
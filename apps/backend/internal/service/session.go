@@ -0,0 +1,239 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrInvalidSession  = errors.New("invalid session")
+)
+
+const (
+	RememberMeToken = "REMEMBER_ME_TOKEN"
+	SessionDuration = 30 * 24 * time.Hour
+	// sessionRenewWindow is how close to its expiry a session gets a new
+	// token when it is used.
+	sessionRenewWindow = 7 * 24 * time.Hour
+	// sessionTouchInterval keeps a busy session from writing its last use on
+	// every request.
+	sessionTouchInterval = time.Minute
+	// sessionRotationGrace keeps a rotated token valid for the requests sent
+	// with it before the new one reached the client.
+	sessionRotationGrace = time.Minute
+	TokenPurgeInterval   = time.Hour
+	maxDeviceNameLength  = 255
+)
+
+// SessionInfo describes where a session was opened from.
+type SessionInfo struct {
+	DeviceName string
+	IP         string
+}
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	DeviceName string    `json:"device_name"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+func generateToken() (string, error) {
+	token, err := utils.GenerateRandomBytes(64)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawStdEncoding.EncodeToString(token), nil
+}
+
+// truncateDeviceName keeps the name within the column, which counts
+// characters, without splitting one. It comes from a header, invalid UTF-8
+// is dropped.
+func truncateDeviceName(name string) string {
+	name = strings.ToValidUTF8(name, "")
+	if utf8.RuneCountInString(name) <= maxDeviceNameLength {
+		return name
+	}
+
+	return string([]rune(name)[:maxDeviceNameLength])
+}
+
+func createSession(ctx context.Context, userID string, info SessionInfo) (*string, error) {
+	token, err := generateToken()
+	if err != nil {
+		slog.Error("failed generate token", "err", err)
+		return nil, err
+	}
+
+	info.DeviceName = truncateDeviceName(info.DeviceName)
+
+	_, err = db.Query.CreateToken(ctx, queries.CreateTokenParams{
+		ID:         utils.Node.Generate().String(),
+		UserID:     userID,
+		Token:      token,
+		ExpireAt:   time.Now().Add(SessionDuration),
+		Type:       RememberMeToken,
+		DeviceName: info.DeviceName,
+		Ip:         info.IP,
+	})
+	if err != nil {
+		slog.Error("failed create token", "err", err)
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Authenticate returns the session behind the token along with its user, and
+// records that it was used.
+func Authenticate(ctx context.Context, token string) (*queries.GetSessionRow, error) {
+	session, err := db.Query.GetSession(ctx, queries.GetSessionParams{
+		Token: token,
+		Grace: int32(sessionRotationGrace.Seconds()),
+	})
+	if err != nil {
+		return nil, ErrInvalidSession
+	}
+
+	if time.Since(session.Token.LastUsedAt) > sessionTouchInterval {
+		err = db.Query.TouchSession(ctx, session.Token.ID)
+		if err != nil {
+			slog.Error("failed to touch session", "err", err)
+		}
+	}
+
+	return &session, nil
+}
+
+// RenewSession rotates the token of a session about to expire, an empty token
+// is returned when it is not due or a concurrent request already rotated it.
+// The previous token keeps working for sessionRotationGrace so that requests
+// already in flight with it are not signed out.
+func RenewSession(ctx context.Context, session *queries.Token) (string, time.Time, error) {
+	if time.Until(session.ExpireAt) > sessionRenewWindow {
+		return "", time.Time{}, nil
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expireAt := time.Now().Add(SessionDuration)
+	rows, err := db.Query.RotateToken(ctx, queries.RotateTokenParams{
+		NewToken: token,
+		ExpireAt: expireAt,
+		ID:       session.ID,
+		Token:    session.Token,
+	})
+	if err != nil || rows == 0 {
+		return "", time.Time{}, err
+	}
+
+	return token, expireAt, nil
+}
+
+func GetSessions(ctx context.Context, userID, currentID string) ([]SessionResponse, error) {
+	rows, err := db.Query.GetSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]SessionResponse, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, SessionResponse{
+			ID:         row.ID,
+			DeviceName: row.DeviceName,
+			IP:         row.Ip,
+			CreatedAt:  row.CreatedAt,
+			LastUsedAt: row.LastUsedAt,
+			ExpiresAt:  row.ExpireAt,
+			Current:    row.ID == currentID,
+		})
+	}
+
+	return sessions, nil
+}
+
+func RevokeSession(ctx context.Context, userID, sessionID string) error {
+	rows, err := db.Query.DeleteSession(ctx, queries.DeleteSessionParams{
+		ID:     sessionID,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// RevokeOtherSessions signs the user out everywhere but the current session
+// and returns the sessions revoked.
+func RevokeOtherSessions(ctx context.Context, userID, currentID string) ([]string, error) {
+	return db.Query.DeleteOtherSessions(ctx, queries.DeleteOtherSessionsParams{
+		UserID: userID,
+		ID:     currentID,
+	})
+}
+
+// CheckSessions tells, for each of the given sessions, why it cannot be used
+// anymore. Sessions still valid are left out.
+func CheckSessions(ctx context.Context, sessionIDs []string) (map[string]error, error) {
+	rows, err := db.Query.GetSessionsExpiry(ctx, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := make(map[string]time.Time, len(rows))
+	for _, row := range rows {
+		expiry[row.ID] = row.ExpireAt
+	}
+
+	invalid := make(map[string]error)
+	now := time.Now()
+	for _, sessionID := range sessionIDs {
+		expireAt, ok := expiry[sessionID]
+		switch {
+		case !ok:
+			invalid[sessionID] = ErrSessionRevoked
+		case !expireAt.After(now):
+			invalid[sessionID] = ErrSessionExpired
+		}
+	}
+
+	return invalid, nil
+}
+
+// PurgeExpiredTokens deletes the expired tokens every TokenPurgeInterval, it
+// never returns.
+func PurgeExpiredTokens() {
+	for range time.Tick(TokenPurgeInterval) {
+		deleted, err := db.Query.DeleteExpiredTokens(context.TODO())
+		if err != nil {
+			slog.Error("failed to purge expired tokens", "err", err)
+			continue
+		}
+
+		if deleted > 0 {
+			slog.Info("purged expired tokens", "count", deleted)
+		}
+	}
+}
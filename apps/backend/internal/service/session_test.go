@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestTruncateDeviceName(t *testing.T) {
+	name := truncateDeviceName(strings.Repeat("é", 300))
+	if !utf8.ValidString(name) || utf8.RuneCountInString(name) != maxDeviceNameLength {
+		t.Fatalf("expected %d valid characters, got %q", maxDeviceNameLength, name)
+	}
+
+	if name := truncateDeviceName("Firefox on Linux"); name != "Firefox on Linux" {
+		t.Fatalf("a short name should be kept, got %q", name)
+	}
+
+	if name := truncateDeviceName("Firefox\xff on Linux"); name != "Firefox on Linux" {
+		t.Fatalf("invalid UTF-8 should be dropped, got %q", name)
+	}
+}
+
+func TestRenewSessionKeepsPreviousToken(t *testing.T) {
+	setupTestDB(t)
+	ctx := context.Background()
+	user := createTestUser(t)
+
+	token, err := createSession(ctx, user.ID, SessionInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session, err := Authenticate(ctx, *token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// pretend the session is about to expire so that it is renewed
+	session.Token.ExpireAt = time.Now().Add(time.Hour)
+	renewed, _, err := RenewSession(ctx, &session.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renewed == "" {
+		t.Fatal("the session should have been renewed")
+	}
+
+	// a concurrent request still carrying the old cookie
+	renewedAgain, _, err := RenewSession(ctx, &session.Token)
+	if err != nil || renewedAgain != "" {
+		t.Fatalf("the session should only be rotated once, got %q, %v", renewedAgain, err)
+	}
+
+	for _, tok := range []string{*token, renewed} {
+		s, err := Authenticate(ctx, tok)
+		if err != nil {
+			t.Fatalf("the token should still be accepted: %v", err)
+		}
+		if s.Token.ID != session.Token.ID {
+			t.Fatalf("expected session %s, got %s", session.Token.ID, s.Token.ID)
+		}
+	}
+}
@@ -44,23 +44,34 @@ type ChannelsWithMembers struct {
 	LastMessageSent string          `json:"last_message_sent"`
 	LastMessageRead string          `json:"last_message_read"`
 	MentionsIds     json.RawMessage `json:"last_mentions"`
+	UnreadCount     int32           `json:"unread_count"`
+	MentionCount    int32           `json:"mention_count"`
 	VoiceUsers      []VoiceUser     `json:"voice_users"`
 }
 
 type UserResponse struct {
-	ID          string          `json:"id"`
-	Email       string          `json:"email"`
-	Username    string          `json:"username"`
-	DisplayName string          `json:"display_name"`
-	Avatar      pgtype.Text     `json:"avatar"`
-	Banner      pgtype.Text     `json:"banner"`
-	Body        pgtype.Text     `json:"rpm_avatar_id"`
-	RPMToken    pgtype.Text     `json:"rpm_token"`
-	MainColor   pgtype.Text     `json:"main_color"`
-	About       json.RawMessage `json:"about"`
-	Links       json.RawMessage `json:"links"`
-	Facts       json.RawMessage `json:"facts"`
-	CreatedAt   time.Time       `json:"created_at"`
+	ID          string           `json:"id"`
+	Email       string           `json:"email"`
+	Username    string           `json:"username"`
+	DisplayName string           `json:"display_name"`
+	Avatar      pgtype.Text      `json:"avatar"`
+	Banner      pgtype.Text      `json:"banner"`
+	Body        pgtype.Text      `json:"rpm_avatar_id"`
+	RPMToken    pgtype.Text      `json:"rpm_token"`
+	MainColor   pgtype.Text      `json:"main_color"`
+	About       json.RawMessage  `json:"about"`
+	Links       json.RawMessage  `json:"links"`
+	Facts       json.RawMessage  `json:"facts"`
+	Presence    PresenceResponse `json:"presence"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+// CurrentUserResponse adds to the user what only they should see about
+// their account.
+type CurrentUserResponse struct {
+	UserResponse
+	EmailVerified bool `json:"email_verified"`
+	TwoFactor     bool `json:"two_factor"`
 }
 
 type FriendResponse struct {
@@ -76,10 +87,11 @@ type FriendResponse struct {
 }
 
 type SetupResponse struct {
-	User    UserResponse                  `json:"user"`
-	Emojis  []queries.GetEmojisRow        `json:"emojis"`
-	Friends []FriendResponse              `json:"friends"`
-	Servers map[string]ServerWithChannels `json:"servers"`
+	User                 CurrentUserResponse            `json:"user"`
+	Emojis               []queries.GetEmojisRow         `json:"emojis"`
+	Friends              []FriendResponse               `json:"friends"`
+	Servers              map[string]ServerWithChannels  `json:"servers"`
+	NotificationSettings []NotificationSettingsResponse `json:"notification_settings"`
 }
 
 func GetSetup(ctx context.Context) (*SetupResponse, error) {
@@ -102,20 +114,32 @@ func GetSetup(ctx context.Context) (*SetupResponse, error) {
 		return nil, err
 	}
 
-	res.User = UserResponse{
-		ID:          ctxUser.ID,
-		Email:       ctxUser.Email,
-		Username:    ctxUser.Username,
-		DisplayName: ctxUser.DisplayName,
-		Avatar:      ctxUser.Avatar,
-		Banner:      ctxUser.Banner,
-		Body:        ctxUser.Body,
-		RPMToken:    ctxUser.RpmToken,
-		MainColor:   ctxUser.MainColor,
-		About:       ctxUser.About,
-		CreatedAt:   ctxUser.CreatedAt,
-		Links:       ctxUser.Links,
-		Facts:       ctxUser.Facts,
+	notificationSettings, err := db.Query.GetNotificationSettings(ctx, ctxUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res.User = CurrentUserResponse{
+		UserResponse: UserResponse{
+			ID:          ctxUser.ID,
+			Email:       ctxUser.Email,
+			Username:    ctxUser.Username,
+			DisplayName: ctxUser.DisplayName,
+			Avatar:      ctxUser.Avatar,
+			Banner:      ctxUser.Banner,
+			Body:        ctxUser.Body,
+			RPMToken:    ctxUser.RpmToken,
+			MainColor:   ctxUser.MainColor,
+			About:       ctxUser.About,
+			CreatedAt:   ctxUser.CreatedAt,
+			Links:       ctxUser.Links,
+			Facts:       ctxUser.Facts,
+			Presence: NewPresenceResponse(
+				newPresence(ctxUser.ID, ctxUser.Status, ctxUser.CustomStatusText, ctxUser.CustomStatusEmoji, ctxUser.CustomStatusExpiresAt),
+			),
+		},
+		EmailVerified: ctxUser.EmailVerified,
+		TwoFactor:     ctxUser.TotpEnabled,
 	}
 
 	for _, f := range friends {
@@ -134,9 +158,14 @@ func GetSetup(ctx context.Context) (*SetupResponse, error) {
 
 	res.Emojis = emojis
 
+	res.NotificationSettings = make([]NotificationSettingsResponse, 0, len(notificationSettings))
+	for _, setting := range notificationSettings {
+		res.NotificationSettings = append(res.NotificationSettings, newNotificationSettingsResponse(setting))
+	}
+
 	res.Servers = make(map[string]ServerWithChannels)
 	if len(servers) > 0 {
-		serversMap, err := processServers(ctx, ctxUser.ID, servers)
+		serversMap, err := processServers(ctx, ctxUser.ID, servers, newSettingsByTarget(notificationSettings))
 		if err != nil {
 			return nil, err
 		}
@@ -147,7 +176,7 @@ func GetSetup(ctx context.Context) (*SetupResponse, error) {
 	return &res, nil
 }
 
-func processServers(ctx context.Context, userID string, servers []queries.GetServersFromUserRow) (map[string]ServerWithChannels, error) {
+func processServers(ctx context.Context, userID string, servers []queries.GetServersFromUserRow, settings settingsByTarget) (map[string]ServerWithChannels, error) {
 	serverIDs := make([]string, 0, len(servers))
 	for _, server := range servers {
 		serverIDs = append(serverIDs, server.ID)
@@ -163,6 +192,13 @@ func processServers(ctx context.Context, userID string, servers []queries.GetSer
 		return nil, err
 	}
 
+	abilitiesByServer := resolveServersAbilities(userID, servers, allRoles)
+
+	allChannels, err = filterVisibleChannels(ctx, userID, abilitiesByServer, allChannels)
+	if err != nil {
+		return nil, err
+	}
+
 	channelIDs := make([]string, 0, len(allChannels))
 	for _, channel := range allChannels {
 		channelIDs = append(channelIDs, channel.ID)
@@ -188,6 +224,18 @@ func processServers(ctx context.Context, userID string, servers []queries.GetSer
 		allMessagesMentionsSet[mess.ChannelID] = mess.UnreadMentionIds
 	}
 
+	unreadCounts, err := db.Query.GetUnreadCounts(ctx, queries.GetUnreadCountsParams{
+		UserID:     userID,
+		ChannelIds: channelIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	unreadCountsSet := make(map[string]queries.GetUnreadCountsRow)
+	for _, count := range unreadCounts {
+		unreadCountsSet[count.ChannelID] = count
+	}
+
 	allMembers, err := db.Query.GetMembersFromServers(ctx, serverIDs)
 	if err != nil {
 		return nil, err
@@ -234,16 +282,21 @@ func processServers(ctx context.Context, userID string, servers []queries.GetSer
 		rolesByServer[role.ServerID] = append(rolesByServer[role.ServerID], role)
 	}
 
+	now := time.Now()
 	result := make(map[string]ServerWithChannels)
 	for _, server := range servers {
 		channelMap := make(map[string]ChannelsWithMembers)
 
 		for _, channel := range channelsByServer[server.ID] {
+			unreadCount, mentionCount := settings.forChannel(server.ID, channel.ID).badges(unreadCountsSet[channel.ID], now)
+
 			channelMap[channel.ID] = ChannelsWithMembers{
 				channel,
 				allMessagesSentSet[channel.ID],
 				allMessagesReadSet[channel.ID],
 				allMessagesMentionsSet[channel.ID],
+				unreadCount,
+				mentionCount,
 				[]VoiceUser{},
 			}
 		}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	proto "github.com/okzmo/kyob/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrThreadNotFound             = errors.New("thread not found")
+	ErrThreadAlreadyExists        = errors.New("message already has a thread")
+	ErrUnauthorizedThreadCreation = errors.New("cannot start a thread on this message")
+)
+
+type ThreadBody struct {
+	Name string `validate:"required,max=100" json:"name"`
+}
+
+func CreateThread(ctx context.Context, userID, serverID, channelID, messageID string, body *ThreadBody) (*proto.BroadcastThreadCreation, error) {
+	err := CheckChannelAbility(ctx, channelID, userID, permissions.SendMessages)
+	if err != nil {
+		return nil, ErrUnauthorizedThreadCreation
+	}
+
+	message, err := db.Query.GetMessage(ctx, messageID)
+	if err != nil || message.ChannelID != channelID || message.ThreadID.Valid {
+		return nil, ErrUnauthorizedThreadCreation
+	}
+
+	_, err = db.Query.GetThread(ctx, messageID)
+	if err == nil {
+		return nil, ErrThreadAlreadyExists
+	}
+
+	thread, err := db.Query.CreateThread(ctx, queries.CreateThreadParams{
+		ID:        messageID,
+		ServerID:  serverID,
+		ChannelID: channelID,
+		CreatorID: userID,
+		Name:      body.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.BroadcastThreadCreation{
+		Id:        thread.ID,
+		ServerId:  thread.ServerID,
+		ChannelId: thread.ChannelID,
+		CreatorId: thread.CreatorID,
+		Name:      thread.Name,
+		CreatedAt: timestamppb.New(thread.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"encoding/base32"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+var (
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication already enabled")
+	ErrTwoFactorNotEnrolled    = errors.New("two-factor authentication not enrolled")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrInvalidChallenge        = errors.New("invalid or expired challenge")
+	ErrTooManyTwoFactorCodes   = errors.New("too many two-factor codes tried")
+)
+
+const (
+	TwoFactorChallengeToken    = "TWO_FACTOR_CHALLENGE"
+	TwoFactorChallengeDuration = 5 * time.Minute
+	// twoFactorMaxAttempts is how many codes can be tried against a challenge
+	// before it has to be asked again with the password.
+	twoFactorMaxAttempts = 5
+	// twoFactorUserMaxAttempts is how many codes a user can try within
+	// twoFactorAttemptsWindow, whatever the number of challenges asked.
+	twoFactorUserMaxAttempts = 10
+	twoFactorAttemptsWindow  = 15 * time.Minute
+	recoveryCodesCount       = 10
+	totpIssuer               = "Kyob"
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type EnrollTwoFactorResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type ConfirmTwoFactorBody struct {
+	Code string `validate:"required,len=6,numeric" json:"code"`
+}
+
+type ConfirmTwoFactorResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TwoFactorChallengeResponse struct {
+	Challenge string    `json:"challenge"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type RedeemChallengeBody struct {
+	Challenge  string `validate:"required" json:"challenge"`
+	Code       string `validate:"required,max=32" json:"code"`
+	DeviceName string `validate:"max=255" json:"device_name"`
+}
+
+// EnrollTwoFactor gives the user a new TOTP secret, it only protects their
+// account once a code from it is confirmed.
+func EnrollTwoFactor(ctx context.Context, user queries.User) (*EnrollTwoFactorResponse, error) {
+	if user.TotpEnabled {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query.SetUserTOTPSecret(ctx, queries.SetUserTOTPSecretParams{
+		ID:         user.ID,
+		TotpSecret: pgtype.Text{String: secret, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == 0 {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	return &EnrollTwoFactorResponse{
+		Secret: secret,
+		URI:    utils.TOTPURI(totpIssuer, user.Username, secret),
+	}, nil
+}
+
+// ConfirmTwoFactor turns on two-factor authentication and returns the
+// recovery codes, they are never shown again.
+func ConfirmTwoFactor(ctx context.Context, user queries.User, code string) ([]string, error) {
+	if user.TotpEnabled {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	if !user.TotpSecret.Valid {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+
+	step, ok := utils.ValidateTOTP(user.TotpSecret.String, code, time.Now())
+	if !ok {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	codes, hashedCodes, err := generateRecoveryCodes(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Tx(ctx, func(q *queries.Queries) error {
+		rows, err := q.EnableUserTOTP(ctx, queries.EnableUserTOTPParams{
+			ID:           user.ID,
+			TotpLastStep: step,
+		})
+		if err != nil {
+			return err
+		}
+
+		if rows == 0 {
+			return ErrTwoFactorAlreadyEnabled
+		}
+
+		err = q.DeleteRecoveryCodes(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.CreateRecoveryCodes(ctx, hashedCodes)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func generateRecoveryCodes(userID string) ([]string, []queries.CreateRecoveryCodesParams, error) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashedCodes := make([]queries.CreateRecoveryCodesParams, 0, recoveryCodesCount)
+
+	for range recoveryCodesCount {
+		b, err := utils.GenerateRandomBytes(10)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+		hash, err := utils.HashPassword(code)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes = append(codes, code[:8]+"-"+code[8:])
+		hashedCodes = append(hashedCodes, queries.CreateRecoveryCodesParams{
+			ID:       utils.Node.Generate().String(),
+			UserID:   userID,
+			CodeHash: hash,
+		})
+	}
+
+	return codes, hashedCodes, nil
+}
+
+// useRecoveryCode burns the recovery code of the user matching the given one.
+func useRecoveryCode(ctx context.Context, userID, code string) bool {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+
+	recoveryCodes, err := db.Query.GetRecoveryCodes(ctx, userID)
+	if err != nil {
+		return false
+	}
+
+	for _, recoveryCode := range recoveryCodes {
+		match, err := utils.VerifyPassword(code, recoveryCode.CodeHash)
+		if err != nil || !match {
+			continue
+		}
+
+		rows, err := db.Query.UseRecoveryCode(ctx, recoveryCode.ID)
+		return err == nil && rows > 0
+	}
+
+	return false
+}
+
+// useTOTP accepts a code once, a code seen before for the user is refused.
+func useTOTP(ctx context.Context, user *queries.User, code string) bool {
+	step, ok := utils.ValidateTOTP(user.TotpSecret.String, code, time.Now())
+	if !ok {
+		return false
+	}
+
+	rows, err := db.Query.UseUserTOTPStep(ctx, queries.UseUserTOTPStepParams{
+		ID:           user.ID,
+		TotpLastStep: step,
+	})
+	return err == nil && rows > 0
+}
+
+func createChallenge(ctx context.Context, userID string) (*TwoFactorChallengeResponse, error) {
+	challenge, err := issueToken(ctx, userID, TwoFactorChallengeToken, TwoFactorChallengeDuration)
+	if err != nil {
+		slog.Error("failed to create two-factor challenge", "err", err)
+		return nil, err
+	}
+
+	return &TwoFactorChallengeResponse{
+		Challenge: challenge,
+		ExpiresAt: time.Now().Add(TwoFactorChallengeDuration),
+	}, nil
+}
+
+// RedeemTwoFactorChallenge opens the session a sign in was held back for,
+// given a code from the authenticator app or one of the recovery codes. The
+// codes tried are counted on the challenge and on the user, so that signing
+// in again doesn't give more tries.
+func RedeemTwoFactorChallenge(ctx context.Context, body *RedeemChallengeBody, info SessionInfo) (*string, error) {
+	userID, err := db.Query.AttemptToken(ctx, queries.AttemptTokenParams{
+		Token:       body.Challenge,
+		Type:        TwoFactorChallengeToken,
+		MaxAttempts: twoFactorMaxAttempts,
+	})
+	if err != nil {
+		return nil, ErrInvalidChallenge
+	}
+
+	user, err := db.Query.GetUserById(ctx, userID)
+	if err != nil || !user.TotpEnabled {
+		return nil, ErrInvalidChallenge
+	}
+
+	rows, err := db.Query.AttemptUserTOTP(ctx, queries.AttemptUserTOTPParams{
+		ID:            user.ID,
+		WindowSeconds: int32(twoFactorAttemptsWindow.Seconds()),
+		MaxAttempts:   twoFactorUserMaxAttempts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == 0 {
+		return nil, ErrTooManyTwoFactorCodes
+	}
+
+	var ok bool
+	if len(body.Code) == 6 {
+		ok = useTOTP(ctx, &user, body.Code)
+	} else {
+		ok = useRecoveryCode(ctx, user.ID, body.Code)
+	}
+
+	if !ok {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	_, err = db.Query.ConsumeToken(ctx, queries.ConsumeTokenParams{
+		Token: body.Challenge,
+		Type:  TwoFactorChallengeToken,
+	})
+	if err != nil {
+		return nil, ErrInvalidChallenge
+	}
+
+	err = db.Query.ResetUserTOTPAttempts(ctx, user.ID)
+	if err != nil {
+		slog.Error("failed to reset two-factor attempts", "err", err)
+	}
+
+	return createSession(ctx, user.ID, info)
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+func TestRedeemTwoFactorChallengeCountsAttemptsPerUser(t *testing.T) {
+	setupTestDB(t)
+	ctx := context.Background()
+	user := createTestUser(t)
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Query.SetUserTOTPSecret(ctx, queries.SetUserTOTPSecretParams{
+		ID:         user.ID,
+		TotpSecret: pgtype.Text{String: secret, Valid: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Query.EnableUserTOTP(ctx, queries.EnableUserTOTPParams{ID: user.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// signing in again gives a new challenge but no more tries
+	var lastErr error
+	for range twoFactorUserMaxAttempts/twoFactorMaxAttempts + 1 {
+		challenge, err := createChallenge(ctx, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for range twoFactorMaxAttempts {
+			_, lastErr = RedeemTwoFactorChallenge(ctx, &RedeemChallengeBody{
+				Challenge: challenge.Challenge,
+				Code:      "not-a-recovery-code",
+			}, SessionInfo{})
+		}
+	}
+
+	if !errors.Is(lastErr, ErrTooManyTwoFactorCodes) {
+		t.Fatalf("expected ErrTooManyTwoFactorCodes, got %v", lastErr)
+	}
+}
@@ -151,6 +151,12 @@ func UpdateAccount(ctx context.Context, body *UpdateAccountBody) error {
 		if err != nil {
 			return err
 		}
+
+		// the new address has to be verified again
+		err = sendEmailVerification(ctx, user.ID, body.Email)
+		if err != nil {
+			slog.Error("failed to send email verification", "err", err)
+		}
 	}
 
 	return nil
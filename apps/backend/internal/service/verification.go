@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/okzmo/kyob/db"
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/mailer"
+	"github.com/okzmo/kyob/internal/utils"
+)
+
+var (
+	ErrInvalidToken         = errors.New("invalid or expired token")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+	ErrTokenRequestTooSoon  = errors.New("token requested too soon")
+)
+
+const (
+	EmailVerificationToken    = "EMAIL_VERIFICATION"
+	PasswordResetToken        = "PASSWORD_RESET"
+	EmailVerificationDuration = 24 * time.Hour
+	PasswordResetDuration     = time.Hour
+	// tokenRequestCooldown keeps a user from flooding an inbox with mails.
+	tokenRequestCooldown = time.Minute
+)
+
+type ConfirmTokenBody struct {
+	Token string `validate:"required" json:"token"`
+}
+
+type RequestPasswordResetBody struct {
+	Email string `validate:"required,email" json:"email"`
+}
+
+type ResetPasswordBody struct {
+	Token    string `validate:"required" json:"token"`
+	Password string `validate:"required,min=8,max=254" json:"password"`
+}
+
+// issueToken creates a single-use token of the given type, the ones the user
+// was given before stop working.
+func issueToken(ctx context.Context, userID, tokenType string, duration time.Duration) (string, error) {
+	_, err := db.Query.DeleteUserTokens(ctx, queries.DeleteUserTokensParams{
+		UserID: userID,
+		Type:   tokenType,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = db.Query.CreateToken(ctx, queries.CreateTokenParams{
+		ID:       utils.Node.Generate().String(),
+		UserID:   userID,
+		Token:    token,
+		ExpireAt: time.Now().Add(duration),
+		Type:     tokenType,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func tokenRequestedRecently(ctx context.Context, userID, tokenType string) bool {
+	createdAt, err := db.Query.GetLastTokenCreation(ctx, queries.GetLastTokenCreationParams{
+		UserID: userID,
+		Type:   tokenType,
+	})
+	if err != nil {
+		return false
+	}
+
+	return time.Since(createdAt) < tokenRequestCooldown
+}
+
+func tokenLink(path, token string) string {
+	return fmt.Sprintf("%s/%s?token=%s", os.Getenv("APP_URL"), path, url.QueryEscape(token))
+}
+
+func sendEmailVerification(ctx context.Context, userID, email string) error {
+	token, err := issueToken(ctx, userID, EmailVerificationToken, EmailVerificationDuration)
+	if err != nil {
+		return err
+	}
+
+	return mailer.Send(ctx, mailer.Mail{
+		To:      email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf(
+			"Open this link to verify your email address:\n\n%s\n\nIt expires in 24 hours.",
+			tokenLink("verify_email", token),
+		),
+	})
+}
+
+func RequestEmailVerification(ctx context.Context, user queries.User) error {
+	if user.EmailVerified {
+		return ErrEmailAlreadyVerified
+	}
+
+	if tokenRequestedRecently(ctx, user.ID, EmailVerificationToken) {
+		return ErrTokenRequestTooSoon
+	}
+
+	return sendEmailVerification(ctx, user.ID, user.Email)
+}
+
+func ConfirmEmail(ctx context.Context, token string) error {
+	userID, err := db.Query.ConsumeToken(ctx, queries.ConsumeTokenParams{
+		Token: token,
+		Type:  EmailVerificationToken,
+	})
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	return db.Query.VerifyUserEmail(ctx, userID)
+}
+
+// RequestPasswordReset mails a reset link to the owner of the email. Whether
+// the email belongs to someone is never told to the requester.
+func RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := db.Query.GetUser(ctx, queries.GetUserParams{
+		Email: email,
+	})
+	if err != nil {
+		return nil
+	}
+
+	if tokenRequestedRecently(ctx, user.ID, PasswordResetToken) {
+		return nil
+	}
+
+	token, err := issueToken(ctx, user.ID, PasswordResetToken, PasswordResetDuration)
+	if err != nil {
+		return err
+	}
+
+	return mailer.Send(ctx, mailer.Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Open this link to choose a new password:\n\n%s\n\nIt expires in 1 hour. If you didn't ask for it, you can ignore this mail.",
+			tokenLink("reset_password", token),
+		),
+	})
+}
+
+// ResetPassword sets the new password and signs the user out everywhere, the
+// sessions revoked are returned.
+func ResetPassword(ctx context.Context, token, password string) ([]string, error) {
+	userID, err := db.Query.ConsumeToken(ctx, queries.ConsumeTokenParams{
+		Token: token,
+		Type:  PasswordResetToken,
+	})
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		slog.Error("error on hashing", "err", err)
+		return nil, err
+	}
+
+	err = db.Query.UpdateUserPassword(ctx, queries.UpdateUserPasswordParams{
+		ID:       userID,
+		Password: hashedPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Query.DeleteUserTokens(ctx, queries.DeleteUserTokensParams{
+		UserID: userID,
+		Type:   RememberMeToken,
+	})
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okzmo/kyob/db"
+)
+
+func TestConfirmEmail(t *testing.T) {
+	setupTestDB(t)
+	ctx := context.Background()
+	m := useMemoryMailer(t)
+	user := createTestUser(t)
+
+	err := RequestEmailVerification(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = RequestEmailVerification(ctx, user)
+	if !errors.Is(err, ErrTokenRequestTooSoon) {
+		t.Fatalf("expected ErrTokenRequestTooSoon, got %v", err)
+	}
+
+	token := mailToken(t, m, user.Email)
+	err = ConfirmEmail(ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verified, err := db.Query.GetUserById(ctx, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verified.EmailVerified {
+		t.Fatal("the email should be verified")
+	}
+
+	err = ConfirmEmail(ctx, token)
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("a token should only be used once, got %v", err)
+	}
+}
+
+func TestConfirmEmailExpired(t *testing.T) {
+	setupTestDB(t)
+	ctx := context.Background()
+	user := createTestUser(t)
+
+	token, err := issueToken(ctx, user.ID, EmailVerificationToken, -time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ConfirmEmail(ctx, token)
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("an expired token should be refused, got %v", err)
+	}
+}
+
+func TestConfirmEmailWrongType(t *testing.T) {
+	setupTestDB(t)
+	ctx := context.Background()
+	user := createTestUser(t)
+
+	token, err := issueToken(ctx, user.ID, PasswordResetToken, PasswordResetDuration)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ConfirmEmail(ctx, token)
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("a password reset token should not verify an email, got %v", err)
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	setupTestDB(t)
+	ctx := context.Background()
+	m := useMemoryMailer(t)
+	user := createTestUser(t)
+
+	err := RequestPasswordReset(ctx, user.Email)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token := mailToken(t, m, user.Email)
+	_, err = ResetPassword(ctx, token, "new password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ResetPassword(ctx, token, "another password")
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("a token should only be used once, got %v", err)
+	}
+}
+
+func TestUpdateAccountVerifiesNewEmail(t *testing.T) {
+	setupTestDB(t)
+	m := useMemoryMailer(t)
+	user := createTestUser(t)
+
+	err := db.Query.VerifyUserEmail(context.Background(), user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	email := "new" + user.Email
+	ctx := context.WithValue(context.Background(), "user", user)
+	err = UpdateAccount(ctx, &UpdateAccountBody{Email: email})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := db.Query.GetUserById(ctx, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Email != email || updated.EmailVerified {
+		t.Fatal("the new email should be waiting for verification")
+	}
+
+	err = ConfirmEmail(ctx, mailToken(t, m, email))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err = db.Query.GetUserById(ctx, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated.EmailVerified {
+		t.Fatal("the new email should be verified")
+	}
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	queries "github.com/okzmo/kyob/db/gen_queries"
+	"github.com/okzmo/kyob/internal/domain/permissions"
+	proto "github.com/okzmo/kyob/types"
+)
+
+var (
+	ErrNotVoiceChannel    = errors.New("not a voice channel")
+	ErrNotStageChannel    = errors.New("not a stage channel")
+	ErrInvalidMove        = errors.New("cannot move to the same channel")
+	ErrInvalidStageAction = errors.New("invalid stage action")
+)
+
+const (
+	StageRaiseHand     = "raise_hand"
+	StageLowerHand     = "lower_hand"
+	StageAddSpeaker    = "add_speaker"
+	StageRemoveSpeaker = "remove_speaker"
+)
+
+type VoiceStateBody struct {
+	Mute   *bool `json:"mute"`
+	Deafen *bool `json:"deafen"`
+}
+
+type ServerMuteBody struct {
+	Mute bool `json:"mute"`
+}
+
+type MoveMemberBody struct {
+	ChannelID string `validate:"required" json:"channel_id"`
+}
+
+type StageActionBody struct {
+	Action   string `validate:"required,oneof=raise_hand lower_hand add_speaker remove_speaker" json:"action"`
+	TargetID string `json:"target_id"`
+}
+
+func getVoiceChannel(ctx context.Context, serverID, channelID string) (*queries.Channel, error) {
+	channel, err := getServerChannel(ctx, serverID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.Type != queries.ChannelTypeVoice && channel.Type != queries.ChannelTypeStage {
+		return nil, ErrNotVoiceChannel
+	}
+
+	return channel, nil
+}
+
+// ServerMuteMember revokes, or gives back, the right of a member to speak in
+// the call they are in, whatever their own microphone says. Their camera and
+// screen stay as their abilities allow.
+func ServerMuteMember(ctx context.Context, requesterID, serverID, channelID, userID string, state CallState) error {
+	err := checkModeration(ctx, serverID, requesterID, userID, permissions.Mute, false)
+	if err != nil {
+		return err
+	}
+
+	_, err = getVoiceChannel(ctx, serverID, channelID)
+	if err != nil {
+		return err
+	}
+
+	err = UpdateCallPermissions(ctx, channelID, userID, state)
+	if err != nil {
+		return err
+	}
+
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   serverID,
+		ActorID:    requesterID,
+		Action:     AuditMemberServerMute,
+		TargetType: AuditTargetUser,
+		TargetID:   userID,
+		Changes: AuditChanges{
+			"server_mute": {Before: !state.ServerMute, After: state.ServerMute},
+		},
+	})
+
+	return nil
+}
+
+// MoveMember checks that a member can be moved to another call, the
+// destination channel then hands them a token to join it and CompleteMove
+// pulls them out of the call they were in.
+func MoveMember(ctx context.Context, requesterID, serverID, channelID, userID string, body *MoveMemberBody) (*proto.MoveToCall, error) {
+	if body.ChannelID == channelID {
+		return nil, ErrInvalidMove
+	}
+
+	err := checkModeration(ctx, serverID, requesterID, userID, permissions.MoveMembers, false)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = getVoiceChannel(ctx, serverID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = getVoiceChannel(ctx, serverID, body.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckChannelAbility(ctx, body.ChannelID, userID, permissions.Connect)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.MoveToCall{
+		UserId:        userID,
+		ServerId:      serverID,
+		FromChannelId: channelID,
+		ToChannelId:   body.ChannelID,
+	}, nil
+}
+
+// CompleteMove removes a moved member from the room they left once the
+// destination channel gave them a token.
+func CompleteMove(ctx context.Context, requesterID string, move *proto.MoveToCall) error {
+	writeAuditLog(ctx, AuditEntry{
+		ServerID:   move.ServerId,
+		ActorID:    requesterID,
+		Action:     AuditMemberMove,
+		TargetType: AuditTargetUser,
+		TargetID:   move.UserId,
+		Changes: AuditChanges{
+			"channel_id": {Before: move.FromChannelId, After: move.ToChannelId},
+		},
+	})
+
+	return removeParticipant(ctx, move.FromChannelId, move.UserId)
+}
+
+// CheckStageAction makes sure the channel is a stage and that the user may
+// act on the target. Anyone can raise their hand or step down, choosing the
+// speakers is up to the stage managers.
+func CheckStageAction(ctx context.Context, serverID, channelID, userID string, msg *proto.StageAction) error {
+	channel, err := getServerChannel(ctx, serverID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if channel.Type != queries.ChannelTypeStage {
+		return ErrNotStageChannel
+	}
+
+	switch msg.Action {
+	case StageRaiseHand, StageLowerHand:
+		if msg.TargetId != userID {
+			return ErrInvalidStageAction
+		}
+		return nil
+	case StageRemoveSpeaker:
+		if msg.TargetId == userID {
+			return nil
+		}
+	case StageAddSpeaker:
+	default:
+		return ErrInvalidStageAction
+	}
+
+	return CheckAbility(ctx, serverID, userID, permissions.ManageStage)
+}
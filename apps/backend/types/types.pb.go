@@ -48,6 +48,21 @@ type WSMessage struct {
 	//	*WSMessage_RemoveRoleMember
 	//	*WSMessage_CreateRole
 	//	*WSMessage_MoveRole
+	//	*WSMessage_ReactionAdded
+	//	*WSMessage_ReactionRemoved
+	//	*WSMessage_ThreadCreation
+	//	*WSMessage_ModerationAction
+	//	*WSMessage_JoinRequest
+	//	*WSMessage_JoinRequestResolved
+	//	*WSMessage_Ack
+	//	*WSMessage_Error
+	//	*WSMessage_Typing
+	//	*WSMessage_Presence
+	//	*WSMessage_ReadState
+	//	*WSMessage_Notification
+	//	*WSMessage_NotificationsRead
+	//	*WSMessage_MoveToCall
+	//	*WSMessage_Stage
 	Content       isWSMessage_Content `protobuf_oneof:"content"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -288,6 +303,141 @@ func (x *WSMessage) GetMoveRole() *ChangeRoleRanking {
 	return nil
 }
 
+func (x *WSMessage) GetReactionAdded() *BroadcastReactionAdded {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_ReactionAdded); ok {
+			return x.ReactionAdded
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetReactionRemoved() *BroadcastReactionRemoved {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_ReactionRemoved); ok {
+			return x.ReactionRemoved
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetThreadCreation() *BroadcastThreadCreation {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_ThreadCreation); ok {
+			return x.ThreadCreation
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetModerationAction() *BroadcastModerationAction {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_ModerationAction); ok {
+			return x.ModerationAction
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetJoinRequest() *JoinRequest {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_JoinRequest); ok {
+			return x.JoinRequest
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetJoinRequestResolved() *JoinRequestResolved {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_JoinRequestResolved); ok {
+			return x.JoinRequestResolved
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetAck() *Ack {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetError() *RequestError {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetTyping() *Typing {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_Typing); ok {
+			return x.Typing
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetPresence() *Presence {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_Presence); ok {
+			return x.Presence
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetReadState() *ReadState {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_ReadState); ok {
+			return x.ReadState
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetNotification() *Notification {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_Notification); ok {
+			return x.Notification
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetNotificationsRead() *NotificationsRead {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_NotificationsRead); ok {
+			return x.NotificationsRead
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetMoveToCall() *MoveToCall {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_MoveToCall); ok {
+			return x.MoveToCall
+		}
+	}
+	return nil
+}
+
+func (x *WSMessage) GetStage() *StageState {
+	if x != nil {
+		if x, ok := x.Content.(*WSMessage_Stage); ok {
+			return x.Stage
+		}
+	}
+	return nil
+}
+
 type isWSMessage_Content interface {
 	isWSMessage_Content()
 }
@@ -380,6 +530,66 @@ type WSMessage_MoveRole struct {
 	MoveRole *ChangeRoleRanking `protobuf:"bytes,22,opt,name=move_role,json=moveRole,proto3,oneof"`
 }
 
+type WSMessage_ReactionAdded struct {
+	ReactionAdded *BroadcastReactionAdded `protobuf:"bytes,23,opt,name=reaction_added,json=reactionAdded,proto3,oneof"`
+}
+
+type WSMessage_ReactionRemoved struct {
+	ReactionRemoved *BroadcastReactionRemoved `protobuf:"bytes,24,opt,name=reaction_removed,json=reactionRemoved,proto3,oneof"`
+}
+
+type WSMessage_ThreadCreation struct {
+	ThreadCreation *BroadcastThreadCreation `protobuf:"bytes,25,opt,name=thread_creation,json=threadCreation,proto3,oneof"`
+}
+
+type WSMessage_ModerationAction struct {
+	ModerationAction *BroadcastModerationAction `protobuf:"bytes,26,opt,name=moderation_action,json=moderationAction,proto3,oneof"`
+}
+
+type WSMessage_JoinRequest struct {
+	JoinRequest *JoinRequest `protobuf:"bytes,27,opt,name=join_request,json=joinRequest,proto3,oneof"`
+}
+
+type WSMessage_JoinRequestResolved struct {
+	JoinRequestResolved *JoinRequestResolved `protobuf:"bytes,28,opt,name=join_request_resolved,json=joinRequestResolved,proto3,oneof"`
+}
+
+type WSMessage_Ack struct {
+	Ack *Ack `protobuf:"bytes,29,opt,name=ack,proto3,oneof"`
+}
+
+type WSMessage_Error struct {
+	Error *RequestError `protobuf:"bytes,30,opt,name=error,proto3,oneof"`
+}
+
+type WSMessage_Typing struct {
+	Typing *Typing `protobuf:"bytes,31,opt,name=typing,proto3,oneof"`
+}
+
+type WSMessage_Presence struct {
+	Presence *Presence `protobuf:"bytes,32,opt,name=presence,proto3,oneof"`
+}
+
+type WSMessage_ReadState struct {
+	ReadState *ReadState `protobuf:"bytes,33,opt,name=read_state,json=readState,proto3,oneof"`
+}
+
+type WSMessage_Notification struct {
+	Notification *Notification `protobuf:"bytes,34,opt,name=notification,proto3,oneof"`
+}
+
+type WSMessage_NotificationsRead struct {
+	NotificationsRead *NotificationsRead `protobuf:"bytes,35,opt,name=notifications_read,json=notificationsRead,proto3,oneof"`
+}
+
+type WSMessage_MoveToCall struct {
+	MoveToCall *MoveToCall `protobuf:"bytes,36,opt,name=move_to_call,json=moveToCall,proto3,oneof"`
+}
+
+type WSMessage_Stage struct {
+	Stage *StageState `protobuf:"bytes,37,opt,name=stage,proto3,oneof"`
+}
+
 func (*WSMessage_ChatMessage) isWSMessage_Content() {}
 
 func (*WSMessage_ChannelCreation) isWSMessage_Content() {}
@@ -424,29 +634,70 @@ func (*WSMessage_CreateRole) isWSMessage_Content() {}
 
 func (*WSMessage_MoveRole) isWSMessage_Content() {}
 
-type UserLinksRow struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+func (*WSMessage_ReactionAdded) isWSMessage_Content() {}
+
+func (*WSMessage_ReactionRemoved) isWSMessage_Content() {}
+
+func (*WSMessage_ThreadCreation) isWSMessage_Content() {}
+
+func (*WSMessage_ModerationAction) isWSMessage_Content() {}
+
+func (*WSMessage_JoinRequest) isWSMessage_Content() {}
+
+func (*WSMessage_JoinRequestResolved) isWSMessage_Content() {}
+
+func (*WSMessage_Ack) isWSMessage_Content() {}
+
+func (*WSMessage_Error) isWSMessage_Content() {}
+
+func (*WSMessage_Typing) isWSMessage_Content() {}
+
+func (*WSMessage_Presence) isWSMessage_Content() {}
+
+func (*WSMessage_ReadState) isWSMessage_Content() {}
+
+func (*WSMessage_Notification) isWSMessage_Content() {}
+
+func (*WSMessage_NotificationsRead) isWSMessage_Content() {}
+
+func (*WSMessage_MoveToCall) isWSMessage_Content() {}
+
+func (*WSMessage_Stage) isWSMessage_Content() {}
+
+type ClientMessage struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are valid to be assigned to Content:
+	//
+	//	*ClientMessage_SendMessage
+	//	*ClientMessage_EditMessage
+	//	*ClientMessage_DeleteMessage
+	//	*ClientMessage_Typing
+	//	*ClientMessage_VoiceState
+	//	*ClientMessage_SetPresence
+	//	*ClientMessage_AckChannel
+	//	*ClientMessage_Mute
+	//	*ClientMessage_Deafen
+	//	*ClientMessage_Stage
+	Content       isClientMessage_Content `protobuf_oneof:"content"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserLinksRow) Reset() {
-	*x = UserLinksRow{}
+func (x *ClientMessage) Reset() {
+	*x = ClientMessage{}
 	mi := &file_types_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserLinksRow) String() string {
+func (x *ClientMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLinksRow) ProtoMessage() {}
+func (*ClientMessage) ProtoMessage() {}
 
-func (x *UserLinksRow) ProtoReflect() protoreflect.Message {
+func (x *ClientMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -458,244 +709,340 @@ func (x *UserLinksRow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLinksRow.ProtoReflect.Descriptor instead.
-func (*UserLinksRow) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClientMessage.ProtoReflect.Descriptor instead.
+func (*ClientMessage) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *UserLinksRow) GetId() string {
+func (x *ClientMessage) GetRequestId() string {
 	if x != nil {
-		return x.Id
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *UserLinksRow) GetLabel() string {
+func (x *ClientMessage) GetContent() isClientMessage_Content {
 	if x != nil {
-		return x.Label
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *UserLinksRow) GetUrl() string {
+func (x *ClientMessage) GetSendMessage() *IncomingChatMessage {
 	if x != nil {
-		return x.Url
+		if x, ok := x.Content.(*ClientMessage_SendMessage); ok {
+			return x.SendMessage
+		}
 	}
-	return ""
+	return nil
 }
 
-type UserFactsRow struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ClientMessage) GetEditMessage() *EditChatMessage {
+	if x != nil {
+		if x, ok := x.Content.(*ClientMessage_EditMessage); ok {
+			return x.EditMessage
+		}
+	}
+	return nil
 }
 
-func (x *UserFactsRow) Reset() {
-	*x = UserFactsRow{}
-	mi := &file_types_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ClientMessage) GetDeleteMessage() *DeleteChatMessage {
+	if x != nil {
+		if x, ok := x.Content.(*ClientMessage_DeleteMessage); ok {
+			return x.DeleteMessage
+		}
+	}
+	return nil
 }
 
-func (x *UserFactsRow) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ClientMessage) GetTyping() *Typing {
+	if x != nil {
+		if x, ok := x.Content.(*ClientMessage_Typing); ok {
+			return x.Typing
+		}
+	}
+	return nil
 }
 
-func (*UserFactsRow) ProtoMessage() {}
+func (x *ClientMessage) GetVoiceState() *VoiceState {
+	if x != nil {
+		if x, ok := x.Content.(*ClientMessage_VoiceState); ok {
+			return x.VoiceState
+		}
+	}
+	return nil
+}
 
-func (x *UserFactsRow) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[2]
+func (x *ClientMessage) GetSetPresence() *Presence {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Content.(*ClientMessage_SetPresence); ok {
+			return x.SetPresence
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UserFactsRow.ProtoReflect.Descriptor instead.
-func (*UserFactsRow) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{2}
+func (x *ClientMessage) GetAckChannel() *ReadState {
+	if x != nil {
+		if x, ok := x.Content.(*ClientMessage_AckChannel); ok {
+			return x.AckChannel
+		}
+	}
+	return nil
 }
 
-func (x *UserFactsRow) GetId() string {
+func (x *ClientMessage) GetMute() *Mute {
 	if x != nil {
-		return x.Id
+		if x, ok := x.Content.(*ClientMessage_Mute); ok {
+			return x.Mute
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *UserFactsRow) GetLabel() string {
+func (x *ClientMessage) GetDeafen() *Deafen {
 	if x != nil {
-		return x.Label
+		if x, ok := x.Content.(*ClientMessage_Deafen); ok {
+			return x.Deafen
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *UserFactsRow) GetValue() string {
+func (x *ClientMessage) GetStage() *StageAction {
 	if x != nil {
-		return x.Value
+		if x, ok := x.Content.(*ClientMessage_Stage); ok {
+			return x.Stage
+		}
 	}
-	return ""
+	return nil
 }
 
-type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Avatar        *string                `protobuf:"bytes,5,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
-	Banner        *string                `protobuf:"bytes,6,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
-	MainColor     *string                `protobuf:"bytes,7,opt,name=main_color,json=mainColor,proto3,oneof" json:"main_color,omitempty"`
-	About         []byte                 `protobuf:"bytes,8,opt,name=about,proto3,oneof" json:"about,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Links         []byte                 `protobuf:"bytes,10,opt,name=links,proto3" json:"links,omitempty"`
-	Facts         []byte                 `protobuf:"bytes,11,opt,name=facts,proto3" json:"facts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type isClientMessage_Content interface {
+	isClientMessage_Content()
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_types_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+type ClientMessage_SendMessage struct {
+	SendMessage *IncomingChatMessage `protobuf:"bytes,2,opt,name=send_message,json=sendMessage,proto3,oneof"`
 }
 
-func (x *User) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type ClientMessage_EditMessage struct {
+	EditMessage *EditChatMessage `protobuf:"bytes,3,opt,name=edit_message,json=editMessage,proto3,oneof"`
 }
 
-func (*User) ProtoMessage() {}
+type ClientMessage_DeleteMessage struct {
+	DeleteMessage *DeleteChatMessage `protobuf:"bytes,4,opt,name=delete_message,json=deleteMessage,proto3,oneof"`
+}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
+type ClientMessage_Typing struct {
+	Typing *Typing `protobuf:"bytes,5,opt,name=typing,proto3,oneof"`
+}
+
+type ClientMessage_VoiceState struct {
+	VoiceState *VoiceState `protobuf:"bytes,6,opt,name=voice_state,json=voiceState,proto3,oneof"`
+}
+
+type ClientMessage_SetPresence struct {
+	SetPresence *Presence `protobuf:"bytes,7,opt,name=set_presence,json=setPresence,proto3,oneof"`
+}
+
+type ClientMessage_AckChannel struct {
+	AckChannel *ReadState `protobuf:"bytes,8,opt,name=ack_channel,json=ackChannel,proto3,oneof"`
+}
+
+type ClientMessage_Mute struct {
+	Mute *Mute `protobuf:"bytes,9,opt,name=mute,proto3,oneof"`
+}
+
+type ClientMessage_Deafen struct {
+	Deafen *Deafen `protobuf:"bytes,10,opt,name=deafen,proto3,oneof"`
+}
+
+type ClientMessage_Stage struct {
+	Stage *StageAction `protobuf:"bytes,11,opt,name=stage,proto3,oneof"`
+}
+
+func (*ClientMessage_SendMessage) isClientMessage_Content() {}
+
+func (*ClientMessage_EditMessage) isClientMessage_Content() {}
+
+func (*ClientMessage_DeleteMessage) isClientMessage_Content() {}
+
+func (*ClientMessage_Typing) isClientMessage_Content() {}
+
+func (*ClientMessage_VoiceState) isClientMessage_Content() {}
+
+func (*ClientMessage_SetPresence) isClientMessage_Content() {}
+
+func (*ClientMessage_AckChannel) isClientMessage_Content() {}
+
+func (*ClientMessage_Mute) isClientMessage_Content() {}
+
+func (*ClientMessage_Deafen) isClientMessage_Content() {}
+
+func (*ClientMessage_Stage) isClientMessage_Content() {}
+
+type Ack struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RequestId          string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Message            *BroadcastChatMessage  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	EditedMessage      *BroadcastEditMessage  `protobuf:"bytes,3,opt,name=edited_message,json=editedMessage,proto3" json:"edited_message,omitempty"`
+	CallToken          string                 `protobuf:"bytes,4,opt,name=call_token,json=callToken,proto3" json:"call_token,omitempty"`
+	CallTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=call_token_expires_at,json=callTokenExpiresAt,proto3" json:"call_token_expires_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Ack) Reset() {
+	*x = Ack{}
+	mi := &file_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ack) ProtoMessage() {}
+
+func (x *Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
 		return ms
 	}
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
+func (*Ack) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *User) GetId() string {
+func (x *Ack) GetRequestId() string {
 	if x != nil {
-		return x.Id
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *User) GetEmail() string {
+func (x *Ack) GetMessage() *BroadcastChatMessage {
 	if x != nil {
-		return x.Email
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetUsername() string {
+func (x *Ack) GetEditedMessage() *BroadcastEditMessage {
 	if x != nil {
-		return x.Username
+		return x.EditedMessage
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetDisplayName() string {
+func (x *Ack) GetCallToken() string {
 	if x != nil {
-		return x.DisplayName
+		return x.CallToken
 	}
 	return ""
 }
 
-func (x *User) GetAvatar() string {
-	if x != nil && x.Avatar != nil {
-		return *x.Avatar
+func (x *Ack) GetCallTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CallTokenExpiresAt
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetBanner() string {
-	if x != nil && x.Banner != nil {
-		return *x.Banner
-	}
-	return ""
+type RequestError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) GetMainColor() string {
-	if x != nil && x.MainColor != nil {
-		return *x.MainColor
-	}
-	return ""
+func (x *RequestError) Reset() {
+	*x = RequestError{}
+	mi := &file_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) GetAbout() []byte {
+func (x *RequestError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestError) ProtoMessage() {}
+
+func (x *RequestError) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[3]
 	if x != nil {
-		return x.About
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use RequestError.ProtoReflect.Descriptor instead.
+func (*RequestError) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RequestError) GetRequestId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (x *User) GetLinks() []byte {
+func (x *RequestError) GetCode() string {
 	if x != nil {
-		return x.Links
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *User) GetFacts() []byte {
+func (x *RequestError) GetMessage() string {
 	if x != nil {
-		return x.Facts
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type IncomingChatMessage struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AuthorId         string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	ServerId         string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId        string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Content          []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Everyone         bool                   `protobuf:"varint,5,opt,name=everyone,proto3" json:"everyone,omitempty"`
-	MentionsUsers    []string               `protobuf:"bytes,6,rep,name=mentions_users,json=mentionsUsers,proto3" json:"mentions_users,omitempty"`
-	MentionsChannels []string               `protobuf:"bytes,7,rep,name=mentions_channels,json=mentionsChannels,proto3" json:"mentions_channels,omitempty"`
-	Attachments      []byte                 `protobuf:"bytes,8,opt,name=attachments,proto3" json:"attachments,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type Typing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Typing        bool                   `protobuf:"varint,4,opt,name=typing,proto3" json:"typing,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncomingChatMessage) Reset() {
-	*x = IncomingChatMessage{}
+func (x *Typing) Reset() {
+	*x = Typing{}
 	mi := &file_types_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncomingChatMessage) String() string {
+func (x *Typing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncomingChatMessage) ProtoMessage() {}
+func (*Typing) ProtoMessage() {}
 
-func (x *IncomingChatMessage) ProtoReflect() protoreflect.Message {
+func (x *Typing) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,95 +1054,72 @@ func (x *IncomingChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncomingChatMessage.ProtoReflect.Descriptor instead.
-func (*IncomingChatMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use Typing.ProtoReflect.Descriptor instead.
+func (*Typing) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *IncomingChatMessage) GetAuthorId() string {
+func (x *Typing) GetUserId() string {
 	if x != nil {
-		return x.AuthorId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *IncomingChatMessage) GetServerId() string {
+func (x *Typing) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *IncomingChatMessage) GetChannelId() string {
+func (x *Typing) GetChannelId() string {
 	if x != nil {
 		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *IncomingChatMessage) GetContent() []byte {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-func (x *IncomingChatMessage) GetEveryone() bool {
+func (x *Typing) GetTyping() bool {
 	if x != nil {
-		return x.Everyone
+		return x.Typing
 	}
 	return false
 }
 
-func (x *IncomingChatMessage) GetMentionsUsers() []string {
-	if x != nil {
-		return x.MentionsUsers
-	}
-	return nil
-}
-
-func (x *IncomingChatMessage) GetMentionsChannels() []string {
-	if x != nil {
-		return x.MentionsChannels
-	}
-	return nil
-}
-
-func (x *IncomingChatMessage) GetAttachments() []byte {
+func (x *Typing) GetRequestId() string {
 	if x != nil {
-		return x.Attachments
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-type EditChatMessage struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ServerId         string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId        string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	MessageId        string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	Content          []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Everyone         bool                   `protobuf:"varint,6,opt,name=everyone,proto3" json:"everyone,omitempty"`
-	MentionsUsers    []string               `protobuf:"bytes,7,rep,name=mentions_users,json=mentionsUsers,proto3" json:"mentions_users,omitempty"`
-	MentionsChannels []string               `protobuf:"bytes,8,rep,name=mentions_channels,json=mentionsChannels,proto3" json:"mentions_channels,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type Presence struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId              string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Status                string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CustomStatusText      string                 `protobuf:"bytes,4,opt,name=custom_status_text,json=customStatusText,proto3" json:"custom_status_text,omitempty"`
+	CustomStatusEmoji     string                 `protobuf:"bytes,5,opt,name=custom_status_emoji,json=customStatusEmoji,proto3" json:"custom_status_emoji,omitempty"`
+	CustomStatusExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=custom_status_expires_at,json=customStatusExpiresAt,proto3" json:"custom_status_expires_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *EditChatMessage) Reset() {
-	*x = EditChatMessage{}
+func (x *Presence) Reset() {
+	*x = Presence{}
 	mi := &file_types_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditChatMessage) String() string {
+func (x *Presence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditChatMessage) ProtoMessage() {}
+func (*Presence) ProtoMessage() {}
 
-func (x *EditChatMessage) ProtoReflect() protoreflect.Message {
+func (x *Presence) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,91 +1131,75 @@ func (x *EditChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditChatMessage.ProtoReflect.Descriptor instead.
-func (*EditChatMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *EditChatMessage) GetUserId() string {
+func (x *Presence) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *EditChatMessage) GetServerId() string {
+func (x *Presence) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *EditChatMessage) GetChannelId() string {
+func (x *Presence) GetStatus() string {
 	if x != nil {
-		return x.ChannelId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *EditChatMessage) GetMessageId() string {
+func (x *Presence) GetCustomStatusText() string {
 	if x != nil {
-		return x.MessageId
+		return x.CustomStatusText
 	}
 	return ""
 }
 
-func (x *EditChatMessage) GetContent() []byte {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-func (x *EditChatMessage) GetEveryone() bool {
-	if x != nil {
-		return x.Everyone
-	}
-	return false
-}
-
-func (x *EditChatMessage) GetMentionsUsers() []string {
+func (x *Presence) GetCustomStatusEmoji() string {
 	if x != nil {
-		return x.MentionsUsers
+		return x.CustomStatusEmoji
 	}
-	return nil
+	return ""
 }
 
-func (x *EditChatMessage) GetMentionsChannels() []string {
+func (x *Presence) GetCustomStatusExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MentionsChannels
+		return x.CustomStatusExpiresAt
 	}
 	return nil
 }
 
-type DeleteChatMessage struct {
+type UserChangedPresence struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Presence      *Presence              `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteChatMessage) Reset() {
-	*x = DeleteChatMessage{}
+func (x *UserChangedPresence) Reset() {
+	*x = UserChangedPresence{}
 	mi := &file_types_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteChatMessage) String() string {
+func (x *UserChangedPresence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteChatMessage) ProtoMessage() {}
+func (*UserChangedPresence) ProtoMessage() {}
 
-func (x *DeleteChatMessage) ProtoReflect() protoreflect.Message {
+func (x *UserChangedPresence) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -903,183 +1211,126 @@ func (x *DeleteChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteChatMessage.ProtoReflect.Descriptor instead.
-func (*DeleteChatMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserChangedPresence.ProtoReflect.Descriptor instead.
+func (*UserChangedPresence) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteChatMessage) GetUserId() string {
+func (x *UserChangedPresence) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteChatMessage) GetServerId() string {
+func (x *UserChangedPresence) GetPresence() *Presence {
 	if x != nil {
-		return x.ServerId
+		return x.Presence
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteChatMessage) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
+type ReadState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ServerId          string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId         string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LastReadMessageId string                 `protobuf:"bytes,3,opt,name=last_read_message_id,json=lastReadMessageId,proto3" json:"last_read_message_id,omitempty"`
+	UnreadCount       int32                  `protobuf:"varint,4,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount      int32                  `protobuf:"varint,5,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *DeleteChatMessage) GetMessageId() string {
+func (x *ReadState) Reset() {
+	*x = ReadState{}
+	mi := &file_types_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadState) ProtoMessage() {}
+
+func (x *ReadState) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[7]
 	if x != nil {
-		return x.MessageId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type BroadcastChatMessage struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AuthorId         string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	ServerId         string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId        string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Content          []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Everyone         bool                   `protobuf:"varint,6,opt,name=everyone,proto3" json:"everyone,omitempty"`
-	MentionsUsers    []string               `protobuf:"bytes,7,rep,name=mentions_users,json=mentionsUsers,proto3" json:"mentions_users,omitempty"`
-	MentionsChannels []string               `protobuf:"bytes,8,rep,name=mentions_channels,json=mentionsChannels,proto3" json:"mentions_channels,omitempty"`
-	Attachments      []byte                 `protobuf:"bytes,9,opt,name=attachments,proto3" json:"attachments,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *BroadcastChatMessage) Reset() {
-	*x = BroadcastChatMessage{}
-	mi := &file_types_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *BroadcastChatMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BroadcastChatMessage) ProtoMessage() {}
-
-func (x *BroadcastChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BroadcastChatMessage.ProtoReflect.Descriptor instead.
-func (*BroadcastChatMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadState.ProtoReflect.Descriptor instead.
+func (*ReadState) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *BroadcastChatMessage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *BroadcastChatMessage) GetAuthorId() string {
-	if x != nil {
-		return x.AuthorId
-	}
-	return ""
-}
-
-func (x *BroadcastChatMessage) GetServerId() string {
+func (x *ReadState) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *BroadcastChatMessage) GetChannelId() string {
+func (x *ReadState) GetChannelId() string {
 	if x != nil {
 		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *BroadcastChatMessage) GetContent() []byte {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-func (x *BroadcastChatMessage) GetEveryone() bool {
-	if x != nil {
-		return x.Everyone
-	}
-	return false
-}
-
-func (x *BroadcastChatMessage) GetMentionsUsers() []string {
+func (x *ReadState) GetLastReadMessageId() string {
 	if x != nil {
-		return x.MentionsUsers
-	}
-	return nil
-}
-
-func (x *BroadcastChatMessage) GetMentionsChannels() []string {
-	if x != nil {
-		return x.MentionsChannels
+		return x.LastReadMessageId
 	}
-	return nil
+	return ""
 }
 
-func (x *BroadcastChatMessage) GetAttachments() []byte {
+func (x *ReadState) GetUnreadCount() int32 {
 	if x != nil {
-		return x.Attachments
+		return x.UnreadCount
 	}
-	return nil
+	return 0
 }
 
-func (x *BroadcastChatMessage) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ReadState) GetMentionCount() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.MentionCount
 	}
-	return nil
+	return 0
 }
 
-type BroadcastEditMessage struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	MessageId        string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	ServerId         string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId        string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Content          []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Everyone         bool                   `protobuf:"varint,5,opt,name=everyone,proto3" json:"everyone,omitempty"`
-	MentionsUsers    []string               `protobuf:"bytes,6,rep,name=mentions_users,json=mentionsUsers,proto3" json:"mentions_users,omitempty"`
-	MentionsChannels []string               `protobuf:"bytes,7,rep,name=mentions_channels,json=mentionsChannels,proto3" json:"mentions_channels,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Message       *BroadcastChatMessage  `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BroadcastEditMessage) Reset() {
-	*x = BroadcastEditMessage{}
+func (x *Notification) Reset() {
+	*x = Notification{}
 	mi := &file_types_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastEditMessage) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastEditMessage) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *BroadcastEditMessage) ProtoReflect() protoreflect.Message {
+func (x *Notification) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1091,90 +1342,68 @@ func (x *BroadcastEditMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastEditMessage.ProtoReflect.Descriptor instead.
-func (*BroadcastEditMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BroadcastEditMessage) GetMessageId() string {
+func (x *Notification) GetId() string {
 	if x != nil {
-		return x.MessageId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BroadcastEditMessage) GetServerId() string {
+func (x *Notification) GetUserId() string {
 	if x != nil {
-		return x.ServerId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *BroadcastEditMessage) GetChannelId() string {
+func (x *Notification) GetType() string {
 	if x != nil {
-		return x.ChannelId
+		return x.Type
 	}
 	return ""
 }
 
-func (x *BroadcastEditMessage) GetContent() []byte {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-func (x *BroadcastEditMessage) GetEveryone() bool {
-	if x != nil {
-		return x.Everyone
-	}
-	return false
-}
-
-func (x *BroadcastEditMessage) GetMentionsUsers() []string {
-	if x != nil {
-		return x.MentionsUsers
-	}
-	return nil
-}
-
-func (x *BroadcastEditMessage) GetMentionsChannels() []string {
+func (x *Notification) GetMessage() *BroadcastChatMessage {
 	if x != nil {
-		return x.MentionsChannels
+		return x.Message
 	}
 	return nil
 }
 
-func (x *BroadcastEditMessage) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type BroadcastDeleteChatMessage struct {
+type NotificationsRead struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BroadcastDeleteChatMessage) Reset() {
-	*x = BroadcastDeleteChatMessage{}
+func (x *NotificationsRead) Reset() {
+	*x = NotificationsRead{}
 	mi := &file_types_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastDeleteChatMessage) String() string {
+func (x *NotificationsRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastDeleteChatMessage) ProtoMessage() {}
+func (*NotificationsRead) ProtoMessage() {}
 
-func (x *BroadcastDeleteChatMessage) ProtoReflect() protoreflect.Message {
+func (x *NotificationsRead) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1186,56 +1415,48 @@ func (x *BroadcastDeleteChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastDeleteChatMessage.ProtoReflect.Descriptor instead.
-func (*BroadcastDeleteChatMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use NotificationsRead.ProtoReflect.Descriptor instead.
+func (*NotificationsRead) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BroadcastDeleteChatMessage) GetMessageId() string {
-	if x != nil {
-		return x.MessageId
-	}
-	return ""
-}
-
-func (x *BroadcastDeleteChatMessage) GetServerId() string {
+func (x *NotificationsRead) GetIds() []string {
 	if x != nil {
-		return x.ServerId
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-func (x *BroadcastDeleteChatMessage) GetChannelId() string {
+func (x *NotificationsRead) GetAll() bool {
 	if x != nil {
-		return x.ChannelId
+		return x.All
 	}
-	return ""
+	return false
 }
 
-type BroadcastChannelRemoved struct {
+type VoiceState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
 	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	ActorAddress  string                 `protobuf:"bytes,4,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	Connected     bool                   `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BroadcastChannelRemoved) Reset() {
-	*x = BroadcastChannelRemoved{}
+func (x *VoiceState) Reset() {
+	*x = VoiceState{}
 	mi := &file_types_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastChannelRemoved) String() string {
+func (x *VoiceState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastChannelRemoved) ProtoMessage() {}
+func (*VoiceState) ProtoMessage() {}
 
-func (x *BroadcastChannelRemoved) ProtoReflect() protoreflect.Message {
+func (x *VoiceState) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1247,61 +1468,55 @@ func (x *BroadcastChannelRemoved) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastChannelRemoved.ProtoReflect.Descriptor instead.
-func (*BroadcastChannelRemoved) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoiceState.ProtoReflect.Descriptor instead.
+func (*VoiceState) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BroadcastChannelRemoved) GetServerId() string {
+func (x *VoiceState) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *BroadcastChannelRemoved) GetChannelId() string {
+func (x *VoiceState) GetChannelId() string {
 	if x != nil {
 		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *BroadcastChannelRemoved) GetActorId() string {
-	if x != nil {
-		return x.ActorId
-	}
-	return ""
-}
-
-func (x *BroadcastChannelRemoved) GetActorAddress() string {
+func (x *VoiceState) GetConnected() bool {
 	if x != nil {
-		return x.ActorAddress
+		return x.Connected
 	}
-	return ""
+	return false
 }
 
-type BroadcastNewUserInServer struct {
+type UserLinksRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BroadcastNewUserInServer) Reset() {
-	*x = BroadcastNewUserInServer{}
+func (x *UserLinksRow) Reset() {
+	*x = UserLinksRow{}
 	mi := &file_types_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastNewUserInServer) String() string {
+func (x *UserLinksRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastNewUserInServer) ProtoMessage() {}
+func (*UserLinksRow) ProtoMessage() {}
 
-func (x *BroadcastNewUserInServer) ProtoReflect() protoreflect.Message {
+func (x *UserLinksRow) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1313,48 +1528,55 @@ func (x *BroadcastNewUserInServer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastNewUserInServer.ProtoReflect.Descriptor instead.
-func (*BroadcastNewUserInServer) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserLinksRow.ProtoReflect.Descriptor instead.
+func (*UserLinksRow) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BroadcastNewUserInServer) GetServerId() string {
+func (x *UserLinksRow) GetId() string {
 	if x != nil {
-		return x.ServerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BroadcastNewUserInServer) GetUser() *User {
+func (x *UserLinksRow) GetLabel() string {
 	if x != nil {
-		return x.User
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-type BroadcastServerRemoved struct {
+func (x *UserLinksRow) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type UserFactsRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	ActorAddress  string                 `protobuf:"bytes,3,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BroadcastServerRemoved) Reset() {
-	*x = BroadcastServerRemoved{}
+func (x *UserFactsRow) Reset() {
+	*x = UserFactsRow{}
 	mi := &file_types_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastServerRemoved) String() string {
+func (x *UserFactsRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastServerRemoved) ProtoMessage() {}
+func (*UserFactsRow) ProtoMessage() {}
 
-func (x *BroadcastServerRemoved) ProtoReflect() protoreflect.Message {
+func (x *UserFactsRow) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1366,65 +1588,63 @@ func (x *BroadcastServerRemoved) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastServerRemoved.ProtoReflect.Descriptor instead.
-func (*BroadcastServerRemoved) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserFactsRow.ProtoReflect.Descriptor instead.
+func (*UserFactsRow) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BroadcastServerRemoved) GetServerId() string {
+func (x *UserFactsRow) GetId() string {
 	if x != nil {
-		return x.ServerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BroadcastServerRemoved) GetActorId() string {
+func (x *UserFactsRow) GetLabel() string {
 	if x != nil {
-		return x.ActorId
+		return x.Label
 	}
 	return ""
 }
 
-func (x *BroadcastServerRemoved) GetActorAddress() string {
+func (x *UserFactsRow) GetValue() string {
 	if x != nil {
-		return x.ActorAddress
+		return x.Value
 	}
 	return ""
 }
 
-type BroadcastChannelCreation struct {
+type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Users         []string               `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty"`
-	Roles         []string               `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
-	X             int32                  `protobuf:"varint,8,opt,name=x,proto3" json:"x,omitempty"`
-	Y             int32                  `protobuf:"varint,9,opt,name=y,proto3" json:"y,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	ActorId       string                 `protobuf:"bytes,12,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	ActorAddress  string                 `protobuf:"bytes,13,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Avatar        *string                `protobuf:"bytes,5,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	Banner        *string                `protobuf:"bytes,6,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
+	MainColor     *string                `protobuf:"bytes,7,opt,name=main_color,json=mainColor,proto3,oneof" json:"main_color,omitempty"`
+	About         []byte                 `protobuf:"bytes,8,opt,name=about,proto3,oneof" json:"about,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Links         []byte                 `protobuf:"bytes,10,opt,name=links,proto3" json:"links,omitempty"`
+	Facts         []byte                 `protobuf:"bytes,11,opt,name=facts,proto3" json:"facts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BroadcastChannelCreation) Reset() {
-	*x = BroadcastChannelCreation{}
+func (x *User) Reset() {
+	*x = User{}
 	mi := &file_types_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastChannelCreation) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastChannelCreation) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *BroadcastChannelCreation) ProtoReflect() protoreflect.Message {
+func (x *User) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1436,124 +1656,120 @@ func (x *BroadcastChannelCreation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastChannelCreation.ProtoReflect.Descriptor instead.
-func (*BroadcastChannelCreation) Descriptor() ([]byte, []int) {
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BroadcastChannelCreation) GetId() string {
+func (x *User) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *BroadcastChannelCreation) GetServerId() string {
+func (x *User) GetEmail() string {
 	if x != nil {
-		return x.ServerId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *BroadcastChannelCreation) GetName() string {
+func (x *User) GetUsername() string {
 	if x != nil {
-		return x.Name
+		return x.Username
 	}
 	return ""
 }
 
-func (x *BroadcastChannelCreation) GetType() string {
+func (x *User) GetDisplayName() string {
 	if x != nil {
-		return x.Type
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *BroadcastChannelCreation) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *User) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
 	}
 	return ""
 }
 
-func (x *BroadcastChannelCreation) GetUsers() []string {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-func (x *BroadcastChannelCreation) GetRoles() []string {
-	if x != nil {
-		return x.Roles
+func (x *User) GetBanner() string {
+	if x != nil && x.Banner != nil {
+		return *x.Banner
 	}
-	return nil
+	return ""
 }
 
-func (x *BroadcastChannelCreation) GetX() int32 {
-	if x != nil {
-		return x.X
+func (x *User) GetMainColor() string {
+	if x != nil && x.MainColor != nil {
+		return *x.MainColor
 	}
-	return 0
+	return ""
 }
 
-func (x *BroadcastChannelCreation) GetY() int32 {
+func (x *User) GetAbout() []byte {
 	if x != nil {
-		return x.Y
+		return x.About
 	}
-	return 0
+	return nil
 }
 
-func (x *BroadcastChannelCreation) GetCreatedAt() *timestamppb.Timestamp {
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *BroadcastChannelCreation) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *User) GetLinks() []byte {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Links
 	}
 	return nil
 }
 
-func (x *BroadcastChannelCreation) GetActorId() string {
-	if x != nil {
-		return x.ActorId
-	}
-	return ""
-}
-
-func (x *BroadcastChannelCreation) GetActorAddress() string {
+func (x *User) GetFacts() []byte {
 	if x != nil {
-		return x.ActorAddress
+		return x.Facts
 	}
-	return ""
+	return nil
 }
 
-type ChannelStarting struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	ActorAddress  string                 `protobuf:"bytes,2,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type IncomingChatMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId         string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ServerId         string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId        string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Content          []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Everyone         bool                   `protobuf:"varint,5,opt,name=everyone,proto3" json:"everyone,omitempty"`
+	MentionsUsers    []string               `protobuf:"bytes,6,rep,name=mentions_users,json=mentionsUsers,proto3" json:"mentions_users,omitempty"`
+	MentionsChannels []string               `protobuf:"bytes,7,rep,name=mentions_channels,json=mentionsChannels,proto3" json:"mentions_channels,omitempty"`
+	Attachments      []byte                 `protobuf:"bytes,8,opt,name=attachments,proto3" json:"attachments,omitempty"`
+	ReplyTo          string                 `protobuf:"bytes,9,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	ThreadId         string                 `protobuf:"bytes,10,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	RequestId        string                 `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MentionsRoles    []string               `protobuf:"bytes,12,rep,name=mentions_roles,json=mentionsRoles,proto3" json:"mentions_roles,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ChannelStarting) Reset() {
-	*x = ChannelStarting{}
+func (x *IncomingChatMessage) Reset() {
+	*x = IncomingChatMessage{}
 	mi := &file_types_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChannelStarting) String() string {
+func (x *IncomingChatMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStarting) ProtoMessage() {}
+func (*IncomingChatMessage) ProtoMessage() {}
 
-func (x *ChannelStarting) ProtoReflect() protoreflect.Message {
+func (x *IncomingChatMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_types_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1565,117 +1781,126 @@ func (x *ChannelStarting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelStarting.ProtoReflect.Descriptor instead.
-func (*ChannelStarting) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncomingChatMessage.ProtoReflect.Descriptor instead.
+func (*IncomingChatMessage) Descriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ChannelStarting) GetActorId() string {
+func (x *IncomingChatMessage) GetAuthorId() string {
 	if x != nil {
-		return x.ActorId
+		return x.AuthorId
 	}
 	return ""
 }
 
-func (x *ChannelStarting) GetActorAddress() string {
+func (x *IncomingChatMessage) GetServerId() string {
 	if x != nil {
-		return x.ActorAddress
+		return x.ServerId
 	}
 	return ""
 }
 
-type BroadcastConnect struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Users         []string               `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *IncomingChatMessage) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
 }
 
-func (x *BroadcastConnect) Reset() {
-	*x = BroadcastConnect{}
-	mi := &file_types_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *IncomingChatMessage) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
 }
 
-func (x *BroadcastConnect) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IncomingChatMessage) GetEveryone() bool {
+	if x != nil {
+		return x.Everyone
+	}
+	return false
 }
 
-func (*BroadcastConnect) ProtoMessage() {}
+func (x *IncomingChatMessage) GetMentionsUsers() []string {
+	if x != nil {
+		return x.MentionsUsers
+	}
+	return nil
+}
 
-func (x *BroadcastConnect) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[15]
+func (x *IncomingChatMessage) GetMentionsChannels() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MentionsChannels
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use BroadcastConnect.ProtoReflect.Descriptor instead.
-func (*BroadcastConnect) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{15}
+func (x *IncomingChatMessage) GetAttachments() []byte {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
 }
 
-func (x *BroadcastConnect) GetServerId() string {
+func (x *IncomingChatMessage) GetReplyTo() string {
 	if x != nil {
-		return x.ServerId
+		return x.ReplyTo
 	}
 	return ""
 }
 
-func (x *BroadcastConnect) GetUserId() string {
+func (x *IncomingChatMessage) GetThreadId() string {
 	if x != nil {
-		return x.UserId
+		return x.ThreadId
 	}
 	return ""
 }
 
-func (x *BroadcastConnect) GetUsers() []string {
+func (x *IncomingChatMessage) GetRequestId() string {
 	if x != nil {
-		return x.Users
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (x *BroadcastConnect) GetType() string {
+func (x *IncomingChatMessage) GetMentionsRoles() []string {
 	if x != nil {
-		return x.Type
+		return x.MentionsRoles
 	}
-	return ""
+	return nil
 }
 
-type BroadcastDisconnect struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EditChatMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId         string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId        string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId        string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Content          []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Everyone         bool                   `protobuf:"varint,6,opt,name=everyone,proto3" json:"everyone,omitempty"`
+	MentionsUsers    []string               `protobuf:"bytes,7,rep,name=mentions_users,json=mentionsUsers,proto3" json:"mentions_users,omitempty"`
+	MentionsChannels []string               `protobuf:"bytes,8,rep,name=mentions_channels,json=mentionsChannels,proto3" json:"mentions_channels,omitempty"`
+	RequestId        string                 `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MentionsRoles    []string               `protobuf:"bytes,10,rep,name=mentions_roles,json=mentionsRoles,proto3" json:"mentions_roles,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *BroadcastDisconnect) Reset() {
-	*x = BroadcastDisconnect{}
-	mi := &file_types_proto_msgTypes[16]
+func (x *EditChatMessage) Reset() {
+	*x = EditChatMessage{}
+	mi := &file_types_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastDisconnect) String() string {
+func (x *EditChatMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastDisconnect) ProtoMessage() {}
+func (*EditChatMessage) ProtoMessage() {}
 
-func (x *BroadcastDisconnect) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[16]
+func (x *EditChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1686,172 +1911,107 @@ func (x *BroadcastDisconnect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastDisconnect.ProtoReflect.Descriptor instead.
-func (*BroadcastDisconnect) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *BroadcastDisconnect) GetServerId() string {
-	if x != nil {
-		return x.ServerId
-	}
-	return ""
+// Deprecated: Use EditChatMessage.ProtoReflect.Descriptor instead.
+func (*EditChatMessage) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *BroadcastDisconnect) GetUserId() string {
+func (x *EditChatMessage) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *BroadcastDisconnect) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-type BodyChannelCreation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	CreatorId     string                 `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Users         []string               `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty"`
-	Roles         []string               `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
-	X             int32                  `protobuf:"varint,8,opt,name=x,proto3" json:"x,omitempty"`
-	Y             int32                  `protobuf:"varint,9,opt,name=y,proto3" json:"y,omitempty"`
-	Id            string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BodyChannelCreation) Reset() {
-	*x = BodyChannelCreation{}
-	mi := &file_types_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *BodyChannelCreation) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BodyChannelCreation) ProtoMessage() {}
-
-func (x *BodyChannelCreation) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[17]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BodyChannelCreation.ProtoReflect.Descriptor instead.
-func (*BodyChannelCreation) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *BodyChannelCreation) GetServerId() string {
+func (x *EditChatMessage) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *BodyChannelCreation) GetCreatorId() string {
+func (x *EditChatMessage) GetChannelId() string {
 	if x != nil {
-		return x.CreatorId
+		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *BodyChannelCreation) GetName() string {
+func (x *EditChatMessage) GetMessageId() string {
 	if x != nil {
-		return x.Name
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *BodyChannelCreation) GetType() string {
+func (x *EditChatMessage) GetContent() []byte {
 	if x != nil {
-		return x.Type
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *BodyChannelCreation) GetDescription() string {
+func (x *EditChatMessage) GetEveryone() bool {
 	if x != nil {
-		return x.Description
+		return x.Everyone
 	}
-	return ""
+	return false
 }
 
-func (x *BodyChannelCreation) GetUsers() []string {
+func (x *EditChatMessage) GetMentionsUsers() []string {
 	if x != nil {
-		return x.Users
+		return x.MentionsUsers
 	}
 	return nil
 }
 
-func (x *BodyChannelCreation) GetRoles() []string {
+func (x *EditChatMessage) GetMentionsChannels() []string {
 	if x != nil {
-		return x.Roles
+		return x.MentionsChannels
 	}
 	return nil
 }
 
-func (x *BodyChannelCreation) GetX() int32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *BodyChannelCreation) GetY() int32 {
+func (x *EditChatMessage) GetRequestId() string {
 	if x != nil {
-		return x.Y
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-func (x *BodyChannelCreation) GetId() string {
+func (x *EditChatMessage) GetMentionsRoles() []string {
 	if x != nil {
-		return x.Id
+		return x.MentionsRoles
 	}
-	return ""
+	return nil
 }
 
-type StartChannel struct {
+type DeleteChatMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Users         []string               `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartChannel) Reset() {
-	*x = StartChannel{}
-	mi := &file_types_proto_msgTypes[18]
+func (x *DeleteChatMessage) Reset() {
+	*x = DeleteChatMessage{}
+	mi := &file_types_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartChannel) String() string {
+func (x *DeleteChatMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartChannel) ProtoMessage() {}
+func (*DeleteChatMessage) ProtoMessage() {}
 
-func (x *StartChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[18]
+func (x *DeleteChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1862,58 +2022,84 @@ func (x *StartChannel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartChannel.ProtoReflect.Descriptor instead.
-func (*StartChannel) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteChatMessage.ProtoReflect.Descriptor instead.
+func (*DeleteChatMessage) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *StartChannel) GetServerId() string {
+func (x *DeleteChatMessage) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteChatMessage) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *StartChannel) GetChannelId() string {
+func (x *DeleteChatMessage) GetChannelId() string {
 	if x != nil {
 		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *StartChannel) GetUsers() []string {
+func (x *DeleteChatMessage) GetMessageId() string {
 	if x != nil {
-		return x.Users
+		return x.MessageId
 	}
-	return nil
+	return ""
 }
 
-type KillChannel struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Users         []string               `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
-	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	ActorAddress  string                 `protobuf:"bytes,5,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+func (x *DeleteChatMessage) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type BroadcastChatMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId         string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ServerId         string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId        string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Content          []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Everyone         bool                   `protobuf:"varint,6,opt,name=everyone,proto3" json:"everyone,omitempty"`
+	MentionsUsers    []string               `protobuf:"bytes,7,rep,name=mentions_users,json=mentionsUsers,proto3" json:"mentions_users,omitempty"`
+	MentionsChannels []string               `protobuf:"bytes,8,rep,name=mentions_channels,json=mentionsChannels,proto3" json:"mentions_channels,omitempty"`
+	Attachments      []byte                 `protobuf:"bytes,9,opt,name=attachments,proto3" json:"attachments,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReplyTo          *MessageReference      `protobuf:"bytes,11,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	ThreadId         string                 `protobuf:"bytes,12,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	MentionsRoles    []string               `protobuf:"bytes,13,rep,name=mentions_roles,json=mentionsRoles,proto3" json:"mentions_roles,omitempty"`
+	// unread and mention tell the receiver whether the message counts toward
+	// their badges of the channel, their notification settings applied.
+	Unread        bool `protobuf:"varint,14,opt,name=unread,proto3" json:"unread,omitempty"`
+	Mention       bool `protobuf:"varint,15,opt,name=mention,proto3" json:"mention,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KillChannel) Reset() {
-	*x = KillChannel{}
-	mi := &file_types_proto_msgTypes[19]
+func (x *BroadcastChatMessage) Reset() {
+	*x = BroadcastChatMessage{}
+	mi := &file_types_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KillChannel) String() string {
+func (x *BroadcastChatMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KillChannel) ProtoMessage() {}
+func (*BroadcastChatMessage) ProtoMessage() {}
 
-func (x *KillChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[19]
+func (x *BroadcastChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,129 +2110,141 @@ func (x *KillChannel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KillChannel.ProtoReflect.Descriptor instead.
-func (*KillChannel) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use BroadcastChatMessage.ProtoReflect.Descriptor instead.
+func (*BroadcastChatMessage) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *KillChannel) GetServerId() string {
+func (x *BroadcastChatMessage) GetId() string {
 	if x != nil {
-		return x.ServerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *KillChannel) GetChannelId() string {
+func (x *BroadcastChatMessage) GetAuthorId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.AuthorId
 	}
 	return ""
 }
 
-func (x *KillChannel) GetUsers() []string {
+func (x *BroadcastChatMessage) GetServerId() string {
 	if x != nil {
-		return x.Users
+		return x.ServerId
 	}
-	return nil
+	return ""
 }
 
-func (x *KillChannel) GetActorId() string {
+func (x *BroadcastChatMessage) GetChannelId() string {
 	if x != nil {
-		return x.ActorId
+		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *KillChannel) GetActorAddress() string {
+func (x *BroadcastChatMessage) GetContent() []byte {
 	if x != nil {
-		return x.ActorAddress
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-type BodyChannelRemoved struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BroadcastChatMessage) GetEveryone() bool {
+	if x != nil {
+		return x.Everyone
+	}
+	return false
 }
 
-func (x *BodyChannelRemoved) Reset() {
-	*x = BodyChannelRemoved{}
-	mi := &file_types_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BroadcastChatMessage) GetMentionsUsers() []string {
+	if x != nil {
+		return x.MentionsUsers
+	}
+	return nil
 }
 
-func (x *BodyChannelRemoved) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BroadcastChatMessage) GetMentionsChannels() []string {
+	if x != nil {
+		return x.MentionsChannels
+	}
+	return nil
 }
 
-func (*BodyChannelRemoved) ProtoMessage() {}
+func (x *BroadcastChatMessage) GetAttachments() []byte {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
 
-func (x *BodyChannelRemoved) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[20]
+func (x *BroadcastChatMessage) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use BodyChannelRemoved.ProtoReflect.Descriptor instead.
-func (*BodyChannelRemoved) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{20}
+func (x *BroadcastChatMessage) GetReplyTo() *MessageReference {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return nil
 }
 
-func (x *BodyChannelRemoved) GetServerId() string {
+func (x *BroadcastChatMessage) GetThreadId() string {
 	if x != nil {
-		return x.ServerId
+		return x.ThreadId
 	}
 	return ""
 }
 
-func (x *BodyChannelRemoved) GetChannelId() string {
+func (x *BroadcastChatMessage) GetMentionsRoles() []string {
 	if x != nil {
-		return x.ChannelId
+		return x.MentionsRoles
 	}
-	return ""
+	return nil
 }
 
-func (x *BodyChannelRemoved) GetUserId() string {
+func (x *BroadcastChatMessage) GetUnread() bool {
 	if x != nil {
-		return x.UserId
+		return x.Unread
 	}
-	return ""
+	return false
 }
 
-type BodyServerRemoved struct {
+func (x *BroadcastChatMessage) GetMention() bool {
+	if x != nil {
+		return x.Mention
+	}
+	return false
+}
+
+type MessageReference struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BodyServerRemoved) Reset() {
-	*x = BodyServerRemoved{}
-	mi := &file_types_proto_msgTypes[21]
+func (x *MessageReference) Reset() {
+	*x = MessageReference{}
+	mi := &file_types_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BodyServerRemoved) String() string {
+func (x *MessageReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BodyServerRemoved) ProtoMessage() {}
+func (*MessageReference) ProtoMessage() {}
 
-func (x *BodyServerRemoved) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[21]
+func (x *MessageReference) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,48 +2255,66 @@ func (x *BodyServerRemoved) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BodyServerRemoved.ProtoReflect.Descriptor instead.
-func (*BodyServerRemoved) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MessageReference.ProtoReflect.Descriptor instead.
+func (*MessageReference) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *BodyServerRemoved) GetServerId() string {
+func (x *MessageReference) GetId() string {
 	if x != nil {
-		return x.ServerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BodyServerRemoved) GetUserId() string {
+func (x *MessageReference) GetAuthorId() string {
 	if x != nil {
-		return x.UserId
+		return x.AuthorId
 	}
 	return ""
 }
 
-type BodyNewUserInServer struct {
+func (x *MessageReference) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *MessageReference) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type StartThread struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BodyNewUserInServer) Reset() {
-	*x = BodyNewUserInServer{}
-	mi := &file_types_proto_msgTypes[22]
+func (x *StartThread) Reset() {
+	*x = StartThread{}
+	mi := &file_types_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BodyNewUserInServer) String() string {
+func (x *StartThread) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BodyNewUserInServer) ProtoMessage() {}
+func (*StartThread) ProtoMessage() {}
 
-func (x *BodyNewUserInServer) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[22]
+func (x *StartThread) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,48 +2325,82 @@ func (x *BodyNewUserInServer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BodyNewUserInServer.ProtoReflect.Descriptor instead.
-func (*BodyNewUserInServer) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use StartThread.ProtoReflect.Descriptor instead.
+func (*StartThread) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *BodyNewUserInServer) GetServerId() string {
+func (x *StartThread) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StartThread) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *BodyNewUserInServer) GetUser() *User {
+func (x *StartThread) GetChannelId() string {
 	if x != nil {
-		return x.User
+		return x.ChannelId
 	}
-	return nil
+	return ""
 }
 
-type NewServerCreated struct {
+func (x *StartThread) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *StartThread) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartThread) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type BroadcastThreadCreation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	ActorAddress  string                 `protobuf:"bytes,2,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	CreatorId     string                 `protobuf:"bytes,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActorId       string                 `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorAddress  string                 `protobuf:"bytes,8,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NewServerCreated) Reset() {
-	*x = NewServerCreated{}
-	mi := &file_types_proto_msgTypes[23]
+func (x *BroadcastThreadCreation) Reset() {
+	*x = BroadcastThreadCreation{}
+	mi := &file_types_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NewServerCreated) String() string {
+func (x *BroadcastThreadCreation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewServerCreated) ProtoMessage() {}
+func (*BroadcastThreadCreation) ProtoMessage() {}
 
-func (x *NewServerCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[23]
+func (x *BroadcastThreadCreation) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2161,100 +2411,97 @@ func (x *NewServerCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewServerCreated.ProtoReflect.Descriptor instead.
-func (*NewServerCreated) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use BroadcastThreadCreation.ProtoReflect.Descriptor instead.
+func (*BroadcastThreadCreation) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *NewServerCreated) GetActorId() string {
+func (x *BroadcastThreadCreation) GetId() string {
 	if x != nil {
-		return x.ActorId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *NewServerCreated) GetActorAddress() string {
+func (x *BroadcastThreadCreation) GetServerId() string {
 	if x != nil {
-		return x.ActorAddress
+		return x.ServerId
 	}
 	return ""
 }
 
-type BroadcastAcceptFriend struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FriendId      string                 `protobuf:"bytes,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BroadcastAcceptFriend) Reset() {
-	*x = BroadcastAcceptFriend{}
-	mi := &file_types_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BroadcastThreadCreation) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
 }
 
-func (x *BroadcastAcceptFriend) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BroadcastThreadCreation) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
 }
 
-func (*BroadcastAcceptFriend) ProtoMessage() {}
-
-func (x *BroadcastAcceptFriend) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[24]
+func (x *BroadcastThreadCreation) GetName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Name
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use BroadcastAcceptFriend.ProtoReflect.Descriptor instead.
-func (*BroadcastAcceptFriend) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{24}
+func (x *BroadcastThreadCreation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *BroadcastAcceptFriend) GetUserId() string {
+func (x *BroadcastThreadCreation) GetActorId() string {
 	if x != nil {
-		return x.UserId
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *BroadcastAcceptFriend) GetFriendId() string {
+func (x *BroadcastThreadCreation) GetActorAddress() string {
 	if x != nil {
-		return x.FriendId
+		return x.ActorAddress
 	}
 	return ""
 }
 
-type SendFriendInvite struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BroadcastEditMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MessageId        string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ServerId         string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId        string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Content          []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Everyone         bool                   `protobuf:"varint,5,opt,name=everyone,proto3" json:"everyone,omitempty"`
+	MentionsUsers    []string               `protobuf:"bytes,6,rep,name=mentions_users,json=mentionsUsers,proto3" json:"mentions_users,omitempty"`
+	MentionsChannels []string               `protobuf:"bytes,7,rep,name=mentions_channels,json=mentionsChannels,proto3" json:"mentions_channels,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	MentionsRoles    []string               `protobuf:"bytes,9,rep,name=mentions_roles,json=mentionsRoles,proto3" json:"mentions_roles,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SendFriendInvite) Reset() {
-	*x = SendFriendInvite{}
-	mi := &file_types_proto_msgTypes[25]
+func (x *BroadcastEditMessage) Reset() {
+	*x = BroadcastEditMessage{}
+	mi := &file_types_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendFriendInvite) String() string {
+func (x *BroadcastEditMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendFriendInvite) ProtoMessage() {}
+func (*BroadcastEditMessage) ProtoMessage() {}
 
-func (x *SendFriendInvite) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[25]
+func (x *BroadcastEditMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2265,116 +2512,98 @@ func (x *SendFriendInvite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendFriendInvite.ProtoReflect.Descriptor instead.
-func (*SendFriendInvite) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use BroadcastEditMessage.ProtoReflect.Descriptor instead.
+func (*BroadcastEditMessage) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SendFriendInvite) GetInviteId() string {
+func (x *BroadcastEditMessage) GetMessageId() string {
 	if x != nil {
-		return x.InviteId
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *SendFriendInvite) GetUser() *User {
+func (x *BroadcastEditMessage) GetServerId() string {
 	if x != nil {
-		return x.User
+		return x.ServerId
 	}
-	return nil
+	return ""
 }
 
-type AcceptFriendInvite struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Sender        bool                   `protobuf:"varint,4,opt,name=sender,proto3" json:"sender,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AcceptFriendInvite) Reset() {
-	*x = AcceptFriendInvite{}
-	mi := &file_types_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AcceptFriendInvite) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BroadcastEditMessage) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
 }
 
-func (*AcceptFriendInvite) ProtoMessage() {}
-
-func (x *AcceptFriendInvite) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[26]
+func (x *BroadcastEditMessage) GetContent() []byte {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Content
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use AcceptFriendInvite.ProtoReflect.Descriptor instead.
-func (*AcceptFriendInvite) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{26}
+func (x *BroadcastEditMessage) GetEveryone() bool {
+	if x != nil {
+		return x.Everyone
+	}
+	return false
 }
 
-func (x *AcceptFriendInvite) GetInviteId() string {
+func (x *BroadcastEditMessage) GetMentionsUsers() []string {
 	if x != nil {
-		return x.InviteId
+		return x.MentionsUsers
 	}
-	return ""
+	return nil
 }
 
-func (x *AcceptFriendInvite) GetChannelId() string {
+func (x *BroadcastEditMessage) GetMentionsChannels() []string {
 	if x != nil {
-		return x.ChannelId
+		return x.MentionsChannels
 	}
-	return ""
+	return nil
 }
 
-func (x *AcceptFriendInvite) GetUser() *User {
+func (x *BroadcastEditMessage) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.User
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *AcceptFriendInvite) GetSender() bool {
+func (x *BroadcastEditMessage) GetMentionsRoles() []string {
 	if x != nil {
-		return x.Sender
+		return x.MentionsRoles
 	}
-	return false
+	return nil
 }
 
-type DeleteFriend struct {
+type BroadcastDeleteChatMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteFriend) Reset() {
-	*x = DeleteFriend{}
-	mi := &file_types_proto_msgTypes[27]
+func (x *BroadcastDeleteChatMessage) Reset() {
+	*x = BroadcastDeleteChatMessage{}
+	mi := &file_types_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFriend) String() string {
+func (x *BroadcastDeleteChatMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFriend) ProtoMessage() {}
+func (*BroadcastDeleteChatMessage) ProtoMessage() {}
 
-func (x *DeleteFriend) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[27]
+func (x *BroadcastDeleteChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2385,47 +2614,60 @@ func (x *DeleteFriend) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFriend.ProtoReflect.Descriptor instead.
-func (*DeleteFriend) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use BroadcastDeleteChatMessage.ProtoReflect.Descriptor instead.
+func (*BroadcastDeleteChatMessage) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteFriend) GetInviteId() string {
+func (x *BroadcastDeleteChatMessage) GetMessageId() string {
 	if x != nil {
-		return x.InviteId
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *DeleteFriend) GetUserId() string {
+func (x *BroadcastDeleteChatMessage) GetServerId() string {
 	if x != nil {
-		return x.UserId
+		return x.ServerId
 	}
 	return ""
 }
 
-type Connect struct {
+func (x *BroadcastDeleteChatMessage) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type AddReaction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,5,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	EmojiId       string                 `protobuf:"bytes,6,opt,name=emoji_id,json=emojiId,proto3" json:"emoji_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Connect) Reset() {
-	*x = Connect{}
-	mi := &file_types_proto_msgTypes[28]
+func (x *AddReaction) Reset() {
+	*x = AddReaction{}
+	mi := &file_types_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Connect) String() string {
+func (x *AddReaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Connect) ProtoMessage() {}
+func (*AddReaction) ProtoMessage() {}
 
-func (x *Connect) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[28]
+func (x *AddReaction) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,100 +2678,88 @@ func (x *Connect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Connect.ProtoReflect.Descriptor instead.
-func (*Connect) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use AddReaction.ProtoReflect.Descriptor instead.
+func (*AddReaction) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Connect) GetType() string {
+func (x *AddReaction) GetUserId() string {
 	if x != nil {
-		return x.Type
+		return x.UserId
 	}
 	return ""
 }
 
-type ConnectToCall struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ConnectToCall) Reset() {
-	*x = ConnectToCall{}
-	mi := &file_types_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ConnectToCall) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AddReaction) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
 }
 
-func (*ConnectToCall) ProtoMessage() {}
-
-func (x *ConnectToCall) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[29]
+func (x *AddReaction) GetChannelId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChannelId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ConnectToCall.ProtoReflect.Descriptor instead.
-func (*ConnectToCall) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{29}
+func (x *AddReaction) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
 }
 
-func (x *ConnectToCall) GetUserId() string {
+func (x *AddReaction) GetEmoji() string {
 	if x != nil {
-		return x.UserId
+		return x.Emoji
 	}
 	return ""
 }
 
-func (x *ConnectToCall) GetServerId() string {
+func (x *AddReaction) GetEmojiId() string {
 	if x != nil {
-		return x.ServerId
+		return x.EmojiId
 	}
 	return ""
 }
 
-func (x *ConnectToCall) GetChannelId() string {
+func (x *AddReaction) GetRequestId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.RequestId
 	}
 	return ""
 }
 
-type CallInitialization struct {
+type RemoveReaction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CallUsers     []*ConnectToCall       `protobuf:"bytes,1,rep,name=call_users,json=callUsers,proto3" json:"call_users,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,5,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	EmojiId       string                 `protobuf:"bytes,6,opt,name=emoji_id,json=emojiId,proto3" json:"emoji_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CallInitialization) Reset() {
-	*x = CallInitialization{}
-	mi := &file_types_proto_msgTypes[30]
+func (x *RemoveReaction) Reset() {
+	*x = RemoveReaction{}
+	mi := &file_types_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CallInitialization) String() string {
+func (x *RemoveReaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallInitialization) ProtoMessage() {}
+func (*RemoveReaction) ProtoMessage() {}
 
-func (x *CallInitialization) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[30]
+func (x *RemoveReaction) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2540,86 +2770,88 @@ func (x *CallInitialization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallInitialization.ProtoReflect.Descriptor instead.
-func (*CallInitialization) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use RemoveReaction.ProtoReflect.Descriptor instead.
+func (*RemoveReaction) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CallInitialization) GetCallUsers() []*ConnectToCall {
+func (x *RemoveReaction) GetUserId() string {
 	if x != nil {
-		return x.CallUsers
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type Disconnect struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RemoveReaction) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
 }
 
-func (x *Disconnect) Reset() {
-	*x = Disconnect{}
-	mi := &file_types_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RemoveReaction) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
 }
 
-func (x *Disconnect) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RemoveReaction) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
 }
 
-func (*Disconnect) ProtoMessage() {}
-
-func (x *Disconnect) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[31]
+func (x *RemoveReaction) GetEmoji() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Emoji
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Disconnect.ProtoReflect.Descriptor instead.
-func (*Disconnect) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{31}
+func (x *RemoveReaction) GetEmojiId() string {
+	if x != nil {
+		return x.EmojiId
+	}
+	return ""
 }
 
-func (x *Disconnect) GetType() string {
+func (x *RemoveReaction) GetRequestId() string {
 	if x != nil {
-		return x.Type
+		return x.RequestId
 	}
 	return ""
 }
 
-type DisconnectFromCall struct {
+type BroadcastReactionAdded struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
 	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,5,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	EmojiId       string                 `protobuf:"bytes,6,opt,name=emoji_id,json=emojiId,proto3" json:"emoji_id,omitempty"`
+	EmojiUrl      string                 `protobuf:"bytes,7,opt,name=emoji_url,json=emojiUrl,proto3" json:"emoji_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectFromCall) Reset() {
-	*x = DisconnectFromCall{}
-	mi := &file_types_proto_msgTypes[32]
+func (x *BroadcastReactionAdded) Reset() {
+	*x = BroadcastReactionAdded{}
+	mi := &file_types_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectFromCall) String() string {
+func (x *BroadcastReactionAdded) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectFromCall) ProtoMessage() {}
+func (*BroadcastReactionAdded) ProtoMessage() {}
 
-func (x *DisconnectFromCall) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[32]
+func (x *BroadcastReactionAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,57 +2862,87 @@ func (x *DisconnectFromCall) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectFromCall.ProtoReflect.Descriptor instead.
-func (*DisconnectFromCall) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use BroadcastReactionAdded.ProtoReflect.Descriptor instead.
+func (*BroadcastReactionAdded) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DisconnectFromCall) GetUserId() string {
+func (x *BroadcastReactionAdded) GetMessageId() string {
 	if x != nil {
-		return x.UserId
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *DisconnectFromCall) GetServerId() string {
+func (x *BroadcastReactionAdded) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *DisconnectFromCall) GetChannelId() string {
+func (x *BroadcastReactionAdded) GetChannelId() string {
 	if x != nil {
 		return x.ChannelId
 	}
 	return ""
 }
 
-type Mute struct {
+func (x *BroadcastReactionAdded) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BroadcastReactionAdded) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *BroadcastReactionAdded) GetEmojiId() string {
+	if x != nil {
+		return x.EmojiId
+	}
+	return ""
+}
+
+func (x *BroadcastReactionAdded) GetEmojiUrl() string {
+	if x != nil {
+		return x.EmojiUrl
+	}
+	return ""
+}
+
+type BroadcastReactionRemoved struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,5,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	EmojiId       string                 `protobuf:"bytes,6,opt,name=emoji_id,json=emojiId,proto3" json:"emoji_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Mute) Reset() {
-	*x = Mute{}
-	mi := &file_types_proto_msgTypes[33]
+func (x *BroadcastReactionRemoved) Reset() {
+	*x = BroadcastReactionRemoved{}
+	mi := &file_types_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Mute) String() string {
+func (x *BroadcastReactionRemoved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Mute) ProtoMessage() {}
+func (*BroadcastReactionRemoved) ProtoMessage() {}
 
-func (x *Mute) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[33]
+func (x *BroadcastReactionRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2691,64 +2953,144 @@ func (x *Mute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Mute.ProtoReflect.Descriptor instead.
-func (*Mute) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use BroadcastReactionRemoved.ProtoReflect.Descriptor instead.
+func (*BroadcastReactionRemoved) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Mute) GetUserId() string {
+func (x *BroadcastReactionRemoved) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *BroadcastReactionRemoved) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BroadcastReactionRemoved) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *BroadcastReactionRemoved) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *Mute) GetStatus() bool {
+func (x *BroadcastReactionRemoved) GetEmoji() string {
 	if x != nil {
-		return x.Status
+		return x.Emoji
 	}
-	return false
+	return ""
 }
 
-func (x *Mute) GetServerId() string {
+func (x *BroadcastReactionRemoved) GetEmojiId() string {
+	if x != nil {
+		return x.EmojiId
+	}
+	return ""
+}
+
+type BroadcastChannelRemoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorAddress  string                 `protobuf:"bytes,4,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastChannelRemoved) Reset() {
+	*x = BroadcastChannelRemoved{}
+	mi := &file_types_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastChannelRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastChannelRemoved) ProtoMessage() {}
+
+func (x *BroadcastChannelRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastChannelRemoved.ProtoReflect.Descriptor instead.
+func (*BroadcastChannelRemoved) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BroadcastChannelRemoved) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *Mute) GetChannelId() string {
+func (x *BroadcastChannelRemoved) GetChannelId() string {
 	if x != nil {
 		return x.ChannelId
 	}
 	return ""
 }
 
-type Deafen struct {
+func (x *BroadcastChannelRemoved) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *BroadcastChannelRemoved) GetActorAddress() string {
+	if x != nil {
+		return x.ActorAddress
+	}
+	return ""
+}
+
+type BroadcastNewUserInServer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Deafen) Reset() {
-	*x = Deafen{}
-	mi := &file_types_proto_msgTypes[34]
+func (x *BroadcastNewUserInServer) Reset() {
+	*x = BroadcastNewUserInServer{}
+	mi := &file_types_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Deafen) String() string {
+func (x *BroadcastNewUserInServer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Deafen) ProtoMessage() {}
+func (*BroadcastNewUserInServer) ProtoMessage() {}
 
-func (x *Deafen) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[34]
+func (x *BroadcastNewUserInServer) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2759,68 +3101,2261 @@ func (x *Deafen) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Deafen.ProtoReflect.Descriptor instead.
-func (*Deafen) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use BroadcastNewUserInServer.ProtoReflect.Descriptor instead.
+func (*BroadcastNewUserInServer) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Deafen) GetUserId() string {
+func (x *BroadcastNewUserInServer) GetServerId() string {
 	if x != nil {
-		return x.UserId
+		return x.ServerId
 	}
 	return ""
 }
 
-func (x *Deafen) GetStatus() bool {
+func (x *BroadcastNewUserInServer) GetUser() *User {
 	if x != nil {
-		return x.Status
+		return x.User
+	}
+	return nil
+}
+
+type BroadcastServerRemoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorAddress  string                 `protobuf:"bytes,3,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastServerRemoved) Reset() {
+	*x = BroadcastServerRemoved{}
+	mi := &file_types_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastServerRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastServerRemoved) ProtoMessage() {}
+
+func (x *BroadcastServerRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastServerRemoved.ProtoReflect.Descriptor instead.
+func (*BroadcastServerRemoved) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BroadcastServerRemoved) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BroadcastServerRemoved) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *BroadcastServerRemoved) GetActorAddress() string {
+	if x != nil {
+		return x.ActorAddress
+	}
+	return ""
+}
+
+type BroadcastChannelCreation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Users         []string               `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty"`
+	Roles         []string               `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
+	X             int32                  `protobuf:"varint,8,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,9,opt,name=y,proto3" json:"y,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ActorId       string                 `protobuf:"bytes,12,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorAddress  string                 `protobuf:"bytes,13,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	UserLimit     *int32                 `protobuf:"varint,14,opt,name=user_limit,json=userLimit,proto3,oneof" json:"user_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastChannelCreation) Reset() {
+	*x = BroadcastChannelCreation{}
+	mi := &file_types_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastChannelCreation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastChannelCreation) ProtoMessage() {}
+
+func (x *BroadcastChannelCreation) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastChannelCreation.ProtoReflect.Descriptor instead.
+func (*BroadcastChannelCreation) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BroadcastChannelCreation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BroadcastChannelCreation) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BroadcastChannelCreation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BroadcastChannelCreation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BroadcastChannelCreation) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *BroadcastChannelCreation) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BroadcastChannelCreation) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *BroadcastChannelCreation) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *BroadcastChannelCreation) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *BroadcastChannelCreation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BroadcastChannelCreation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *BroadcastChannelCreation) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *BroadcastChannelCreation) GetActorAddress() string {
+	if x != nil {
+		return x.ActorAddress
+	}
+	return ""
+}
+
+func (x *BroadcastChannelCreation) GetUserLimit() int32 {
+	if x != nil && x.UserLimit != nil {
+		return *x.UserLimit
+	}
+	return 0
+}
+
+type ChannelStarting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorAddress  string                 `protobuf:"bytes,2,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelStarting) Reset() {
+	*x = ChannelStarting{}
+	mi := &file_types_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelStarting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStarting) ProtoMessage() {}
+
+func (x *ChannelStarting) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStarting.ProtoReflect.Descriptor instead.
+func (*ChannelStarting) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChannelStarting) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ChannelStarting) GetActorAddress() string {
+	if x != nil {
+		return x.ActorAddress
+	}
+	return ""
+}
+
+type BroadcastConnect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Users         []string               `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Presences     []*Presence            `protobuf:"bytes,5,rep,name=presences,proto3" json:"presences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastConnect) Reset() {
+	*x = BroadcastConnect{}
+	mi := &file_types_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastConnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastConnect) ProtoMessage() {}
+
+func (x *BroadcastConnect) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastConnect.ProtoReflect.Descriptor instead.
+func (*BroadcastConnect) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BroadcastConnect) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BroadcastConnect) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BroadcastConnect) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BroadcastConnect) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BroadcastConnect) GetPresences() []*Presence {
+	if x != nil {
+		return x.Presences
+	}
+	return nil
+}
+
+type BroadcastDisconnect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastDisconnect) Reset() {
+	*x = BroadcastDisconnect{}
+	mi := &file_types_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastDisconnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastDisconnect) ProtoMessage() {}
+
+func (x *BroadcastDisconnect) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastDisconnect.ProtoReflect.Descriptor instead.
+func (*BroadcastDisconnect) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BroadcastDisconnect) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BroadcastDisconnect) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BroadcastDisconnect) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type BodyChannelCreation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	CreatorId     string                 `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Users         []string               `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty"`
+	Roles         []string               `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
+	X             int32                  `protobuf:"varint,8,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,9,opt,name=y,proto3" json:"y,omitempty"`
+	Id            string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	UserLimit     *int32                 `protobuf:"varint,11,opt,name=user_limit,json=userLimit,proto3,oneof" json:"user_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BodyChannelCreation) Reset() {
+	*x = BodyChannelCreation{}
+	mi := &file_types_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BodyChannelCreation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyChannelCreation) ProtoMessage() {}
+
+func (x *BodyChannelCreation) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyChannelCreation.ProtoReflect.Descriptor instead.
+func (*BodyChannelCreation) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BodyChannelCreation) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BodyChannelCreation) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *BodyChannelCreation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BodyChannelCreation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BodyChannelCreation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BodyChannelCreation) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BodyChannelCreation) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *BodyChannelCreation) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *BodyChannelCreation) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *BodyChannelCreation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BodyChannelCreation) GetUserLimit() int32 {
+	if x != nil && x.UserLimit != nil {
+		return *x.UserLimit
+	}
+	return 0
+}
+
+type StartChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Users         []string               `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartChannel) Reset() {
+	*x = StartChannel{}
+	mi := &file_types_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartChannel) ProtoMessage() {}
+
+func (x *StartChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartChannel.ProtoReflect.Descriptor instead.
+func (*StartChannel) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartChannel) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *StartChannel) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *StartChannel) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type KillChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Users         []string               `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorAddress  string                 `protobuf:"bytes,5,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillChannel) Reset() {
+	*x = KillChannel{}
+	mi := &file_types_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillChannel) ProtoMessage() {}
+
+func (x *KillChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillChannel.ProtoReflect.Descriptor instead.
+func (*KillChannel) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *KillChannel) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *KillChannel) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *KillChannel) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *KillChannel) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *KillChannel) GetActorAddress() string {
+	if x != nil {
+		return x.ActorAddress
+	}
+	return ""
+}
+
+type BodyChannelRemoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BodyChannelRemoved) Reset() {
+	*x = BodyChannelRemoved{}
+	mi := &file_types_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BodyChannelRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyChannelRemoved) ProtoMessage() {}
+
+func (x *BodyChannelRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyChannelRemoved.ProtoReflect.Descriptor instead.
+func (*BodyChannelRemoved) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BodyChannelRemoved) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BodyChannelRemoved) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *BodyChannelRemoved) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BodyServerRemoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BodyServerRemoved) Reset() {
+	*x = BodyServerRemoved{}
+	mi := &file_types_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BodyServerRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyServerRemoved) ProtoMessage() {}
+
+func (x *BodyServerRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyServerRemoved.ProtoReflect.Descriptor instead.
+func (*BodyServerRemoved) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BodyServerRemoved) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BodyServerRemoved) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BodyNewUserInServer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BodyNewUserInServer) Reset() {
+	*x = BodyNewUserInServer{}
+	mi := &file_types_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BodyNewUserInServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyNewUserInServer) ProtoMessage() {}
+
+func (x *BodyNewUserInServer) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyNewUserInServer.ProtoReflect.Descriptor instead.
+func (*BodyNewUserInServer) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BodyNewUserInServer) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BodyNewUserInServer) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type NewServerCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorAddress  string                 `protobuf:"bytes,2,opt,name=actor_address,json=actorAddress,proto3" json:"actor_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewServerCreated) Reset() {
+	*x = NewServerCreated{}
+	mi := &file_types_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewServerCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewServerCreated) ProtoMessage() {}
+
+func (x *NewServerCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewServerCreated.ProtoReflect.Descriptor instead.
+func (*NewServerCreated) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NewServerCreated) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *NewServerCreated) GetActorAddress() string {
+	if x != nil {
+		return x.ActorAddress
+	}
+	return ""
+}
+
+type BroadcastAcceptFriend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FriendId      string                 `protobuf:"bytes,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastAcceptFriend) Reset() {
+	*x = BroadcastAcceptFriend{}
+	mi := &file_types_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastAcceptFriend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastAcceptFriend) ProtoMessage() {}
+
+func (x *BroadcastAcceptFriend) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastAcceptFriend.ProtoReflect.Descriptor instead.
+func (*BroadcastAcceptFriend) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BroadcastAcceptFriend) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BroadcastAcceptFriend) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
+
+type SendFriendInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendInvite) Reset() {
+	*x = SendFriendInvite{}
+	mi := &file_types_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendInvite) ProtoMessage() {}
+
+func (x *SendFriendInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendInvite.ProtoReflect.Descriptor instead.
+func (*SendFriendInvite) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SendFriendInvite) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *SendFriendInvite) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AcceptFriendInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Sender        bool                   `protobuf:"varint,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFriendInvite) Reset() {
+	*x = AcceptFriendInvite{}
+	mi := &file_types_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFriendInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendInvite) ProtoMessage() {}
+
+func (x *AcceptFriendInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendInvite.ProtoReflect.Descriptor instead.
+func (*AcceptFriendInvite) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AcceptFriendInvite) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *AcceptFriendInvite) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *AcceptFriendInvite) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AcceptFriendInvite) GetSender() bool {
+	if x != nil {
+		return x.Sender
+	}
+	return false
+}
+
+type DeleteFriend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFriend) Reset() {
+	*x = DeleteFriend{}
+	mi := &file_types_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFriend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriend) ProtoMessage() {}
+
+func (x *DeleteFriend) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriend.ProtoReflect.Descriptor instead.
+func (*DeleteFriend) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteFriend) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *DeleteFriend) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type Connect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Presence      *Presence              `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Connect) Reset() {
+	*x = Connect{}
+	mi := &file_types_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Connect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connect) ProtoMessage() {}
+
+func (x *Connect) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connect.ProtoReflect.Descriptor instead.
+func (*Connect) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Connect) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Connect) GetPresence() *Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+type ConnectToCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Mute          bool                   `protobuf:"varint,4,opt,name=mute,proto3" json:"mute,omitempty"`
+	Deafen        bool                   `protobuf:"varint,5,opt,name=deafen,proto3" json:"deafen,omitempty"`
+	ServerMute    bool                   `protobuf:"varint,6,opt,name=server_mute,json=serverMute,proto3" json:"server_mute,omitempty"`
+	RequestId     string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Refresh       bool                   `protobuf:"varint,8,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	MovedFrom     string                 `protobuf:"bytes,9,opt,name=moved_from,json=movedFrom,proto3" json:"moved_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectToCall) Reset() {
+	*x = ConnectToCall{}
+	mi := &file_types_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectToCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectToCall) ProtoMessage() {}
+
+func (x *ConnectToCall) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectToCall.ProtoReflect.Descriptor instead.
+func (*ConnectToCall) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ConnectToCall) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConnectToCall) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ConnectToCall) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ConnectToCall) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+func (x *ConnectToCall) GetDeafen() bool {
+	if x != nil {
+		return x.Deafen
+	}
+	return false
+}
+
+func (x *ConnectToCall) GetServerMute() bool {
+	if x != nil {
+		return x.ServerMute
+	}
+	return false
+}
+
+func (x *ConnectToCall) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ConnectToCall) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+func (x *ConnectToCall) GetMovedFrom() string {
+	if x != nil {
+		return x.MovedFrom
+	}
+	return ""
+}
+
+type CallInitialization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallUsers     []*ConnectToCall       `protobuf:"bytes,1,rep,name=call_users,json=callUsers,proto3" json:"call_users,omitempty"`
+	Stage         *StageState            `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallInitialization) Reset() {
+	*x = CallInitialization{}
+	mi := &file_types_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallInitialization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallInitialization) ProtoMessage() {}
+
+func (x *CallInitialization) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallInitialization.ProtoReflect.Descriptor instead.
+func (*CallInitialization) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CallInitialization) GetCallUsers() []*ConnectToCall {
+	if x != nil {
+		return x.CallUsers
+	}
+	return nil
+}
+
+func (x *CallInitialization) GetStage() *StageState {
+	if x != nil {
+		return x.Stage
+	}
+	return nil
+}
+
+type StageState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Speakers      []string               `protobuf:"bytes,3,rep,name=speakers,proto3" json:"speakers,omitempty"`
+	RaisedHands   []string               `protobuf:"bytes,4,rep,name=raised_hands,json=raisedHands,proto3" json:"raised_hands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageState) Reset() {
+	*x = StageState{}
+	mi := &file_types_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageState) ProtoMessage() {}
+
+func (x *StageState) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageState.ProtoReflect.Descriptor instead.
+func (*StageState) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StageState) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *StageState) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *StageState) GetSpeakers() []string {
+	if x != nil {
+		return x.Speakers
+	}
+	return nil
+}
+
+func (x *StageState) GetRaisedHands() []string {
+	if x != nil {
+		return x.RaisedHands
+	}
+	return nil
+}
+
+type StageAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	TargetId      string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageAction) Reset() {
+	*x = StageAction{}
+	mi := &file_types_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageAction) ProtoMessage() {}
+
+func (x *StageAction) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageAction.ProtoReflect.Descriptor instead.
+func (*StageAction) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *StageAction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StageAction) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *StageAction) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *StageAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *StageAction) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *StageAction) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type Disconnect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Disconnect) Reset() {
+	*x = Disconnect{}
+	mi := &file_types_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Disconnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Disconnect) ProtoMessage() {}
+
+func (x *Disconnect) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Disconnect.ProtoReflect.Descriptor instead.
+func (*Disconnect) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Disconnect) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type DisconnectFromCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LeftAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=left_at,json=leftAt,proto3" json:"left_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectFromCall) Reset() {
+	*x = DisconnectFromCall{}
+	mi := &file_types_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectFromCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectFromCall) ProtoMessage() {}
+
+func (x *DisconnectFromCall) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectFromCall.ProtoReflect.Descriptor instead.
+func (*DisconnectFromCall) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DisconnectFromCall) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DisconnectFromCall) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DisconnectFromCall) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *DisconnectFromCall) GetLeftAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LeftAt
+	}
+	return nil
+}
+
+type CallEnded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallEnded) Reset() {
+	*x = CallEnded{}
+	mi := &file_types_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallEnded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallEnded) ProtoMessage() {}
+
+func (x *CallEnded) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallEnded.ProtoReflect.Descriptor instead.
+func (*CallEnded) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CallEnded) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CallEnded) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type Mute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Server        bool                   `protobuf:"varint,5,opt,name=server,proto3" json:"server,omitempty"`
+	ModeratorId   string                 `protobuf:"bytes,6,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mute) Reset() {
+	*x = Mute{}
+	mi := &file_types_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mute) ProtoMessage() {}
+
+func (x *Mute) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mute.ProtoReflect.Descriptor instead.
+func (*Mute) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Mute) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Mute) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *Mute) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Mute) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Mute) GetServer() bool {
+	if x != nil {
+		return x.Server
+	}
+	return false
+}
+
+func (x *Mute) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *Mute) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type MoveToCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	FromChannelId string                 `protobuf:"bytes,3,opt,name=from_channel_id,json=fromChannelId,proto3" json:"from_channel_id,omitempty"`
+	ToChannelId   string                 `protobuf:"bytes,4,opt,name=to_channel_id,json=toChannelId,proto3" json:"to_channel_id,omitempty"`
+	CallToken     string                 `protobuf:"bytes,5,opt,name=call_token,json=callToken,proto3" json:"call_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveToCall) Reset() {
+	*x = MoveToCall{}
+	mi := &file_types_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToCall) ProtoMessage() {}
+
+func (x *MoveToCall) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToCall.ProtoReflect.Descriptor instead.
+func (*MoveToCall) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MoveToCall) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveToCall) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *MoveToCall) GetFromChannelId() string {
+	if x != nil {
+		return x.FromChannelId
+	}
+	return ""
+}
+
+func (x *MoveToCall) GetToChannelId() string {
+	if x != nil {
+		return x.ToChannelId
+	}
+	return ""
+}
+
+func (x *MoveToCall) GetCallToken() string {
+	if x != nil {
+		return x.CallToken
+	}
+	return ""
+}
+
+type Deafen struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deafen) Reset() {
+	*x = Deafen{}
+	mi := &file_types_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deafen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deafen) ProtoMessage() {}
+
+func (x *Deafen) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deafen.ProtoReflect.Descriptor instead.
+func (*Deafen) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Deafen) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Deafen) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *Deafen) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Deafen) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Deafen) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type UserInformations struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      *string                `protobuf:"bytes,1,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	DisplayName   *string                `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	Avatar        *string                `protobuf:"bytes,3,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	Banner        *string                `protobuf:"bytes,4,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
+	Facts         []byte                 `protobuf:"bytes,5,opt,name=facts,proto3,oneof" json:"facts,omitempty"`
+	Links         []byte                 `protobuf:"bytes,6,opt,name=links,proto3,oneof" json:"links,omitempty"`
+	About         []byte                 `protobuf:"bytes,7,opt,name=about,proto3,oneof" json:"about,omitempty"`
+	MainColor     *string                `protobuf:"bytes,8,opt,name=main_color,json=mainColor,proto3,oneof" json:"main_color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserInformations) Reset() {
+	*x = UserInformations{}
+	mi := &file_types_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserInformations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInformations) ProtoMessage() {}
+
+func (x *UserInformations) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInformations.ProtoReflect.Descriptor instead.
+func (*UserInformations) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UserInformations) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *UserInformations) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserInformations) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
+	}
+	return ""
+}
+
+func (x *UserInformations) GetBanner() string {
+	if x != nil && x.Banner != nil {
+		return *x.Banner
+	}
+	return ""
+}
+
+func (x *UserInformations) GetFacts() []byte {
+	if x != nil {
+		return x.Facts
+	}
+	return nil
+}
+
+func (x *UserInformations) GetLinks() []byte {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *UserInformations) GetAbout() []byte {
+	if x != nil {
+		return x.About
+	}
+	return nil
+}
+
+func (x *UserInformations) GetMainColor() string {
+	if x != nil && x.MainColor != nil {
+		return *x.MainColor
+	}
+	return ""
+}
+
+type UserChangedInformations struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserInformations *UserInformations      `protobuf:"bytes,2,opt,name=user_informations,json=userInformations,proto3" json:"user_informations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserChangedInformations) Reset() {
+	*x = UserChangedInformations{}
+	mi := &file_types_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserChangedInformations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserChangedInformations) ProtoMessage() {}
+
+func (x *UserChangedInformations) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserChangedInformations.ProtoReflect.Descriptor instead.
+func (*UserChangedInformations) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UserChangedInformations) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserChangedInformations) GetUserInformations() *UserInformations {
+	if x != nil {
+		return x.UserInformations
+	}
+	return nil
+}
+
+type BroadcastUserInformations struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerId         string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserInformations *UserInformations      `protobuf:"bytes,3,opt,name=user_informations,json=userInformations,proto3" json:"user_informations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BroadcastUserInformations) Reset() {
+	*x = BroadcastUserInformations{}
+	mi := &file_types_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastUserInformations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastUserInformations) ProtoMessage() {}
+
+func (x *BroadcastUserInformations) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastUserInformations.ProtoReflect.Descriptor instead.
+func (*BroadcastUserInformations) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BroadcastUserInformations) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BroadcastUserInformations) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BroadcastUserInformations) GetUserInformations() *UserInformations {
+	if x != nil {
+		return x.UserInformations
+	}
+	return nil
+}
+
+type ServerInformations struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Avatar        *string                `protobuf:"bytes,2,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	Banner        *string                `protobuf:"bytes,3,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
+	Description   []byte                 `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	MainColor     *string                `protobuf:"bytes,5,opt,name=main_color,json=mainColor,proto3,oneof" json:"main_color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerInformations) Reset() {
+	*x = ServerInformations{}
+	mi := &file_types_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerInformations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInformations) ProtoMessage() {}
+
+func (x *ServerInformations) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInformations.ProtoReflect.Descriptor instead.
+func (*ServerInformations) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ServerInformations) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ServerInformations) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
+	}
+	return ""
+}
+
+func (x *ServerInformations) GetBanner() string {
+	if x != nil && x.Banner != nil {
+		return *x.Banner
+	}
+	return ""
+}
+
+func (x *ServerInformations) GetDescription() []byte {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *ServerInformations) GetMainColor() string {
+	if x != nil && x.MainColor != nil {
+		return *x.MainColor
+	}
+	return ""
+}
+
+type ServerChangedInformations struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ServerId           string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ServerInformations *ServerInformations    `protobuf:"bytes,2,opt,name=server_informations,json=serverInformations,proto3" json:"server_informations,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ServerChangedInformations) Reset() {
+	*x = ServerChangedInformations{}
+	mi := &file_types_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerChangedInformations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerChangedInformations) ProtoMessage() {}
+
+func (x *ServerChangedInformations) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Deafen) GetServerId() string {
+// Deprecated: Use ServerChangedInformations.ProtoReflect.Descriptor instead.
+func (*ServerChangedInformations) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ServerChangedInformations) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *Deafen) GetChannelId() string {
+func (x *ServerChangedInformations) GetServerInformations() *ServerInformations {
 	if x != nil {
-		return x.ChannelId
+		return x.ServerInformations
 	}
-	return ""
+	return nil
 }
 
-type UserInformations struct {
+type CreateRole struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      *string                `protobuf:"bytes,1,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	DisplayName   *string                `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
-	Avatar        *string                `protobuf:"bytes,3,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
-	Banner        *string                `protobuf:"bytes,4,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
-	Facts         []byte                 `protobuf:"bytes,5,opt,name=facts,proto3,oneof" json:"facts,omitempty"`
-	Links         []byte                 `protobuf:"bytes,6,opt,name=links,proto3,oneof" json:"links,omitempty"`
-	About         []byte                 `protobuf:"bytes,7,opt,name=about,proto3,oneof" json:"about,omitempty"`
-	MainColor     *string                `protobuf:"bytes,8,opt,name=main_color,json=mainColor,proto3,oneof" json:"main_color,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Idx           int32                  `protobuf:"varint,2,opt,name=idx,proto3" json:"idx,omitempty"`
+	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	Abilities     []string               `protobuf:"bytes,6,rep,name=abilities,proto3" json:"abilities,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,7,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserInformations) Reset() {
-	*x = UserInformations{}
-	mi := &file_types_proto_msgTypes[35]
+func (x *CreateRole) Reset() {
+	*x = CreateRole{}
+	mi := &file_types_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInformations) String() string {
+func (x *CreateRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInformations) ProtoMessage() {}
+func (*CreateRole) ProtoMessage() {}
 
-func (x *UserInformations) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[35]
+func (x *CreateRole) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2831,90 +5366,85 @@ func (x *UserInformations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInformations.ProtoReflect.Descriptor instead.
-func (*UserInformations) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateRole.ProtoReflect.Descriptor instead.
+func (*CreateRole) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *UserInformations) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
+func (x *CreateRole) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UserInformations) GetDisplayName() string {
-	if x != nil && x.DisplayName != nil {
-		return *x.DisplayName
+func (x *CreateRole) GetIdx() int32 {
+	if x != nil {
+		return x.Idx
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInformations) GetAvatar() string {
-	if x != nil && x.Avatar != nil {
-		return *x.Avatar
+func (x *CreateRole) GetServerId() string {
+	if x != nil {
+		return x.ServerId
 	}
 	return ""
 }
 
-func (x *UserInformations) GetBanner() string {
-	if x != nil && x.Banner != nil {
-		return *x.Banner
+func (x *CreateRole) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserInformations) GetFacts() []byte {
+func (x *CreateRole) GetColor() string {
 	if x != nil {
-		return x.Facts
+		return x.Color
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInformations) GetLinks() []byte {
+func (x *CreateRole) GetAbilities() []string {
 	if x != nil {
-		return x.Links
+		return x.Abilities
 	}
 	return nil
 }
 
-func (x *UserInformations) GetAbout() []byte {
+func (x *CreateRole) GetRequesterId() string {
 	if x != nil {
-		return x.About
-	}
-	return nil
-}
-
-func (x *UserInformations) GetMainColor() string {
-	if x != nil && x.MainColor != nil {
-		return *x.MainColor
+		return x.RequesterId
 	}
 	return ""
 }
 
-type UserChangedInformations struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserInformations *UserInformations      `protobuf:"bytes,2,opt,name=user_informations,json=userInformations,proto3" json:"user_informations,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type AddRoleMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserChangedInformations) Reset() {
-	*x = UserChangedInformations{}
-	mi := &file_types_proto_msgTypes[36]
+func (x *AddRoleMember) Reset() {
+	*x = AddRoleMember{}
+	mi := &file_types_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserChangedInformations) String() string {
+func (x *AddRoleMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserChangedInformations) ProtoMessage() {}
+func (*AddRoleMember) ProtoMessage() {}
 
-func (x *UserChangedInformations) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[36]
+func (x *AddRoleMember) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2925,49 +5455,64 @@ func (x *UserChangedInformations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserChangedInformations.ProtoReflect.Descriptor instead.
-func (*UserChangedInformations) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AddRoleMember.ProtoReflect.Descriptor instead.
+func (*AddRoleMember) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UserChangedInformations) GetUserId() string {
+func (x *AddRoleMember) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UserChangedInformations) GetUserInformations() *UserInformations {
+func (x *AddRoleMember) GetId() string {
 	if x != nil {
-		return x.UserInformations
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type BroadcastUserInformations struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ServerId         string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	UserInformations *UserInformations      `protobuf:"bytes,3,opt,name=user_informations,json=userInformations,proto3" json:"user_informations,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *AddRoleMember) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
 }
 
-func (x *BroadcastUserInformations) Reset() {
-	*x = BroadcastUserInformations{}
-	mi := &file_types_proto_msgTypes[37]
+func (x *AddRoleMember) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+type RemoveRoleMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRoleMember) Reset() {
+	*x = RemoveRoleMember{}
+	mi := &file_types_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastUserInformations) String() string {
+func (x *RemoveRoleMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastUserInformations) ProtoMessage() {}
+func (*RemoveRoleMember) ProtoMessage() {}
 
-func (x *BroadcastUserInformations) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[37]
+func (x *RemoveRoleMember) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2978,58 +5523,65 @@ func (x *BroadcastUserInformations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastUserInformations.ProtoReflect.Descriptor instead.
-func (*BroadcastUserInformations) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RemoveRoleMember.ProtoReflect.Descriptor instead.
+func (*RemoveRoleMember) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *BroadcastUserInformations) GetUserId() string {
+func (x *RemoveRoleMember) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *BroadcastUserInformations) GetServerId() string {
+func (x *RemoveRoleMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveRoleMember) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *BroadcastUserInformations) GetUserInformations() *UserInformations {
+func (x *RemoveRoleMember) GetRequesterId() string {
 	if x != nil {
-		return x.UserInformations
+		return x.RequesterId
 	}
-	return nil
+	return ""
 }
 
-type ServerInformations struct {
+type ChangeRoleRanking struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Avatar        *string                `protobuf:"bytes,2,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
-	Banner        *string                `protobuf:"bytes,3,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
-	Description   []byte                 `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	MainColor     *string                `protobuf:"bytes,5,opt,name=main_color,json=mainColor,proto3,oneof" json:"main_color,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	From          int32                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	ServerId      string                 `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,5,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServerInformations) Reset() {
-	*x = ServerInformations{}
-	mi := &file_types_proto_msgTypes[38]
+func (x *ChangeRoleRanking) Reset() {
+	*x = ChangeRoleRanking{}
+	mi := &file_types_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerInformations) String() string {
+func (x *ChangeRoleRanking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerInformations) ProtoMessage() {}
+func (*ChangeRoleRanking) ProtoMessage() {}
 
-func (x *ServerInformations) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[38]
+func (x *ChangeRoleRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3040,69 +5592,69 @@ func (x *ServerInformations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerInformations.ProtoReflect.Descriptor instead.
-func (*ServerInformations) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *ServerInformations) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// Deprecated: Use ChangeRoleRanking.ProtoReflect.Descriptor instead.
+func (*ChangeRoleRanking) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ServerInformations) GetAvatar() string {
-	if x != nil && x.Avatar != nil {
-		return *x.Avatar
+func (x *ChangeRoleRanking) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ServerInformations) GetBanner() string {
-	if x != nil && x.Banner != nil {
-		return *x.Banner
+func (x *ChangeRoleRanking) GetFrom() int32 {
+	if x != nil {
+		return x.From
 	}
-	return ""
+	return 0
 }
 
-func (x *ServerInformations) GetDescription() []byte {
+func (x *ChangeRoleRanking) GetTo() int32 {
 	if x != nil {
-		return x.Description
+		return x.To
 	}
-	return nil
+	return 0
 }
 
-func (x *ServerInformations) GetMainColor() string {
-	if x != nil && x.MainColor != nil {
-		return *x.MainColor
+func (x *ChangeRoleRanking) GetServerId() string {
+	if x != nil {
+		return x.ServerId
 	}
 	return ""
 }
 
-type ServerChangedInformations struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ServerId           string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ServerInformations *ServerInformations    `protobuf:"bytes,2,opt,name=server_informations,json=serverInformations,proto3" json:"server_informations,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ChangeRoleRanking) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
 }
 
-func (x *ServerChangedInformations) Reset() {
-	*x = ServerChangedInformations{}
-	mi := &file_types_proto_msgTypes[39]
+type RefreshChannelPermissions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshChannelPermissions) Reset() {
+	*x = RefreshChannelPermissions{}
+	mi := &file_types_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerChangedInformations) String() string {
+func (x *RefreshChannelPermissions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerChangedInformations) ProtoMessage() {}
+func (*RefreshChannelPermissions) ProtoMessage() {}
 
-func (x *ServerChangedInformations) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[39]
+func (x *RefreshChannelPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3113,52 +5665,50 @@ func (x *ServerChangedInformations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerChangedInformations.ProtoReflect.Descriptor instead.
-func (*ServerChangedInformations) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RefreshChannelPermissions.ProtoReflect.Descriptor instead.
+func (*RefreshChannelPermissions) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ServerChangedInformations) GetServerId() string {
+func (x *RefreshChannelPermissions) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *ServerChangedInformations) GetServerInformations() *ServerInformations {
+func (x *RefreshChannelPermissions) GetChannelId() string {
 	if x != nil {
-		return x.ServerInformations
+		return x.ChannelId
 	}
-	return nil
+	return ""
 }
 
-type CreateRole struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Idx           int32                  `protobuf:"varint,2,opt,name=idx,proto3" json:"idx,omitempty"`
-	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Color         string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
-	Abilities     []string               `protobuf:"bytes,6,rep,name=abilities,proto3" json:"abilities,omitempty"`
+type ChannelAccessChanged struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ServerId      string                    `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                    `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Visible       bool                      `protobuf:"varint,3,opt,name=visible,proto3" json:"visible,omitempty"`
+	Channel       *BroadcastChannelCreation `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRole) Reset() {
-	*x = CreateRole{}
-	mi := &file_types_proto_msgTypes[40]
+func (x *ChannelAccessChanged) Reset() {
+	*x = ChannelAccessChanged{}
+	mi := &file_types_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRole) String() string {
+func (x *ChannelAccessChanged) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRole) ProtoMessage() {}
+func (*ChannelAccessChanged) ProtoMessage() {}
 
-func (x *CreateRole) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[40]
+func (x *ChannelAccessChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3169,77 +5719,66 @@ func (x *CreateRole) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRole.ProtoReflect.Descriptor instead.
-func (*CreateRole) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *CreateRole) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CreateRole) GetIdx() int32 {
-	if x != nil {
-		return x.Idx
-	}
-	return 0
+// Deprecated: Use ChannelAccessChanged.ProtoReflect.Descriptor instead.
+func (*ChannelAccessChanged) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CreateRole) GetServerId() string {
+func (x *ChannelAccessChanged) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *CreateRole) GetName() string {
+func (x *ChannelAccessChanged) GetChannelId() string {
 	if x != nil {
-		return x.Name
+		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *CreateRole) GetColor() string {
+func (x *ChannelAccessChanged) GetVisible() bool {
 	if x != nil {
-		return x.Color
+		return x.Visible
 	}
-	return ""
+	return false
 }
 
-func (x *CreateRole) GetAbilities() []string {
+func (x *ChannelAccessChanged) GetChannel() *BroadcastChannelCreation {
 	if x != nil {
-		return x.Abilities
+		return x.Channel
 	}
 	return nil
 }
 
-type AddRoleMember struct {
+type BroadcastModerationAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ModeratorId   string                 `protobuf:"bytes,3,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddRoleMember) Reset() {
-	*x = AddRoleMember{}
-	mi := &file_types_proto_msgTypes[41]
+func (x *BroadcastModerationAction) Reset() {
+	*x = BroadcastModerationAction{}
+	mi := &file_types_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddRoleMember) String() string {
+func (x *BroadcastModerationAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRoleMember) ProtoMessage() {}
+func (*BroadcastModerationAction) ProtoMessage() {}
 
-func (x *AddRoleMember) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[41]
+func (x *BroadcastModerationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3250,56 +5789,78 @@ func (x *AddRoleMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRoleMember.ProtoReflect.Descriptor instead.
-func (*AddRoleMember) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use BroadcastModerationAction.ProtoReflect.Descriptor instead.
+func (*BroadcastModerationAction) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *AddRoleMember) GetUserId() string {
+func (x *BroadcastModerationAction) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BroadcastModerationAction) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *AddRoleMember) GetId() string {
+func (x *BroadcastModerationAction) GetModeratorId() string {
 	if x != nil {
-		return x.Id
+		return x.ModeratorId
 	}
 	return ""
 }
 
-func (x *AddRoleMember) GetServerId() string {
+func (x *BroadcastModerationAction) GetAction() string {
 	if x != nil {
-		return x.ServerId
+		return x.Action
 	}
 	return ""
 }
 
-type RemoveRoleMember struct {
+func (x *BroadcastModerationAction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BroadcastModerationAction) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type JoinRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRoleMember) Reset() {
-	*x = RemoveRoleMember{}
-	mi := &file_types_proto_msgTypes[42]
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	mi := &file_types_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRoleMember) String() string {
+func (x *JoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRoleMember) ProtoMessage() {}
+func (*JoinRequest) ProtoMessage() {}
 
-func (x *RemoveRoleMember) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[42]
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3310,57 +5871,65 @@ func (x *RemoveRoleMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRoleMember.ProtoReflect.Descriptor instead.
-func (*RemoveRoleMember) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *RemoveRoleMember) GetUserId() string {
+func (x *JoinRequest) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RemoveRoleMember) GetId() string {
+func (x *JoinRequest) GetServerId() string {
 	if x != nil {
-		return x.Id
+		return x.ServerId
 	}
 	return ""
 }
 
-func (x *RemoveRoleMember) GetServerId() string {
+func (x *JoinRequest) GetUser() *User {
 	if x != nil {
-		return x.ServerId
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-type ChangeRoleRanking struct {
+func (x *JoinRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type JoinRequestResolved struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	From          int32                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
-	To            int32                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
-	ServerId      string                 `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Approved      bool                   `protobuf:"varint,4,opt,name=approved,proto3" json:"approved,omitempty"`
+	Server        []byte                 `protobuf:"bytes,5,opt,name=server,proto3" json:"server,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeRoleRanking) Reset() {
-	*x = ChangeRoleRanking{}
-	mi := &file_types_proto_msgTypes[43]
+func (x *JoinRequestResolved) Reset() {
+	*x = JoinRequestResolved{}
+	mi := &file_types_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeRoleRanking) String() string {
+func (x *JoinRequestResolved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeRoleRanking) ProtoMessage() {}
+func (*JoinRequestResolved) ProtoMessage() {}
 
-func (x *ChangeRoleRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[43]
+func (x *JoinRequestResolved) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3371,44 +5940,51 @@ func (x *ChangeRoleRanking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeRoleRanking.ProtoReflect.Descriptor instead.
-func (*ChangeRoleRanking) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use JoinRequestResolved.ProtoReflect.Descriptor instead.
+func (*JoinRequestResolved) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ChangeRoleRanking) GetId() string {
+func (x *JoinRequestResolved) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ChangeRoleRanking) GetFrom() int32 {
+func (x *JoinRequestResolved) GetServerId() string {
 	if x != nil {
-		return x.From
+		return x.ServerId
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangeRoleRanking) GetTo() int32 {
+func (x *JoinRequestResolved) GetUserId() string {
 	if x != nil {
-		return x.To
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangeRoleRanking) GetServerId() string {
+func (x *JoinRequestResolved) GetApproved() bool {
 	if x != nil {
-		return x.ServerId
+		return x.Approved
 	}
-	return ""
+	return false
+}
+
+func (x *JoinRequestResolved) GetServer() []byte {
+	if x != nil {
+		return x.Server
+	}
+	return nil
 }
 
 var File_types_proto protoreflect.FileDescriptor
 
 const file_types_proto_rawDesc = "" +
 	"\n" +
-	"\vtypes.proto\x12\x05types\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb3\v\n" +
+	"\vtypes.proto\x12\x05types\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\x12\n" +
 	"\tWSMessage\x12@\n" +
 	"\fchat_message\x18\x01 \x01(\v2\x1b.types.BroadcastChatMessageH\x00R\vchatMessage\x12L\n" +
 	"\x10channel_creation\x18\x02 \x01(\v2\x1f.types.BroadcastChannelCreationH\x00R\x0fchannelCreation\x12I\n" +
@@ -3435,8 +6011,97 @@ const file_types_proto_rawDesc = "" +
 	"\x12remove_role_member\x18\x14 \x01(\v2\x17.types.RemoveRoleMemberH\x00R\x10removeRoleMember\x124\n" +
 	"\vcreate_role\x18\x15 \x01(\v2\x11.types.CreateRoleH\x00R\n" +
 	"createRole\x127\n" +
-	"\tmove_role\x18\x16 \x01(\v2\x18.types.ChangeRoleRankingH\x00R\bmoveRoleB\t\n" +
-	"\acontent\"F\n" +
+	"\tmove_role\x18\x16 \x01(\v2\x18.types.ChangeRoleRankingH\x00R\bmoveRole\x12F\n" +
+	"\x0ereaction_added\x18\x17 \x01(\v2\x1d.types.BroadcastReactionAddedH\x00R\rreactionAdded\x12L\n" +
+	"\x10reaction_removed\x18\x18 \x01(\v2\x1f.types.BroadcastReactionRemovedH\x00R\x0freactionRemoved\x12I\n" +
+	"\x0fthread_creation\x18\x19 \x01(\v2\x1e.types.BroadcastThreadCreationH\x00R\x0ethreadCreation\x12O\n" +
+	"\x11moderation_action\x18\x1a \x01(\v2 .types.BroadcastModerationActionH\x00R\x10moderationAction\x127\n" +
+	"\fjoin_request\x18\x1b \x01(\v2\x12.types.JoinRequestH\x00R\vjoinRequest\x12P\n" +
+	"\x15join_request_resolved\x18\x1c \x01(\v2\x1a.types.JoinRequestResolvedH\x00R\x13joinRequestResolved\x12\x1e\n" +
+	"\x03ack\x18\x1d \x01(\v2\n" +
+	".types.AckH\x00R\x03ack\x12+\n" +
+	"\x05error\x18\x1e \x01(\v2\x13.types.RequestErrorH\x00R\x05error\x12'\n" +
+	"\x06typing\x18\x1f \x01(\v2\r.types.TypingH\x00R\x06typing\x12-\n" +
+	"\bpresence\x18  \x01(\v2\x0f.types.PresenceH\x00R\bpresence\x121\n" +
+	"\n" +
+	"read_state\x18! \x01(\v2\x10.types.ReadStateH\x00R\treadState\x129\n" +
+	"\fnotification\x18\" \x01(\v2\x13.types.NotificationH\x00R\fnotification\x12I\n" +
+	"\x12notifications_read\x18# \x01(\v2\x18.types.NotificationsReadH\x00R\x11notificationsRead\x125\n" +
+	"\fmove_to_call\x18$ \x01(\v2\x11.types.MoveToCallH\x00R\n" +
+	"moveToCall\x12)\n" +
+	"\x05stage\x18% \x01(\v2\x11.types.StageStateH\x00R\x05stageB\t\n" +
+	"\acontent\"\xbc\x04\n" +
+	"\rClientMessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12?\n" +
+	"\fsend_message\x18\x02 \x01(\v2\x1a.types.IncomingChatMessageH\x00R\vsendMessage\x12;\n" +
+	"\fedit_message\x18\x03 \x01(\v2\x16.types.EditChatMessageH\x00R\veditMessage\x12A\n" +
+	"\x0edelete_message\x18\x04 \x01(\v2\x18.types.DeleteChatMessageH\x00R\rdeleteMessage\x12'\n" +
+	"\x06typing\x18\x05 \x01(\v2\r.types.TypingH\x00R\x06typing\x124\n" +
+	"\vvoice_state\x18\x06 \x01(\v2\x11.types.VoiceStateH\x00R\n" +
+	"voiceState\x124\n" +
+	"\fset_presence\x18\a \x01(\v2\x0f.types.PresenceH\x00R\vsetPresence\x123\n" +
+	"\vack_channel\x18\b \x01(\v2\x10.types.ReadStateH\x00R\n" +
+	"ackChannel\x12!\n" +
+	"\x04mute\x18\t \x01(\v2\v.types.MuteH\x00R\x04mute\x12'\n" +
+	"\x06deafen\x18\n" +
+	" \x01(\v2\r.types.DeafenH\x00R\x06deafen\x12*\n" +
+	"\x05stage\x18\v \x01(\v2\x12.types.StageActionH\x00R\x05stageB\t\n" +
+	"\acontent\"\x8d\x02\n" +
+	"\x03Ack\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x125\n" +
+	"\amessage\x18\x02 \x01(\v2\x1b.types.BroadcastChatMessageR\amessage\x12B\n" +
+	"\x0eedited_message\x18\x03 \x01(\v2\x1b.types.BroadcastEditMessageR\reditedMessage\x12\x1d\n" +
+	"\n" +
+	"call_token\x18\x04 \x01(\tR\tcallToken\x12M\n" +
+	"\x15call_token_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x12callTokenExpiresAt\"[\n" +
+	"\fRequestError\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x94\x01\n" +
+	"\x06Typing\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x16\n" +
+	"\x06typing\x18\x04 \x01(\bR\x06typing\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\"\x8b\x02\n" +
+	"\bPresence\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12,\n" +
+	"\x12custom_status_text\x18\x04 \x01(\tR\x10customStatusText\x12.\n" +
+	"\x13custom_status_emoji\x18\x05 \x01(\tR\x11customStatusEmoji\x12S\n" +
+	"\x18custom_status_expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x15customStatusExpiresAt\"[\n" +
+	"\x13UserChangedPresence\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\bpresence\x18\x02 \x01(\v2\x0f.types.PresenceR\bpresence\"\xc0\x01\n" +
+	"\tReadState\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12/\n" +
+	"\x14last_read_message_id\x18\x03 \x01(\tR\x11lastReadMessageId\x12!\n" +
+	"\funread_count\x18\x04 \x01(\x05R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\x05 \x01(\x05R\fmentionCount\"\xbd\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x125\n" +
+	"\amessage\x18\x04 \x01(\v2\x1b.types.BroadcastChatMessageR\amessage\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"7\n" +
+	"\x11NotificationsRead\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"f\n" +
+	"\n" +
+	"VoiceState\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1c\n" +
+	"\tconnected\x18\x03 \x01(\bR\tconnected\"F\n" +
 	"\fUserLinksRow\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x10\n" +
@@ -3463,7 +6128,7 @@ const file_types_proto_rawDesc = "" +
 	"\a_avatarB\t\n" +
 	"\a_bannerB\r\n" +
 	"\v_main_colorB\b\n" +
-	"\x06_about\"\x9a\x02\n" +
+	"\x06_about\"\x98\x03\n" +
 	"\x13IncomingChatMessage\x12\x1b\n" +
 	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x1b\n" +
 	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
@@ -3473,7 +6138,13 @@ const file_types_proto_rawDesc = "" +
 	"\beveryone\x18\x05 \x01(\bR\beveryone\x12%\n" +
 	"\x0ementions_users\x18\x06 \x03(\tR\rmentionsUsers\x12+\n" +
 	"\x11mentions_channels\x18\a \x03(\tR\x10mentionsChannels\x12 \n" +
-	"\vattachments\x18\b \x01(\fR\vattachments\"\x8f\x02\n" +
+	"\vattachments\x18\b \x01(\fR\vattachments\x12\x19\n" +
+	"\breply_to\x18\t \x01(\tR\areplyTo\x12\x1b\n" +
+	"\tthread_id\x18\n" +
+	" \x01(\tR\bthreadId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\v \x01(\tR\trequestId\x12%\n" +
+	"\x0ementions_roles\x18\f \x03(\tR\rmentionsRoles\"\xd5\x02\n" +
 	"\x0fEditChatMessage\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
@@ -3484,14 +6155,20 @@ const file_types_proto_rawDesc = "" +
 	"\acontent\x18\x05 \x01(\fR\acontent\x12\x1a\n" +
 	"\beveryone\x18\x06 \x01(\bR\beveryone\x12%\n" +
 	"\x0ementions_users\x18\a \x03(\tR\rmentionsUsers\x12+\n" +
-	"\x11mentions_channels\x18\b \x03(\tR\x10mentionsChannels\"\x87\x01\n" +
+	"\x11mentions_channels\x18\b \x03(\tR\x10mentionsChannels\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\t \x01(\tR\trequestId\x12%\n" +
+	"\x0ementions_roles\x18\n" +
+	" \x03(\tR\rmentionsRoles\"\xa6\x01\n" +
 	"\x11DeleteChatMessage\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x04 \x01(\tR\tmessageId\"\xe6\x02\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\"\x90\x04\n" +
 	"\x14BroadcastChatMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x1b\n" +
@@ -3505,7 +6182,40 @@ const file_types_proto_rawDesc = "" +
 	"\vattachments\x18\t \x01(\fR\vattachments\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb6\x02\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x122\n" +
+	"\breply_to\x18\v \x01(\v2\x17.types.MessageReferenceR\areplyTo\x12\x1b\n" +
+	"\tthread_id\x18\f \x01(\tR\bthreadId\x12%\n" +
+	"\x0ementions_roles\x18\r \x03(\tR\rmentionsRoles\x12\x16\n" +
+	"\x06unread\x18\x0e \x01(\bR\x06unread\x12\x18\n" +
+	"\amention\x18\x0f \x01(\bR\amention\"\x94\x01\n" +
+	"\x10MessageReference\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb4\x01\n" +
+	"\vStartThread\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\"\x93\x02\n" +
+	"\x17BroadcastThreadCreation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\tR\tcreatorId\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\tR\aactorId\x12#\n" +
+	"\ractor_address\x18\b \x01(\tR\factorAddress\"\xdd\x02\n" +
 	"\x14BroadcastEditMessage\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1b\n" +
@@ -3517,13 +6227,55 @@ const file_types_proto_rawDesc = "" +
 	"\x0ementions_users\x18\x06 \x03(\tR\rmentionsUsers\x12+\n" +
 	"\x11mentions_channels\x18\a \x03(\tR\x10mentionsChannels\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"w\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\x0ementions_roles\x18\t \x03(\tR\rmentionsRoles\"w\n" +
 	"\x1aBroadcastDeleteChatMessage\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1b\n" +
 	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
-	"channel_id\x18\x03 \x01(\tR\tchannelId\"\x95\x01\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\"\xd1\x01\n" +
+	"\vAddReaction\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x05 \x01(\tR\x05emoji\x12\x19\n" +
+	"\bemoji_id\x18\x06 \x01(\tR\aemojiId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\"\xd4\x01\n" +
+	"\x0eRemoveReaction\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x05 \x01(\tR\x05emoji\x12\x19\n" +
+	"\bemoji_id\x18\x06 \x01(\tR\aemojiId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\"\xda\x01\n" +
+	"\x16BroadcastReactionAdded\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05emoji\x18\x05 \x01(\tR\x05emoji\x12\x19\n" +
+	"\bemoji_id\x18\x06 \x01(\tR\aemojiId\x12\x1b\n" +
+	"\temoji_url\x18\a \x01(\tR\bemojiUrl\"\xbf\x01\n" +
+	"\x18BroadcastReactionRemoved\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05emoji\x18\x05 \x01(\tR\x05emoji\x12\x19\n" +
+	"\bemoji_id\x18\x06 \x01(\tR\aemojiId\"\x95\x01\n" +
 	"\x17BroadcastChannelRemoved\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
@@ -3536,7 +6288,7 @@ const file_types_proto_rawDesc = "" +
 	"\x16BroadcastServerRemoved\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x19\n" +
 	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12#\n" +
-	"\ractor_address\x18\x03 \x01(\tR\factorAddress\"\xa4\x03\n" +
+	"\ractor_address\x18\x03 \x01(\tR\factorAddress\"\xd7\x03\n" +
 	"\x18BroadcastChannelCreation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x12\n" +
@@ -3553,20 +6305,24 @@ const file_types_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
 	"\bactor_id\x18\f \x01(\tR\aactorId\x12#\n" +
-	"\ractor_address\x18\r \x01(\tR\factorAddressB\x0e\n" +
-	"\f_description\"Q\n" +
+	"\ractor_address\x18\r \x01(\tR\factorAddress\x12\"\n" +
+	"\n" +
+	"user_limit\x18\x0e \x01(\x05H\x01R\tuserLimit\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_user_limit\"Q\n" +
 	"\x0fChannelStarting\x12\x19\n" +
 	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12#\n" +
-	"\ractor_address\x18\x02 \x01(\tR\factorAddress\"r\n" +
+	"\ractor_address\x18\x02 \x01(\tR\factorAddress\"\xa1\x01\n" +
 	"\x10BroadcastConnect\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05users\x18\x03 \x03(\tR\x05users\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\tR\x04type\"_\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12-\n" +
+	"\tpresences\x18\x05 \x03(\v2\x0f.types.PresenceR\tpresences\"_\n" +
 	"\x13BroadcastDisconnect\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\"\xf3\x01\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"\xa6\x02\n" +
 	"\x13BodyChannelCreation\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
@@ -3579,7 +6335,10 @@ const file_types_proto_rawDesc = "" +
 	"\x01x\x18\b \x01(\x05R\x01x\x12\f\n" +
 	"\x01y\x18\t \x01(\x05R\x01y\x12\x0e\n" +
 	"\x02id\x18\n" +
-	" \x01(\tR\x02id\"`\n" +
+	" \x01(\tR\x02id\x12\"\n" +
+	"\n" +
+	"user_limit\x18\v \x01(\x05H\x00R\tuserLimit\x88\x01\x01B\r\n" +
+	"\v_user_limit\"`\n" +
 	"\fStartChannel\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
@@ -3620,37 +6379,83 @@ const file_types_proto_rawDesc = "" +
 	"\x06sender\x18\x04 \x01(\bR\x06sender\"D\n" +
 	"\fDeleteFriend\x12\x1b\n" +
 	"\tinvite_id\x18\x01 \x01(\tR\binviteId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1d\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"J\n" +
 	"\aConnect\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\"d\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12+\n" +
+	"\bpresence\x18\x02 \x01(\v2\x0f.types.PresenceR\bpresence\"\x89\x02\n" +
 	"\rConnectToCall\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
-	"channel_id\x18\x03 \x01(\tR\tchannelId\"I\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x12\n" +
+	"\x04mute\x18\x04 \x01(\bR\x04mute\x12\x16\n" +
+	"\x06deafen\x18\x05 \x01(\bR\x06deafen\x12\x1f\n" +
+	"\vserver_mute\x18\x06 \x01(\bR\n" +
+	"serverMute\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\x12\x18\n" +
+	"\arefresh\x18\b \x01(\bR\arefresh\x12\x1d\n" +
+	"\n" +
+	"moved_from\x18\t \x01(\tR\tmovedFrom\"r\n" +
 	"\x12CallInitialization\x123\n" +
 	"\n" +
-	"call_users\x18\x01 \x03(\v2\x14.types.ConnectToCallR\tcallUsers\" \n" +
+	"call_users\x18\x01 \x03(\v2\x14.types.ConnectToCallR\tcallUsers\x12'\n" +
+	"\x05stage\x18\x02 \x01(\v2\x11.types.StageStateR\x05stage\"\x87\x01\n" +
+	"\n" +
+	"StageState\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1a\n" +
+	"\bspeakers\x18\x03 \x03(\tR\bspeakers\x12!\n" +
+	"\fraised_hands\x18\x04 \x03(\tR\vraisedHands\"\xb6\x01\n" +
+	"\vStageAction\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\" \n" +
 	"\n" +
 	"Disconnect\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\"i\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\"\x9e\x01\n" +
 	"\x12DisconnectFromCall\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
-	"channel_id\x18\x03 \x01(\tR\tchannelId\"s\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x123\n" +
+	"\aleft_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06leftAt\"G\n" +
+	"\tCallEnded\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"\xcd\x01\n" +
 	"\x04Mute\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\bR\x06status\x12\x1b\n" +
 	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
-	"channel_id\x18\x04 \x01(\tR\tchannelId\"u\n" +
+	"channel_id\x18\x04 \x01(\tR\tchannelId\x12\x16\n" +
+	"\x06server\x18\x05 \x01(\bR\x06server\x12!\n" +
+	"\fmoderator_id\x18\x06 \x01(\tR\vmoderatorId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\"\xad\x01\n" +
+	"\n" +
+	"MoveToCall\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12&\n" +
+	"\x0ffrom_channel_id\x18\x03 \x01(\tR\rfromChannelId\x12\"\n" +
+	"\rto_channel_id\x18\x04 \x01(\tR\vtoChannelId\x12\x1d\n" +
+	"\n" +
+	"call_token\x18\x05 \x01(\tR\tcallToken\"\x94\x01\n" +
 	"\x06Deafen\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\bR\x06status\x12\x1b\n" +
 	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
-	"channel_id\x18\x04 \x01(\tR\tchannelId\"\xeb\x02\n" +
+	"channel_id\x18\x04 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\"\xeb\x02\n" +
 	"\x10UserInformations\x12\x1f\n" +
 	"\busername\x18\x01 \x01(\tH\x00R\busername\x88\x01\x01\x12&\n" +
 	"\fdisplay_name\x18\x02 \x01(\tH\x01R\vdisplayName\x88\x01\x01\x12\x1b\n" +
@@ -3690,7 +6495,7 @@ const file_types_proto_rawDesc = "" +
 	"\v_main_color\"\x84\x01\n" +
 	"\x19ServerChangedInformations\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12J\n" +
-	"\x13server_informations\x18\x02 \x01(\v2\x19.types.ServerInformationsR\x12serverInformations\"\x93\x01\n" +
+	"\x13server_informations\x18\x02 \x01(\v2\x19.types.ServerInformationsR\x12serverInformations\"\xb6\x01\n" +
 	"\n" +
 	"CreateRole\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
@@ -3698,20 +6503,54 @@ const file_types_proto_rawDesc = "" +
 	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12\x12\n" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
 	"\x05color\x18\x05 \x01(\tR\x05color\x12\x1c\n" +
-	"\tabilities\x18\x06 \x03(\tR\tabilities\"U\n" +
+	"\tabilities\x18\x06 \x03(\tR\tabilities\x12!\n" +
+	"\frequester_id\x18\a \x01(\tR\vrequesterId\"x\n" +
 	"\rAddRoleMember\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
-	"\tserver_id\x18\x03 \x01(\tR\bserverId\"X\n" +
+	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12!\n" +
+	"\frequester_id\x18\x04 \x01(\tR\vrequesterId\"{\n" +
 	"\x10RemoveRoleMember\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
-	"\tserver_id\x18\x03 \x01(\tR\bserverId\"d\n" +
+	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12!\n" +
+	"\frequester_id\x18\x04 \x01(\tR\vrequesterId\"\x87\x01\n" +
 	"\x11ChangeRoleRanking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04from\x18\x02 \x01(\x05R\x04from\x12\x0e\n" +
 	"\x02to\x18\x03 \x01(\x05R\x02to\x12\x1b\n" +
-	"\tserver_id\x18\x04 \x01(\tR\bserverIdB\x1cZ\x1agithub.com/okzmo/nyo/protob\x06proto3"
+	"\tserver_id\x18\x04 \x01(\tR\bserverId\x12!\n" +
+	"\frequester_id\x18\x05 \x01(\tR\vrequesterId\"W\n" +
+	"\x19RefreshChannelPermissions\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"\xa7\x01\n" +
+	"\x14ChannelAccessChanged\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x18\n" +
+	"\avisible\x18\x03 \x01(\bR\avisible\x129\n" +
+	"\achannel\x18\x04 \x01(\v2\x1f.types.BroadcastChannelCreationR\achannel\"\xdf\x01\n" +
+	"\x19BroadcastModerationAction\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fmoderator_id\x18\x03 \x01(\tR\vmoderatorId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x96\x01\n" +
+	"\vJoinRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1f\n" +
+	"\x04user\x18\x03 \x01(\v2\v.types.UserR\x04user\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8f\x01\n" +
+	"\x13JoinRequestResolved\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bapproved\x18\x04 \x01(\bR\bapproved\x12\x16\n" +
+	"\x06server\x18\x05 \x01(\fR\x06serverB\x1cZ\x1agithub.com/okzmo/nyo/protob\x06proto3"
 
 var (
 	file_types_proto_rawDescOnce sync.Once
@@ -3725,95 +6564,164 @@ func file_types_proto_rawDescGZIP() []byte {
 	return file_types_proto_rawDescData
 }
 
-var file_types_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_types_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_types_proto_goTypes = []any{
 	(*WSMessage)(nil),                  // 0: types.WSMessage
-	(*UserLinksRow)(nil),               // 1: types.UserLinksRow
-	(*UserFactsRow)(nil),               // 2: types.UserFactsRow
-	(*User)(nil),                       // 3: types.User
-	(*IncomingChatMessage)(nil),        // 4: types.IncomingChatMessage
-	(*EditChatMessage)(nil),            // 5: types.EditChatMessage
-	(*DeleteChatMessage)(nil),          // 6: types.DeleteChatMessage
-	(*BroadcastChatMessage)(nil),       // 7: types.BroadcastChatMessage
-	(*BroadcastEditMessage)(nil),       // 8: types.BroadcastEditMessage
-	(*BroadcastDeleteChatMessage)(nil), // 9: types.BroadcastDeleteChatMessage
-	(*BroadcastChannelRemoved)(nil),    // 10: types.BroadcastChannelRemoved
-	(*BroadcastNewUserInServer)(nil),   // 11: types.BroadcastNewUserInServer
-	(*BroadcastServerRemoved)(nil),     // 12: types.BroadcastServerRemoved
-	(*BroadcastChannelCreation)(nil),   // 13: types.BroadcastChannelCreation
-	(*ChannelStarting)(nil),            // 14: types.ChannelStarting
-	(*BroadcastConnect)(nil),           // 15: types.BroadcastConnect
-	(*BroadcastDisconnect)(nil),        // 16: types.BroadcastDisconnect
-	(*BodyChannelCreation)(nil),        // 17: types.BodyChannelCreation
-	(*StartChannel)(nil),               // 18: types.StartChannel
-	(*KillChannel)(nil),                // 19: types.KillChannel
-	(*BodyChannelRemoved)(nil),         // 20: types.BodyChannelRemoved
-	(*BodyServerRemoved)(nil),          // 21: types.BodyServerRemoved
-	(*BodyNewUserInServer)(nil),        // 22: types.BodyNewUserInServer
-	(*NewServerCreated)(nil),           // 23: types.NewServerCreated
-	(*BroadcastAcceptFriend)(nil),      // 24: types.BroadcastAcceptFriend
-	(*SendFriendInvite)(nil),           // 25: types.SendFriendInvite
-	(*AcceptFriendInvite)(nil),         // 26: types.AcceptFriendInvite
-	(*DeleteFriend)(nil),               // 27: types.DeleteFriend
-	(*Connect)(nil),                    // 28: types.Connect
-	(*ConnectToCall)(nil),              // 29: types.ConnectToCall
-	(*CallInitialization)(nil),         // 30: types.CallInitialization
-	(*Disconnect)(nil),                 // 31: types.Disconnect
-	(*DisconnectFromCall)(nil),         // 32: types.DisconnectFromCall
-	(*Mute)(nil),                       // 33: types.Mute
-	(*Deafen)(nil),                     // 34: types.Deafen
-	(*UserInformations)(nil),           // 35: types.UserInformations
-	(*UserChangedInformations)(nil),    // 36: types.UserChangedInformations
-	(*BroadcastUserInformations)(nil),  // 37: types.BroadcastUserInformations
-	(*ServerInformations)(nil),         // 38: types.ServerInformations
-	(*ServerChangedInformations)(nil),  // 39: types.ServerChangedInformations
-	(*CreateRole)(nil),                 // 40: types.CreateRole
-	(*AddRoleMember)(nil),              // 41: types.AddRoleMember
-	(*RemoveRoleMember)(nil),           // 42: types.RemoveRoleMember
-	(*ChangeRoleRanking)(nil),          // 43: types.ChangeRoleRanking
-	(*timestamppb.Timestamp)(nil),      // 44: google.protobuf.Timestamp
+	(*ClientMessage)(nil),              // 1: types.ClientMessage
+	(*Ack)(nil),                        // 2: types.Ack
+	(*RequestError)(nil),               // 3: types.RequestError
+	(*Typing)(nil),                     // 4: types.Typing
+	(*Presence)(nil),                   // 5: types.Presence
+	(*UserChangedPresence)(nil),        // 6: types.UserChangedPresence
+	(*ReadState)(nil),                  // 7: types.ReadState
+	(*Notification)(nil),               // 8: types.Notification
+	(*NotificationsRead)(nil),          // 9: types.NotificationsRead
+	(*VoiceState)(nil),                 // 10: types.VoiceState
+	(*UserLinksRow)(nil),               // 11: types.UserLinksRow
+	(*UserFactsRow)(nil),               // 12: types.UserFactsRow
+	(*User)(nil),                       // 13: types.User
+	(*IncomingChatMessage)(nil),        // 14: types.IncomingChatMessage
+	(*EditChatMessage)(nil),            // 15: types.EditChatMessage
+	(*DeleteChatMessage)(nil),          // 16: types.DeleteChatMessage
+	(*BroadcastChatMessage)(nil),       // 17: types.BroadcastChatMessage
+	(*MessageReference)(nil),           // 18: types.MessageReference
+	(*StartThread)(nil),                // 19: types.StartThread
+	(*BroadcastThreadCreation)(nil),    // 20: types.BroadcastThreadCreation
+	(*BroadcastEditMessage)(nil),       // 21: types.BroadcastEditMessage
+	(*BroadcastDeleteChatMessage)(nil), // 22: types.BroadcastDeleteChatMessage
+	(*AddReaction)(nil),                // 23: types.AddReaction
+	(*RemoveReaction)(nil),             // 24: types.RemoveReaction
+	(*BroadcastReactionAdded)(nil),     // 25: types.BroadcastReactionAdded
+	(*BroadcastReactionRemoved)(nil),   // 26: types.BroadcastReactionRemoved
+	(*BroadcastChannelRemoved)(nil),    // 27: types.BroadcastChannelRemoved
+	(*BroadcastNewUserInServer)(nil),   // 28: types.BroadcastNewUserInServer
+	(*BroadcastServerRemoved)(nil),     // 29: types.BroadcastServerRemoved
+	(*BroadcastChannelCreation)(nil),   // 30: types.BroadcastChannelCreation
+	(*ChannelStarting)(nil),            // 31: types.ChannelStarting
+	(*BroadcastConnect)(nil),           // 32: types.BroadcastConnect
+	(*BroadcastDisconnect)(nil),        // 33: types.BroadcastDisconnect
+	(*BodyChannelCreation)(nil),        // 34: types.BodyChannelCreation
+	(*StartChannel)(nil),               // 35: types.StartChannel
+	(*KillChannel)(nil),                // 36: types.KillChannel
+	(*BodyChannelRemoved)(nil),         // 37: types.BodyChannelRemoved
+	(*BodyServerRemoved)(nil),          // 38: types.BodyServerRemoved
+	(*BodyNewUserInServer)(nil),        // 39: types.BodyNewUserInServer
+	(*NewServerCreated)(nil),           // 40: types.NewServerCreated
+	(*BroadcastAcceptFriend)(nil),      // 41: types.BroadcastAcceptFriend
+	(*SendFriendInvite)(nil),           // 42: types.SendFriendInvite
+	(*AcceptFriendInvite)(nil),         // 43: types.AcceptFriendInvite
+	(*DeleteFriend)(nil),               // 44: types.DeleteFriend
+	(*Connect)(nil),                    // 45: types.Connect
+	(*ConnectToCall)(nil),              // 46: types.ConnectToCall
+	(*CallInitialization)(nil),         // 47: types.CallInitialization
+	(*StageState)(nil),                 // 48: types.StageState
+	(*StageAction)(nil),                // 49: types.StageAction
+	(*Disconnect)(nil),                 // 50: types.Disconnect
+	(*DisconnectFromCall)(nil),         // 51: types.DisconnectFromCall
+	(*CallEnded)(nil),                  // 52: types.CallEnded
+	(*Mute)(nil),                       // 53: types.Mute
+	(*MoveToCall)(nil),                 // 54: types.MoveToCall
+	(*Deafen)(nil),                     // 55: types.Deafen
+	(*UserInformations)(nil),           // 56: types.UserInformations
+	(*UserChangedInformations)(nil),    // 57: types.UserChangedInformations
+	(*BroadcastUserInformations)(nil),  // 58: types.BroadcastUserInformations
+	(*ServerInformations)(nil),         // 59: types.ServerInformations
+	(*ServerChangedInformations)(nil),  // 60: types.ServerChangedInformations
+	(*CreateRole)(nil),                 // 61: types.CreateRole
+	(*AddRoleMember)(nil),              // 62: types.AddRoleMember
+	(*RemoveRoleMember)(nil),           // 63: types.RemoveRoleMember
+	(*ChangeRoleRanking)(nil),          // 64: types.ChangeRoleRanking
+	(*RefreshChannelPermissions)(nil),  // 65: types.RefreshChannelPermissions
+	(*ChannelAccessChanged)(nil),       // 66: types.ChannelAccessChanged
+	(*BroadcastModerationAction)(nil),  // 67: types.BroadcastModerationAction
+	(*JoinRequest)(nil),                // 68: types.JoinRequest
+	(*JoinRequestResolved)(nil),        // 69: types.JoinRequestResolved
+	(*timestamppb.Timestamp)(nil),      // 70: google.protobuf.Timestamp
 }
 var file_types_proto_depIdxs = []int32{
-	7,  // 0: types.WSMessage.chat_message:type_name -> types.BroadcastChatMessage
-	13, // 1: types.WSMessage.channel_creation:type_name -> types.BroadcastChannelCreation
-	10, // 2: types.WSMessage.channel_removed:type_name -> types.BroadcastChannelRemoved
-	11, // 3: types.WSMessage.new_user:type_name -> types.BroadcastNewUserInServer
-	15, // 4: types.WSMessage.user_connect:type_name -> types.BroadcastConnect
-	16, // 5: types.WSMessage.user_disconnect:type_name -> types.BroadcastDisconnect
-	9,  // 6: types.WSMessage.delete_message:type_name -> types.BroadcastDeleteChatMessage
-	8,  // 7: types.WSMessage.edit_message:type_name -> types.BroadcastEditMessage
-	25, // 8: types.WSMessage.friend_invite:type_name -> types.SendFriendInvite
-	26, // 9: types.WSMessage.accept_friend:type_name -> types.AcceptFriendInvite
-	27, // 10: types.WSMessage.delete_friend:type_name -> types.DeleteFriend
-	37, // 11: types.WSMessage.user_changed:type_name -> types.BroadcastUserInformations
-	39, // 12: types.WSMessage.server_changed:type_name -> types.ServerChangedInformations
-	30, // 13: types.WSMessage.call_users:type_name -> types.CallInitialization
-	29, // 14: types.WSMessage.connect_to_call:type_name -> types.ConnectToCall
-	32, // 15: types.WSMessage.disconnect_from_call:type_name -> types.DisconnectFromCall
-	33, // 16: types.WSMessage.mute_user:type_name -> types.Mute
-	34, // 17: types.WSMessage.deafen_user:type_name -> types.Deafen
-	41, // 18: types.WSMessage.add_role_member:type_name -> types.AddRoleMember
-	42, // 19: types.WSMessage.remove_role_member:type_name -> types.RemoveRoleMember
-	40, // 20: types.WSMessage.create_role:type_name -> types.CreateRole
-	43, // 21: types.WSMessage.move_role:type_name -> types.ChangeRoleRanking
-	44, // 22: types.User.created_at:type_name -> google.protobuf.Timestamp
-	44, // 23: types.BroadcastChatMessage.created_at:type_name -> google.protobuf.Timestamp
-	44, // 24: types.BroadcastEditMessage.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 25: types.BroadcastNewUserInServer.user:type_name -> types.User
-	44, // 26: types.BroadcastChannelCreation.created_at:type_name -> google.protobuf.Timestamp
-	44, // 27: types.BroadcastChannelCreation.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 28: types.BodyNewUserInServer.user:type_name -> types.User
-	3,  // 29: types.SendFriendInvite.user:type_name -> types.User
-	3,  // 30: types.AcceptFriendInvite.user:type_name -> types.User
-	29, // 31: types.CallInitialization.call_users:type_name -> types.ConnectToCall
-	35, // 32: types.UserChangedInformations.user_informations:type_name -> types.UserInformations
-	35, // 33: types.BroadcastUserInformations.user_informations:type_name -> types.UserInformations
-	38, // 34: types.ServerChangedInformations.server_informations:type_name -> types.ServerInformations
-	35, // [35:35] is the sub-list for method output_type
-	35, // [35:35] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	17, // 0: types.WSMessage.chat_message:type_name -> types.BroadcastChatMessage
+	30, // 1: types.WSMessage.channel_creation:type_name -> types.BroadcastChannelCreation
+	27, // 2: types.WSMessage.channel_removed:type_name -> types.BroadcastChannelRemoved
+	28, // 3: types.WSMessage.new_user:type_name -> types.BroadcastNewUserInServer
+	32, // 4: types.WSMessage.user_connect:type_name -> types.BroadcastConnect
+	33, // 5: types.WSMessage.user_disconnect:type_name -> types.BroadcastDisconnect
+	22, // 6: types.WSMessage.delete_message:type_name -> types.BroadcastDeleteChatMessage
+	21, // 7: types.WSMessage.edit_message:type_name -> types.BroadcastEditMessage
+	42, // 8: types.WSMessage.friend_invite:type_name -> types.SendFriendInvite
+	43, // 9: types.WSMessage.accept_friend:type_name -> types.AcceptFriendInvite
+	44, // 10: types.WSMessage.delete_friend:type_name -> types.DeleteFriend
+	58, // 11: types.WSMessage.user_changed:type_name -> types.BroadcastUserInformations
+	60, // 12: types.WSMessage.server_changed:type_name -> types.ServerChangedInformations
+	47, // 13: types.WSMessage.call_users:type_name -> types.CallInitialization
+	46, // 14: types.WSMessage.connect_to_call:type_name -> types.ConnectToCall
+	51, // 15: types.WSMessage.disconnect_from_call:type_name -> types.DisconnectFromCall
+	53, // 16: types.WSMessage.mute_user:type_name -> types.Mute
+	55, // 17: types.WSMessage.deafen_user:type_name -> types.Deafen
+	62, // 18: types.WSMessage.add_role_member:type_name -> types.AddRoleMember
+	63, // 19: types.WSMessage.remove_role_member:type_name -> types.RemoveRoleMember
+	61, // 20: types.WSMessage.create_role:type_name -> types.CreateRole
+	64, // 21: types.WSMessage.move_role:type_name -> types.ChangeRoleRanking
+	25, // 22: types.WSMessage.reaction_added:type_name -> types.BroadcastReactionAdded
+	26, // 23: types.WSMessage.reaction_removed:type_name -> types.BroadcastReactionRemoved
+	20, // 24: types.WSMessage.thread_creation:type_name -> types.BroadcastThreadCreation
+	67, // 25: types.WSMessage.moderation_action:type_name -> types.BroadcastModerationAction
+	68, // 26: types.WSMessage.join_request:type_name -> types.JoinRequest
+	69, // 27: types.WSMessage.join_request_resolved:type_name -> types.JoinRequestResolved
+	2,  // 28: types.WSMessage.ack:type_name -> types.Ack
+	3,  // 29: types.WSMessage.error:type_name -> types.RequestError
+	4,  // 30: types.WSMessage.typing:type_name -> types.Typing
+	5,  // 31: types.WSMessage.presence:type_name -> types.Presence
+	7,  // 32: types.WSMessage.read_state:type_name -> types.ReadState
+	8,  // 33: types.WSMessage.notification:type_name -> types.Notification
+	9,  // 34: types.WSMessage.notifications_read:type_name -> types.NotificationsRead
+	54, // 35: types.WSMessage.move_to_call:type_name -> types.MoveToCall
+	48, // 36: types.WSMessage.stage:type_name -> types.StageState
+	14, // 37: types.ClientMessage.send_message:type_name -> types.IncomingChatMessage
+	15, // 38: types.ClientMessage.edit_message:type_name -> types.EditChatMessage
+	16, // 39: types.ClientMessage.delete_message:type_name -> types.DeleteChatMessage
+	4,  // 40: types.ClientMessage.typing:type_name -> types.Typing
+	10, // 41: types.ClientMessage.voice_state:type_name -> types.VoiceState
+	5,  // 42: types.ClientMessage.set_presence:type_name -> types.Presence
+	7,  // 43: types.ClientMessage.ack_channel:type_name -> types.ReadState
+	53, // 44: types.ClientMessage.mute:type_name -> types.Mute
+	55, // 45: types.ClientMessage.deafen:type_name -> types.Deafen
+	49, // 46: types.ClientMessage.stage:type_name -> types.StageAction
+	17, // 47: types.Ack.message:type_name -> types.BroadcastChatMessage
+	21, // 48: types.Ack.edited_message:type_name -> types.BroadcastEditMessage
+	70, // 49: types.Ack.call_token_expires_at:type_name -> google.protobuf.Timestamp
+	70, // 50: types.Presence.custom_status_expires_at:type_name -> google.protobuf.Timestamp
+	5,  // 51: types.UserChangedPresence.presence:type_name -> types.Presence
+	17, // 52: types.Notification.message:type_name -> types.BroadcastChatMessage
+	70, // 53: types.Notification.created_at:type_name -> google.protobuf.Timestamp
+	70, // 54: types.User.created_at:type_name -> google.protobuf.Timestamp
+	70, // 55: types.BroadcastChatMessage.created_at:type_name -> google.protobuf.Timestamp
+	18, // 56: types.BroadcastChatMessage.reply_to:type_name -> types.MessageReference
+	70, // 57: types.MessageReference.created_at:type_name -> google.protobuf.Timestamp
+	70, // 58: types.BroadcastThreadCreation.created_at:type_name -> google.protobuf.Timestamp
+	70, // 59: types.BroadcastEditMessage.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 60: types.BroadcastNewUserInServer.user:type_name -> types.User
+	70, // 61: types.BroadcastChannelCreation.created_at:type_name -> google.protobuf.Timestamp
+	70, // 62: types.BroadcastChannelCreation.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 63: types.BroadcastConnect.presences:type_name -> types.Presence
+	13, // 64: types.BodyNewUserInServer.user:type_name -> types.User
+	13, // 65: types.SendFriendInvite.user:type_name -> types.User
+	13, // 66: types.AcceptFriendInvite.user:type_name -> types.User
+	5,  // 67: types.Connect.presence:type_name -> types.Presence
+	46, // 68: types.CallInitialization.call_users:type_name -> types.ConnectToCall
+	48, // 69: types.CallInitialization.stage:type_name -> types.StageState
+	70, // 70: types.DisconnectFromCall.left_at:type_name -> google.protobuf.Timestamp
+	56, // 71: types.UserChangedInformations.user_informations:type_name -> types.UserInformations
+	56, // 72: types.BroadcastUserInformations.user_informations:type_name -> types.UserInformations
+	59, // 73: types.ServerChangedInformations.server_informations:type_name -> types.ServerInformations
+	30, // 74: types.ChannelAccessChanged.channel:type_name -> types.BroadcastChannelCreation
+	70, // 75: types.BroadcastModerationAction.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 76: types.JoinRequest.user:type_name -> types.User
+	70, // 77: types.JoinRequest.created_at:type_name -> google.protobuf.Timestamp
+	78, // [78:78] is the sub-list for method output_type
+	78, // [78:78] is the sub-list for method input_type
+	78, // [78:78] is the sub-list for extension type_name
+	78, // [78:78] is the sub-list for extension extendee
+	0,  // [0:78] is the sub-list for field type_name
 }
 
 func init() { file_types_proto_init() }
@@ -3844,18 +6752,46 @@ func file_types_proto_init() {
 		(*WSMessage_RemoveRoleMember)(nil),
 		(*WSMessage_CreateRole)(nil),
 		(*WSMessage_MoveRole)(nil),
+		(*WSMessage_ReactionAdded)(nil),
+		(*WSMessage_ReactionRemoved)(nil),
+		(*WSMessage_ThreadCreation)(nil),
+		(*WSMessage_ModerationAction)(nil),
+		(*WSMessage_JoinRequest)(nil),
+		(*WSMessage_JoinRequestResolved)(nil),
+		(*WSMessage_Ack)(nil),
+		(*WSMessage_Error)(nil),
+		(*WSMessage_Typing)(nil),
+		(*WSMessage_Presence)(nil),
+		(*WSMessage_ReadState)(nil),
+		(*WSMessage_Notification)(nil),
+		(*WSMessage_NotificationsRead)(nil),
+		(*WSMessage_MoveToCall)(nil),
+		(*WSMessage_Stage)(nil),
+	}
+	file_types_proto_msgTypes[1].OneofWrappers = []any{
+		(*ClientMessage_SendMessage)(nil),
+		(*ClientMessage_EditMessage)(nil),
+		(*ClientMessage_DeleteMessage)(nil),
+		(*ClientMessage_Typing)(nil),
+		(*ClientMessage_VoiceState)(nil),
+		(*ClientMessage_SetPresence)(nil),
+		(*ClientMessage_AckChannel)(nil),
+		(*ClientMessage_Mute)(nil),
+		(*ClientMessage_Deafen)(nil),
+		(*ClientMessage_Stage)(nil),
 	}
-	file_types_proto_msgTypes[3].OneofWrappers = []any{}
 	file_types_proto_msgTypes[13].OneofWrappers = []any{}
-	file_types_proto_msgTypes[35].OneofWrappers = []any{}
-	file_types_proto_msgTypes[38].OneofWrappers = []any{}
+	file_types_proto_msgTypes[30].OneofWrappers = []any{}
+	file_types_proto_msgTypes[34].OneofWrappers = []any{}
+	file_types_proto_msgTypes[56].OneofWrappers = []any{}
+	file_types_proto_msgTypes[59].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_proto_rawDesc), len(file_types_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   44,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file types.proto.
  */
 export const file_types: GenFile = /*@__PURE__*/
-  fileDesc("Cgt0eXBlcy5wcm90bxIFdHlwZXMiggkKCVdTTWVzc2FnZRIzCgxjaGF0X21lc3NhZ2UYASABKAsyGy50eXBlcy5Ccm9hZGNhc3RDaGF0TWVzc2FnZUgAEjsKEGNoYW5uZWxfY3JlYXRpb24YAiABKAsyHy50eXBlcy5Ccm9hZGNhc3RDaGFubmVsQ3JlYXRpb25IABI5Cg9jaGFubmVsX3JlbW92ZWQYAyABKAsyHi50eXBlcy5Ccm9hZGNhc3RDaGFubmVsUmVtb3ZlZEgAEjMKCG5ld191c2VyGAQgASgLMh8udHlwZXMuQnJvYWRjYXN0TmV3VXNlckluU2VydmVySAASLwoMdXNlcl9jb25uZWN0GAUgASgLMhcudHlwZXMuQnJvYWRjYXN0Q29ubmVjdEgAEjUKD3VzZXJfZGlzY29ubmVjdBgGIAEoCzIaLnR5cGVzLkJyb2FkY2FzdERpc2Nvbm5lY3RIABI7Cg5kZWxldGVfbWVzc2FnZRgHIAEoCzIhLnR5cGVzLkJyb2FkY2FzdERlbGV0ZUNoYXRNZXNzYWdlSAASMwoMZWRpdF9tZXNzYWdlGAggASgLMhsudHlwZXMuQnJvYWRjYXN0RWRpdE1lc3NhZ2VIABIwCg1mcmllbmRfaW52aXRlGAkgASgLMhcudHlwZXMuU2VuZEZyaWVuZEludml0ZUgAEjIKDWFjY2VwdF9mcmllbmQYCiABKAsyGS50eXBlcy5BY2NlcHRGcmllbmRJbnZpdGVIABIsCg1kZWxldGVfZnJpZW5kGAsgASgLMhMudHlwZXMuRGVsZXRlRnJpZW5kSAASOAoMdXNlcl9jaGFuZ2VkGAwgASgLMiAudHlwZXMuQnJvYWRjYXN0VXNlckluZm9ybWF0aW9uc0gAEjoKDnNlcnZlcl9jaGFuZ2VkGA0gASgLMiAudHlwZXMuU2VydmVyQ2hhbmdlZEluZm9ybWF0aW9uc0gAEi8KCmNhbGxfdXNlcnMYDiABKAsyGS50eXBlcy5DYWxsSW5pdGlhbGl6YXRpb25IABIvCg9jb25uZWN0X3RvX2NhbGwYDyABKAsyFC50eXBlcy5Db25uZWN0VG9DYWxsSAASOQoUZGlzY29ubmVjdF9mcm9tX2NhbGwYECABKAsyGS50eXBlcy5EaXNjb25uZWN0RnJvbUNhbGxIABIgCgltdXRlX3VzZXIYESABKAsyCy50eXBlcy5NdXRlSAASJAoLZGVhZmVuX3VzZXIYEiABKAsyDS50eXBlcy5EZWFmZW5IABIvCg9hZGRfcm9sZV9tZW1iZXIYEyABKAsyFC50eXBlcy5BZGRSb2xlTWVtYmVySAASNQoScmVtb3ZlX3JvbGVfbWVtYmVyGBQgASgLMhcudHlwZXMuUmVtb3ZlUm9sZU1lbWJlckgAEigKC2NyZWF0ZV9yb2xlGBUgASgLMhEudHlwZXMuQ3JlYXRlUm9sZUgAEi0KCW1vdmVfcm9sZRgWIAEoCzIYLnR5cGVzLkNoYW5nZVJvbGVSYW5raW5nSABCCQoHY29udGVudCI2CgxVc2VyTGlua3NSb3cSCgoCaWQYASABKAkSDQoFbGFiZWwYAiABKAkSCwoDdXJsGAMgASgJIjgKDFVzZXJGYWN0c1JvdxIKCgJpZBgBIAEoCRINCgVsYWJlbBgCIAEoCRINCgV2YWx1ZRgDIAEoCSKdAgoEVXNlchIKCgJpZBgBIAEoCRINCgVlbWFpbBgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRIUCgxkaXNwbGF5X25hbWUYBCABKAkSEwoGYXZhdGFyGAUgASgJSACIAQESEwoGYmFubmVyGAYgASgJSAGIAQESFwoKbWFpbl9jb2xvchgHIAEoCUgCiAEBEhIKBWFib3V0GAggASgMSAOIAQESLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbGlua3MYCiABKAwSDQoFZmFjdHMYCyABKAxCCQoHX2F2YXRhckIJCgdfYmFubmVyQg0KC19tYWluX2NvbG9yQggKBl9hYm91dCK6AQoTSW5jb21pbmdDaGF0TWVzc2FnZRIRCglhdXRob3JfaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSDwoHY29udGVudBgEIAEoDBIQCghldmVyeW9uZRgFIAEoCBIWCg5tZW50aW9uc191c2VycxgGIAMoCRIZChFtZW50aW9uc19jaGFubmVscxgHIAMoCRITCgthdHRhY2htZW50cxgIIAEoDCKzAQoPRWRpdENoYXRNZXNzYWdlEg8KB3VzZXJfaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSEgoKbWVzc2FnZV9pZBgEIAEoCRIPCgdjb250ZW50GAUgASgMEhAKCGV2ZXJ5b25lGAYgASgIEhYKDm1lbnRpb25zX3VzZXJzGAcgAygJEhkKEW1lbnRpb25zX2NoYW5uZWxzGAggAygJIl8KEURlbGV0ZUNoYXRNZXNzYWdlEg8KB3VzZXJfaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSEgoKbWVzc2FnZV9pZBgEIAEoCSL3AQoUQnJvYWRjYXN0Q2hhdE1lc3NhZ2USCgoCaWQYASABKAkSEQoJYXV0aG9yX2lkGAIgASgJEhEKCXNlcnZlcl9pZBgDIAEoCRISCgpjaGFubmVsX2lkGAQgASgJEg8KB2NvbnRlbnQYBSABKAwSEAoIZXZlcnlvbmUYBiABKAgSFgoObWVudGlvbnNfdXNlcnMYByADKAkSGQoRbWVudGlvbnNfY2hhbm5lbHMYCCADKAkSEwoLYXR0YWNobWVudHMYCSABKAwSLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi1wEKFEJyb2FkY2FzdEVkaXRNZXNzYWdlEhIKCm1lc3NhZ2VfaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSDwoHY29udGVudBgEIAEoDBIQCghldmVyeW9uZRgFIAEoCBIWCg5tZW50aW9uc191c2VycxgGIAMoCRIZChFtZW50aW9uc19jaGFubmVscxgHIAMoCRIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJXChpCcm9hZGNhc3REZWxldGVDaGF0TWVzc2FnZRISCgptZXNzYWdlX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRISCgpjaGFubmVsX2lkGAMgASgJImkKF0Jyb2FkY2FzdENoYW5uZWxSZW1vdmVkEhEKCXNlcnZlcl9pZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJEhAKCGFjdG9yX2lkGAMgASgJEhUKDWFjdG9yX2FkZHJlc3MYBCABKAkiSAoYQnJvYWRjYXN0TmV3VXNlckluU2VydmVyEhEKCXNlcnZlcl9pZBgBIAEoCRIZCgR1c2VyGAIgASgLMgsudHlwZXMuVXNlciJUChZCcm9hZGNhc3RTZXJ2ZXJSZW1vdmVkEhEKCXNlcnZlcl9pZBgBIAEoCRIQCghhY3Rvcl9pZBgCIAEoCRIVCg1hY3Rvcl9hZGRyZXNzGAMgASgJIrwCChhCcm9hZGNhc3RDaGFubmVsQ3JlYXRpb24SCgoCaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSDAoEdHlwZRgEIAEoCRIYCgtkZXNjcmlwdGlvbhgFIAEoCUgAiAEBEg0KBXVzZXJzGAYgAygJEg0KBXJvbGVzGAcgAygJEgkKAXgYCCABKAUSCQoBeRgJIAEoBRIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghhY3Rvcl9pZBgMIAEoCRIVCg1hY3Rvcl9hZGRyZXNzGA0gASgJQg4KDF9kZXNjcmlwdGlvbiI6Cg9DaGFubmVsU3RhcnRpbmcSEAoIYWN0b3JfaWQYASABKAkSFQoNYWN0b3JfYWRkcmVzcxgCIAEoCSJTChBCcm9hZGNhc3RDb25uZWN0EhEKCXNlcnZlcl9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg0KBXVzZXJzGAMgAygJEgwKBHR5cGUYBCABKAkiRwoTQnJvYWRjYXN0RGlzY29ubmVjdBIRCglzZXJ2ZXJfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIMCgR0eXBlGAMgASgJIq0BChNCb2R5Q2hhbm5lbENyZWF0aW9uEhEKCXNlcnZlcl9pZBgBIAEoCRISCgpjcmVhdG9yX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSDAoEdHlwZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRINCgV1c2VycxgGIAMoCRINCgVyb2xlcxgHIAMoCRIJCgF4GAggASgFEgkKAXkYCSABKAUSCgoCaWQYCiABKAkiRAoMU3RhcnRDaGFubmVsEhEKCXNlcnZlcl9pZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJEg0KBXVzZXJzGAMgAygJImwKC0tpbGxDaGFubmVsEhEKCXNlcnZlcl9pZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJEg0KBXVzZXJzGAMgAygJEhAKCGFjdG9yX2lkGAQgASgJEhUKDWFjdG9yX2FkZHJlc3MYBSABKAkiTAoSQm9keUNoYW5uZWxSZW1vdmVkEhEKCXNlcnZlcl9pZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkiNwoRQm9keVNlcnZlclJlbW92ZWQSEQoJc2VydmVyX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiQwoTQm9keU5ld1VzZXJJblNlcnZlchIRCglzZXJ2ZXJfaWQYASABKAkSGQoEdXNlchgCIAEoCzILLnR5cGVzLlVzZXIiOwoQTmV3U2VydmVyQ3JlYXRlZBIQCghhY3Rvcl9pZBgBIAEoCRIVCg1hY3Rvcl9hZGRyZXNzGAIgASgJIjsKFUJyb2FkY2FzdEFjY2VwdEZyaWVuZBIPCgd1c2VyX2lkGAEgASgJEhEKCWZyaWVuZF9pZBgCIAEoCSJAChBTZW5kRnJpZW5kSW52aXRlEhEKCWludml0ZV9pZBgBIAEoCRIZCgR1c2VyGAIgASgLMgsudHlwZXMuVXNlciJmChJBY2NlcHRGcmllbmRJbnZpdGUSEQoJaW52aXRlX2lkGAEgASgJEhIKCmNoYW5uZWxfaWQYAiABKAkSGQoEdXNlchgDIAEoCzILLnR5cGVzLlVzZXISDgoGc2VuZGVyGAQgASgIIjIKDERlbGV0ZUZyaWVuZBIRCglpbnZpdGVfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSIXCgdDb25uZWN0EgwKBHR5cGUYASABKAkiRwoNQ29ubmVjdFRvQ2FsbBIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRISCgpjaGFubmVsX2lkGAMgASgJIj4KEkNhbGxJbml0aWFsaXphdGlvbhIoCgpjYWxsX3VzZXJzGAEgAygLMhQudHlwZXMuQ29ubmVjdFRvQ2FsbCIaCgpEaXNjb25uZWN0EgwKBHR5cGUYASABKAkiTAoSRGlzY29ubmVjdEZyb21DYWxsEg8KB3VzZXJfaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkiTgoETXV0ZRIPCgd1c2VyX2lkGAEgASgJEg4KBnN0YXR1cxgCIAEoCBIRCglzZXJ2ZXJfaWQYAyABKAkSEgoKY2hhbm5lbF9pZBgEIAEoCSJQCgZEZWFmZW4SDwoHdXNlcl9pZBgBIAEoCRIOCgZzdGF0dXMYAiABKAgSEQoJc2VydmVyX2lkGAMgASgJEhIKCmNoYW5uZWxfaWQYBCABKAkipAIKEFVzZXJJbmZvcm1hdGlvbnMSFQoIdXNlcm5hbWUYASABKAlIAIgBARIZCgxkaXNwbGF5X25hbWUYAiABKAlIAYgBARITCgZhdmF0YXIYAyABKAlIAogBARITCgZiYW5uZXIYBCABKAlIA4gBARISCgVmYWN0cxgFIAEoDEgEiAEBEhIKBWxpbmtzGAYgASgMSAWIAQESEgoFYWJvdXQYByABKAxIBogBARIXCgptYWluX2NvbG9yGAggASgJSAeIAQFCCwoJX3VzZXJuYW1lQg8KDV9kaXNwbGF5X25hbWVCCQoHX2F2YXRhckIJCgdfYmFubmVyQggKBl9mYWN0c0IICgZfbGlua3NCCAoGX2Fib3V0Qg0KC19tYWluX2NvbG9yIl4KF1VzZXJDaGFuZ2VkSW5mb3JtYXRpb25zEg8KB3VzZXJfaWQYASABKAkSMgoRdXNlcl9pbmZvcm1hdGlvbnMYAiABKAsyFy50eXBlcy5Vc2VySW5mb3JtYXRpb25zInMKGUJyb2FkY2FzdFVzZXJJbmZvcm1hdGlvbnMSDwoHdXNlcl9pZBgBIAEoCRIRCglzZXJ2ZXJfaWQYAiABKAkSMgoRdXNlcl9pbmZvcm1hdGlvbnMYAyABKAsyFy50eXBlcy5Vc2VySW5mb3JtYXRpb25zIsIBChJTZXJ2ZXJJbmZvcm1hdGlvbnMSEQoEbmFtZRgBIAEoCUgAiAEBEhMKBmF2YXRhchgCIAEoCUgBiAEBEhMKBmJhbm5lchgDIAEoCUgCiAEBEhgKC2Rlc2NyaXB0aW9uGAQgASgMSAOIAQESFwoKbWFpbl9jb2xvchgFIAEoCUgEiAEBQgcKBV9uYW1lQgkKB19hdmF0YXJCCQoHX2Jhbm5lckIOCgxfZGVzY3JpcHRpb25CDQoLX21haW5fY29sb3IiZgoZU2VydmVyQ2hhbmdlZEluZm9ybWF0aW9ucxIRCglzZXJ2ZXJfaWQYASABKAkSNgoTc2VydmVyX2luZm9ybWF0aW9ucxgCIAEoCzIZLnR5cGVzLlNlcnZlckluZm9ybWF0aW9ucyJoCgpDcmVhdGVSb2xlEgoKAmlkGAEgASgJEgsKA2lkeBgCIAEoBRIRCglzZXJ2ZXJfaWQYAyABKAkSDAoEbmFtZRgEIAEoCRINCgVjb2xvchgFIAEoCRIRCglhYmlsaXRpZXMYBiADKAkiPwoNQWRkUm9sZU1lbWJlchIPCgd1c2VyX2lkGAEgASgJEgoKAmlkGAIgASgJEhEKCXNlcnZlcl9pZBgDIAEoCSJCChBSZW1vdmVSb2xlTWVtYmVyEg8KB3VzZXJfaWQYASABKAkSCgoCaWQYAiABKAkSEQoJc2VydmVyX2lkGAMgASgJIkwKEUNoYW5nZVJvbGVSYW5raW5nEgoKAmlkGAEgASgJEgwKBGZyb20YAiABKAUSCgoCdG8YAyABKAUSEQoJc2VydmVyX2lkGAQgASgJQhxaGmdpdGh1Yi5jb20vb2t6bW8vbnlvL3Byb3RvYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("Cgt0eXBlcy5wcm90bxIFdHlwZXMivg4KCVdTTWVzc2FnZRIzCgxjaGF0X21lc3NhZ2UYASABKAsyGy50eXBlcy5Ccm9hZGNhc3RDaGF0TWVzc2FnZUgAEjsKEGNoYW5uZWxfY3JlYXRpb24YAiABKAsyHy50eXBlcy5Ccm9hZGNhc3RDaGFubmVsQ3JlYXRpb25IABI5Cg9jaGFubmVsX3JlbW92ZWQYAyABKAsyHi50eXBlcy5Ccm9hZGNhc3RDaGFubmVsUmVtb3ZlZEgAEjMKCG5ld191c2VyGAQgASgLMh8udHlwZXMuQnJvYWRjYXN0TmV3VXNlckluU2VydmVySAASLwoMdXNlcl9jb25uZWN0GAUgASgLMhcudHlwZXMuQnJvYWRjYXN0Q29ubmVjdEgAEjUKD3VzZXJfZGlzY29ubmVjdBgGIAEoCzIaLnR5cGVzLkJyb2FkY2FzdERpc2Nvbm5lY3RIABI7Cg5kZWxldGVfbWVzc2FnZRgHIAEoCzIhLnR5cGVzLkJyb2FkY2FzdERlbGV0ZUNoYXRNZXNzYWdlSAASMwoMZWRpdF9tZXNzYWdlGAggASgLMhsudHlwZXMuQnJvYWRjYXN0RWRpdE1lc3NhZ2VIABIwCg1mcmllbmRfaW52aXRlGAkgASgLMhcudHlwZXMuU2VuZEZyaWVuZEludml0ZUgAEjIKDWFjY2VwdF9mcmllbmQYCiABKAsyGS50eXBlcy5BY2NlcHRGcmllbmRJbnZpdGVIABIsCg1kZWxldGVfZnJpZW5kGAsgASgLMhMudHlwZXMuRGVsZXRlRnJpZW5kSAASOAoMdXNlcl9jaGFuZ2VkGAwgASgLMiAudHlwZXMuQnJvYWRjYXN0VXNlckluZm9ybWF0aW9uc0gAEjoKDnNlcnZlcl9jaGFuZ2VkGA0gASgLMiAudHlwZXMuU2VydmVyQ2hhbmdlZEluZm9ybWF0aW9uc0gAEi8KCmNhbGxfdXNlcnMYDiABKAsyGS50eXBlcy5DYWxsSW5pdGlhbGl6YXRpb25IABIvCg9jb25uZWN0X3RvX2NhbGwYDyABKAsyFC50eXBlcy5Db25uZWN0VG9DYWxsSAASOQoUZGlzY29ubmVjdF9mcm9tX2NhbGwYECABKAsyGS50eXBlcy5EaXNjb25uZWN0RnJvbUNhbGxIABIgCgltdXRlX3VzZXIYESABKAsyCy50eXBlcy5NdXRlSAASJAoLZGVhZmVuX3VzZXIYEiABKAsyDS50eXBlcy5EZWFmZW5IABIvCg9hZGRfcm9sZV9tZW1iZXIYEyABKAsyFC50eXBlcy5BZGRSb2xlTWVtYmVySAASNQoScmVtb3ZlX3JvbGVfbWVtYmVyGBQgASgLMhcudHlwZXMuUmVtb3ZlUm9sZU1lbWJlckgAEigKC2NyZWF0ZV9yb2xlGBUgASgLMhEudHlwZXMuQ3JlYXRlUm9sZUgAEi0KCW1vdmVfcm9sZRgWIAEoCzIYLnR5cGVzLkNoYW5nZVJvbGVSYW5raW5nSAASNwoOcmVhY3Rpb25fYWRkZWQYFyABKAsyHS50eXBlcy5Ccm9hZGNhc3RSZWFjdGlvbkFkZGVkSAASOwoQcmVhY3Rpb25fcmVtb3ZlZBgYIAEoCzIfLnR5cGVzLkJyb2FkY2FzdFJlYWN0aW9uUmVtb3ZlZEgAEjkKD3RocmVhZF9jcmVhdGlvbhgZIAEoCzIeLnR5cGVzLkJyb2FkY2FzdFRocmVhZENyZWF0aW9uSAASPQoRbW9kZXJhdGlvbl9hY3Rpb24YGiABKAsyIC50eXBlcy5Ccm9hZGNhc3RNb2RlcmF0aW9uQWN0aW9uSAASKgoMam9pbl9yZXF1ZXN0GBsgASgLMhIudHlwZXMuSm9pblJlcXVlc3RIABI7ChVqb2luX3JlcXVlc3RfcmVzb2x2ZWQYHCABKAsyGi50eXBlcy5Kb2luUmVxdWVzdFJlc29sdmVkSAASGQoDYWNrGB0gASgLMgoudHlwZXMuQWNrSAASJAoFZXJyb3IYHiABKAsyEy50eXBlcy5SZXF1ZXN0RXJyb3JIABIfCgZ0eXBpbmcYHyABKAsyDS50eXBlcy5UeXBpbmdIABIjCghwcmVzZW5jZRggIAEoCzIPLnR5cGVzLlByZXNlbmNlSAASJgoKcmVhZF9zdGF0ZRghIAEoCzIQLnR5cGVzLlJlYWRTdGF0ZUgAEisKDG5vdGlmaWNhdGlvbhgiIAEoCzITLnR5cGVzLk5vdGlmaWNhdGlvbkgAEjYKEm5vdGlmaWNhdGlvbnNfcmVhZBgjIAEoCzIYLnR5cGVzLk5vdGlmaWNhdGlvbnNSZWFkSAASKQoMbW92ZV90b19jYWxsGCQgASgLMhEudHlwZXMuTW92ZVRvQ2FsbEgAEiIKBXN0YWdlGCUgASgLMhEudHlwZXMuU3RhZ2VTdGF0ZUgAQgkKB2NvbnRlbnQixgMKDUNsaWVudE1lc3NhZ2USEgoKcmVxdWVzdF9pZBgBIAEoCRIyCgxzZW5kX21lc3NhZ2UYAiABKAsyGi50eXBlcy5JbmNvbWluZ0NoYXRNZXNzYWdlSAASLgoMZWRpdF9tZXNzYWdlGAMgASgLMhYudHlwZXMuRWRpdENoYXRNZXNzYWdlSAASMgoOZGVsZXRlX21lc3NhZ2UYBCABKAsyGC50eXBlcy5EZWxldGVDaGF0TWVzc2FnZUgAEh8KBnR5cGluZxgFIAEoCzINLnR5cGVzLlR5cGluZ0gAEigKC3ZvaWNlX3N0YXRlGAYgASgLMhEudHlwZXMuVm9pY2VTdGF0ZUgAEicKDHNldF9wcmVzZW5jZRgHIAEoCzIPLnR5cGVzLlByZXNlbmNlSAASJwoLYWNrX2NoYW5uZWwYCCABKAsyEC50eXBlcy5SZWFkU3RhdGVIABIbCgRtdXRlGAkgASgLMgsudHlwZXMuTXV0ZUgAEh8KBmRlYWZlbhgKIAEoCzINLnR5cGVzLkRlYWZlbkgAEiMKBXN0YWdlGAsgASgLMhIudHlwZXMuU3RhZ2VBY3Rpb25IAEIJCgdjb250ZW50IssBCgNBY2sSEgoKcmVxdWVzdF9pZBgBIAEoCRIsCgdtZXNzYWdlGAIgASgLMhsudHlwZXMuQnJvYWRjYXN0Q2hhdE1lc3NhZ2USMwoOZWRpdGVkX21lc3NhZ2UYAyABKAsyGy50eXBlcy5Ccm9hZGNhc3RFZGl0TWVzc2FnZRISCgpjYWxsX3Rva2VuGAQgASgJEjkKFWNhbGxfdG9rZW5fZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiQQoMUmVxdWVzdEVycm9yEhIKCnJlcXVlc3RfaWQYASABKAkSDAoEY29kZRgCIAEoCRIPCgdtZXNzYWdlGAMgASgJImQKBlR5cGluZxIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRISCgpjaGFubmVsX2lkGAMgASgJEg4KBnR5cGluZxgEIAEoCBISCgpyZXF1ZXN0X2lkGAUgASgJIrUBCghQcmVzZW5jZRIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRIOCgZzdGF0dXMYAyABKAkSGgoSY3VzdG9tX3N0YXR1c190ZXh0GAQgASgJEhsKE2N1c3RvbV9zdGF0dXNfZW1vamkYBSABKAkSPAoYY3VzdG9tX3N0YXR1c19leHBpcmVzX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJJChNVc2VyQ2hhbmdlZFByZXNlbmNlEg8KB3VzZXJfaWQYASABKAkSIQoIcHJlc2VuY2UYAiABKAsyDy50eXBlcy5QcmVzZW5jZSJ9CglSZWFkU3RhdGUSEQoJc2VydmVyX2lkGAEgASgJEhIKCmNoYW5uZWxfaWQYAiABKAkSHAoUbGFzdF9yZWFkX21lc3NhZ2VfaWQYAyABKAkSFAoMdW5yZWFkX2NvdW50GAQgASgFEhUKDW1lbnRpb25fY291bnQYBSABKAUilwEKDE5vdGlmaWNhdGlvbhIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEgwKBHR5cGUYAyABKAkSLAoHbWVzc2FnZRgEIAEoCzIbLnR5cGVzLkJyb2FkY2FzdENoYXRNZXNzYWdlEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIi0KEU5vdGlmaWNhdGlvbnNSZWFkEgsKA2lkcxgBIAMoCRILCgNhbGwYAiABKAgiRgoKVm9pY2VTdGF0ZRIRCglzZXJ2ZXJfaWQYASABKAkSEgoKY2hhbm5lbF9pZBgCIAEoCRIRCgljb25uZWN0ZWQYAyABKAgiNgoMVXNlckxpbmtzUm93EgoKAmlkGAEgASgJEg0KBWxhYmVsGAIgASgJEgsKA3VybBgDIAEoCSI4CgxVc2VyRmFjdHNSb3cSCgoCaWQYASABKAkSDQoFbGFiZWwYAiABKAkSDQoFdmFsdWUYAyABKAkinQIKBFVzZXISCgoCaWQYASABKAkSDQoFZW1haWwYAiABKAkSEAoIdXNlcm5hbWUYAyABKAkSFAoMZGlzcGxheV9uYW1lGAQgASgJEhMKBmF2YXRhchgFIAEoCUgAiAEBEhMKBmJhbm5lchgGIAEoCUgBiAEBEhcKCm1haW5fY29sb3IYByABKAlIAogBARISCgVhYm91dBgIIAEoDEgDiAEBEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWxpbmtzGAogASgMEg0KBWZhY3RzGAsgASgMQgkKB19hdmF0YXJCCQoHX2Jhbm5lckINCgtfbWFpbl9jb2xvckIICgZfYWJvdXQiiwIKE0luY29taW5nQ2hhdE1lc3NhZ2USEQoJYXV0aG9yX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRISCgpjaGFubmVsX2lkGAMgASgJEg8KB2NvbnRlbnQYBCABKAwSEAoIZXZlcnlvbmUYBSABKAgSFgoObWVudGlvbnNfdXNlcnMYBiADKAkSGQoRbWVudGlvbnNfY2hhbm5lbHMYByADKAkSEwoLYXR0YWNobWVudHMYCCABKAwSEAoIcmVwbHlfdG8YCSABKAkSEQoJdGhyZWFkX2lkGAogASgJEhIKCnJlcXVlc3RfaWQYCyABKAkSFgoObWVudGlvbnNfcm9sZXMYDCADKAki3wEKD0VkaXRDaGF0TWVzc2FnZRIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRISCgpjaGFubmVsX2lkGAMgASgJEhIKCm1lc3NhZ2VfaWQYBCABKAkSDwoHY29udGVudBgFIAEoDBIQCghldmVyeW9uZRgGIAEoCBIWCg5tZW50aW9uc191c2VycxgHIAMoCRIZChFtZW50aW9uc19jaGFubmVscxgIIAMoCRISCgpyZXF1ZXN0X2lkGAkgASgJEhYKDm1lbnRpb25zX3JvbGVzGAogAygJInMKEURlbGV0ZUNoYXRNZXNzYWdlEg8KB3VzZXJfaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSEgoKbWVzc2FnZV9pZBgEIAEoCRISCgpyZXF1ZXN0X2lkGAUgASgJIu4CChRCcm9hZGNhc3RDaGF0TWVzc2FnZRIKCgJpZBgBIAEoCRIRCglhdXRob3JfaWQYAiABKAkSEQoJc2VydmVyX2lkGAMgASgJEhIKCmNoYW5uZWxfaWQYBCABKAkSDwoHY29udGVudBgFIAEoDBIQCghldmVyeW9uZRgGIAEoCBIWCg5tZW50aW9uc191c2VycxgHIAMoCRIZChFtZW50aW9uc19jaGFubmVscxgIIAMoCRITCgthdHRhY2htZW50cxgJIAEoDBIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIpCghyZXBseV90bxgLIAEoCzIXLnR5cGVzLk1lc3NhZ2VSZWZlcmVuY2USEQoJdGhyZWFkX2lkGAwgASgJEhYKDm1lbnRpb25zX3JvbGVzGA0gAygJEg4KBnVucmVhZBgOIAEoCBIPCgdtZW50aW9uGA8gASgIInIKEE1lc3NhZ2VSZWZlcmVuY2USCgoCaWQYASABKAkSEQoJYXV0aG9yX2lkGAIgASgJEg8KB2NvbnRlbnQYAyABKAwSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiewoLU3RhcnRUaHJlYWQSDwoHdXNlcl9pZBgBIAEoCRIRCglzZXJ2ZXJfaWQYAiABKAkSEgoKY2hhbm5lbF9pZBgDIAEoCRISCgptZXNzYWdlX2lkGAQgASgJEgwKBG5hbWUYBSABKAkSEgoKcmVxdWVzdF9pZBgGIAEoCSLHAQoXQnJvYWRjYXN0VGhyZWFkQ3JlYXRpb24SCgoCaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSEgoKY3JlYXRvcl9pZBgEIAEoCRIMCgRuYW1lGAUgASgJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGFjdG9yX2lkGAcgASgJEhUKDWFjdG9yX2FkZHJlc3MYCCABKAki7wEKFEJyb2FkY2FzdEVkaXRNZXNzYWdlEhIKCm1lc3NhZ2VfaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSDwoHY29udGVudBgEIAEoDBIQCghldmVyeW9uZRgFIAEoCBIWCg5tZW50aW9uc191c2VycxgGIAMoCRIZChFtZW50aW9uc19jaGFubmVscxgHIAMoCRIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIWCg5tZW50aW9uc19yb2xlcxgJIAMoCSJXChpCcm9hZGNhc3REZWxldGVDaGF0TWVzc2FnZRISCgptZXNzYWdlX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRISCgpjaGFubmVsX2lkGAMgASgJIo4BCgtBZGRSZWFjdGlvbhIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRISCgpjaGFubmVsX2lkGAMgASgJEhIKCm1lc3NhZ2VfaWQYBCABKAkSDQoFZW1vamkYBSABKAkSEAoIZW1vamlfaWQYBiABKAkSEgoKcmVxdWVzdF9pZBgHIAEoCSKRAQoOUmVtb3ZlUmVhY3Rpb24SDwoHdXNlcl9pZBgBIAEoCRIRCglzZXJ2ZXJfaWQYAiABKAkSEgoKY2hhbm5lbF9pZBgDIAEoCRISCgptZXNzYWdlX2lkGAQgASgJEg0KBWVtb2ppGAUgASgJEhAKCGVtb2ppX2lkGAYgASgJEhIKCnJlcXVlc3RfaWQYByABKAkimAEKFkJyb2FkY2FzdFJlYWN0aW9uQWRkZWQSEgoKbWVzc2FnZV9pZBgBIAEoCRIRCglzZXJ2ZXJfaWQYAiABKAkSEgoKY2hhbm5lbF9pZBgDIAEoCRIPCgd1c2VyX2lkGAQgASgJEg0KBWVtb2ppGAUgASgJEhAKCGVtb2ppX2lkGAYgASgJEhEKCWVtb2ppX3VybBgHIAEoCSKHAQoYQnJvYWRjYXN0UmVhY3Rpb25SZW1vdmVkEhIKCm1lc3NhZ2VfaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSDwoHdXNlcl9pZBgEIAEoCRINCgVlbW9qaRgFIAEoCRIQCghlbW9qaV9pZBgGIAEoCSJpChdCcm9hZGNhc3RDaGFubmVsUmVtb3ZlZBIRCglzZXJ2ZXJfaWQYASABKAkSEgoKY2hhbm5lbF9pZBgCIAEoCRIQCghhY3Rvcl9pZBgDIAEoCRIVCg1hY3Rvcl9hZGRyZXNzGAQgASgJIkgKGEJyb2FkY2FzdE5ld1VzZXJJblNlcnZlchIRCglzZXJ2ZXJfaWQYASABKAkSGQoEdXNlchgCIAEoCzILLnR5cGVzLlVzZXIiVAoWQnJvYWRjYXN0U2VydmVyUmVtb3ZlZBIRCglzZXJ2ZXJfaWQYASABKAkSEAoIYWN0b3JfaWQYAiABKAkSFQoNYWN0b3JfYWRkcmVzcxgDIAEoCSLkAgoYQnJvYWRjYXN0Q2hhbm5lbENyZWF0aW9uEgoKAmlkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEgwKBHR5cGUYBCABKAkSGAoLZGVzY3JpcHRpb24YBSABKAlIAIgBARINCgV1c2VycxgGIAMoCRINCgVyb2xlcxgHIAMoCRIJCgF4GAggASgFEgkKAXkYCSABKAUSLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIYWN0b3JfaWQYDCABKAkSFQoNYWN0b3JfYWRkcmVzcxgNIAEoCRIXCgp1c2VyX2xpbWl0GA4gASgFSAGIAQFCDgoMX2Rlc2NyaXB0aW9uQg0KC191c2VyX2xpbWl0IjoKD0NoYW5uZWxTdGFydGluZxIQCghhY3Rvcl9pZBgBIAEoCRIVCg1hY3Rvcl9hZGRyZXNzGAIgASgJIncKEEJyb2FkY2FzdENvbm5lY3QSEQoJc2VydmVyX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDQoFdXNlcnMYAyADKAkSDAoEdHlwZRgEIAEoCRIiCglwcmVzZW5jZXMYBSADKAsyDy50eXBlcy5QcmVzZW5jZSJHChNCcm9hZGNhc3REaXNjb25uZWN0EhEKCXNlcnZlcl9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEgwKBHR5cGUYAyABKAki1QEKE0JvZHlDaGFubmVsQ3JlYXRpb24SEQoJc2VydmVyX2lkGAEgASgJEhIKCmNyZWF0b3JfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRIMCgR0eXBlGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJEg0KBXVzZXJzGAYgAygJEg0KBXJvbGVzGAcgAygJEgkKAXgYCCABKAUSCQoBeRgJIAEoBRIKCgJpZBgKIAEoCRIXCgp1c2VyX2xpbWl0GAsgASgFSACIAQFCDQoLX3VzZXJfbGltaXQiRAoMU3RhcnRDaGFubmVsEhEKCXNlcnZlcl9pZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJEg0KBXVzZXJzGAMgAygJImwKC0tpbGxDaGFubmVsEhEKCXNlcnZlcl9pZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJEg0KBXVzZXJzGAMgAygJEhAKCGFjdG9yX2lkGAQgASgJEhUKDWFjdG9yX2FkZHJlc3MYBSABKAkiTAoSQm9keUNoYW5uZWxSZW1vdmVkEhEKCXNlcnZlcl9pZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkiNwoRQm9keVNlcnZlclJlbW92ZWQSEQoJc2VydmVyX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiQwoTQm9keU5ld1VzZXJJblNlcnZlchIRCglzZXJ2ZXJfaWQYASABKAkSGQoEdXNlchgCIAEoCzILLnR5cGVzLlVzZXIiOwoQTmV3U2VydmVyQ3JlYXRlZBIQCghhY3Rvcl9pZBgBIAEoCRIVCg1hY3Rvcl9hZGRyZXNzGAIgASgJIjsKFUJyb2FkY2FzdEFjY2VwdEZyaWVuZBIPCgd1c2VyX2lkGAEgASgJEhEKCWZyaWVuZF9pZBgCIAEoCSJAChBTZW5kRnJpZW5kSW52aXRlEhEKCWludml0ZV9pZBgBIAEoCRIZCgR1c2VyGAIgASgLMgsudHlwZXMuVXNlciJmChJBY2NlcHRGcmllbmRJbnZpdGUSEQoJaW52aXRlX2lkGAEgASgJEhIKCmNoYW5uZWxfaWQYAiABKAkSGQoEdXNlchgDIAEoCzILLnR5cGVzLlVzZXISDgoGc2VuZGVyGAQgASgIIjIKDERlbGV0ZUZyaWVuZBIRCglpbnZpdGVfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSI6CgdDb25uZWN0EgwKBHR5cGUYASABKAkSIQoIcHJlc2VuY2UYAiABKAsyDy50eXBlcy5QcmVzZW5jZSKzAQoNQ29ubmVjdFRvQ2FsbBIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRISCgpjaGFubmVsX2lkGAMgASgJEgwKBG11dGUYBCABKAgSDgoGZGVhZmVuGAUgASgIEhMKC3NlcnZlcl9tdXRlGAYgASgIEhIKCnJlcXVlc3RfaWQYByABKAkSDwoHcmVmcmVzaBgIIAEoCBISCgptb3ZlZF9mcm9tGAkgASgJImAKEkNhbGxJbml0aWFsaXphdGlvbhIoCgpjYWxsX3VzZXJzGAEgAygLMhQudHlwZXMuQ29ubmVjdFRvQ2FsbBIgCgVzdGFnZRgCIAEoCzIRLnR5cGVzLlN0YWdlU3RhdGUiWwoKU3RhZ2VTdGF0ZRIRCglzZXJ2ZXJfaWQYASABKAkSEgoKY2hhbm5lbF9pZBgCIAEoCRIQCghzcGVha2VycxgDIAMoCRIUCgxyYWlzZWRfaGFuZHMYBCADKAkifAoLU3RhZ2VBY3Rpb24SDwoHdXNlcl9pZBgBIAEoCRIRCglzZXJ2ZXJfaWQYAiABKAkSEgoKY2hhbm5lbF9pZBgDIAEoCRIOCgZhY3Rpb24YBCABKAkSEQoJdGFyZ2V0X2lkGAUgASgJEhIKCnJlcXVlc3RfaWQYBiABKAkiGgoKRGlzY29ubmVjdBIMCgR0eXBlGAEgASgJInkKEkRpc2Nvbm5lY3RGcm9tQ2FsbBIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRISCgpjaGFubmVsX2lkGAMgASgJEisKB2xlZnRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjIKCUNhbGxFbmRlZBIRCglzZXJ2ZXJfaWQYASABKAkSEgoKY2hhbm5lbF9pZBgCIAEoCSKIAQoETXV0ZRIPCgd1c2VyX2lkGAEgASgJEg4KBnN0YXR1cxgCIAEoCBIRCglzZXJ2ZXJfaWQYAyABKAkSEgoKY2hhbm5lbF9pZBgEIAEoCRIOCgZzZXJ2ZXIYBSABKAgSFAoMbW9kZXJhdG9yX2lkGAYgASgJEhIKCnJlcXVlc3RfaWQYByABKAkidAoKTW92ZVRvQ2FsbBIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRIXCg9mcm9tX2NoYW5uZWxfaWQYAyABKAkSFQoNdG9fY2hhbm5lbF9pZBgEIAEoCRISCgpjYWxsX3Rva2VuGAUgASgJImQKBkRlYWZlbhIPCgd1c2VyX2lkGAEgASgJEg4KBnN0YXR1cxgCIAEoCBIRCglzZXJ2ZXJfaWQYAyABKAkSEgoKY2hhbm5lbF9pZBgEIAEoCRISCgpyZXF1ZXN0X2lkGAUgASgJIqQCChBVc2VySW5mb3JtYXRpb25zEhUKCHVzZXJuYW1lGAEgASgJSACIAQESGQoMZGlzcGxheV9uYW1lGAIgASgJSAGIAQESEwoGYXZhdGFyGAMgASgJSAKIAQESEwoGYmFubmVyGAQgASgJSAOIAQESEgoFZmFjdHMYBSABKAxIBIgBARISCgVsaW5rcxgGIAEoDEgFiAEBEhIKBWFib3V0GAcgASgMSAaIAQESFwoKbWFpbl9jb2xvchgIIAEoCUgHiAEBQgsKCV91c2VybmFtZUIPCg1fZGlzcGxheV9uYW1lQgkKB19hdmF0YXJCCQoHX2Jhbm5lckIICgZfZmFjdHNCCAoGX2xpbmtzQggKBl9hYm91dEINCgtfbWFpbl9jb2xvciJeChdVc2VyQ2hhbmdlZEluZm9ybWF0aW9ucxIPCgd1c2VyX2lkGAEgASgJEjIKEXVzZXJfaW5mb3JtYXRpb25zGAIgASgLMhcudHlwZXMuVXNlckluZm9ybWF0aW9ucyJzChlCcm9hZGNhc3RVc2VySW5mb3JtYXRpb25zEg8KB3VzZXJfaWQYASABKAkSEQoJc2VydmVyX2lkGAIgASgJEjIKEXVzZXJfaW5mb3JtYXRpb25zGAMgASgLMhcudHlwZXMuVXNlckluZm9ybWF0aW9ucyLCAQoSU2VydmVySW5mb3JtYXRpb25zEhEKBG5hbWUYASABKAlIAIgBARITCgZhdmF0YXIYAiABKAlIAYgBARITCgZiYW5uZXIYAyABKAlIAogBARIYCgtkZXNjcmlwdGlvbhgEIAEoDEgDiAEBEhcKCm1haW5fY29sb3IYBSABKAlIBIgBAUIHCgVfbmFtZUIJCgdfYXZhdGFyQgkKB19iYW5uZXJCDgoMX2Rlc2NyaXB0aW9uQg0KC19tYWluX2NvbG9yImYKGVNlcnZlckNoYW5nZWRJbmZvcm1hdGlvbnMSEQoJc2VydmVyX2lkGAEgASgJEjYKE3NlcnZlcl9pbmZvcm1hdGlvbnMYAiABKAsyGS50eXBlcy5TZXJ2ZXJJbmZvcm1hdGlvbnMifgoKQ3JlYXRlUm9sZRIKCgJpZBgBIAEoCRILCgNpZHgYAiABKAUSEQoJc2VydmVyX2lkGAMgASgJEgwKBG5hbWUYBCABKAkSDQoFY29sb3IYBSABKAkSEQoJYWJpbGl0aWVzGAYgAygJEhQKDHJlcXVlc3Rlcl9pZBgHIAEoCSJVCg1BZGRSb2xlTWVtYmVyEg8KB3VzZXJfaWQYASABKAkSCgoCaWQYAiABKAkSEQoJc2VydmVyX2lkGAMgASgJEhQKDHJlcXVlc3Rlcl9pZBgEIAEoCSJYChBSZW1vdmVSb2xlTWVtYmVyEg8KB3VzZXJfaWQYASABKAkSCgoCaWQYAiABKAkSEQoJc2VydmVyX2lkGAMgASgJEhQKDHJlcXVlc3Rlcl9pZBgEIAEoCSJiChFDaGFuZ2VSb2xlUmFua2luZxIKCgJpZBgBIAEoCRIMCgRmcm9tGAIgASgFEgoKAnRvGAMgASgFEhEKCXNlcnZlcl9pZBgEIAEoCRIUCgxyZXF1ZXN0ZXJfaWQYBSABKAkiQgoZUmVmcmVzaENoYW5uZWxQZXJtaXNzaW9ucxIRCglzZXJ2ZXJfaWQYASABKAkSEgoKY2hhbm5lbF9pZBgCIAEoCSKAAQoUQ2hhbm5lbEFjY2Vzc0NoYW5nZWQSEQoJc2VydmVyX2lkGAEgASgJEhIKCmNoYW5uZWxfaWQYAiABKAkSDwoHdmlzaWJsZRgDIAEoCBIwCgdjaGFubmVsGAQgASgLMh8udHlwZXMuQnJvYWRjYXN0Q2hhbm5lbENyZWF0aW9uIqUBChlCcm9hZGNhc3RNb2RlcmF0aW9uQWN0aW9uEhEKCXNlcnZlcl9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhQKDG1vZGVyYXRvcl9pZBgDIAEoCRIOCgZhY3Rpb24YBCABKAkSDgoGcmVhc29uGAUgASgJEi4KCmV4cGlyZXNfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIncKC0pvaW5SZXF1ZXN0EgoKAmlkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRIZCgR1c2VyGAMgASgLMgsudHlwZXMuVXNlchIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJnChNKb2luUmVxdWVzdFJlc29sdmVkEgoKAmlkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRIPCgd1c2VyX2lkGAMgASgJEhAKCGFwcHJvdmVkGAQgASgIEg4KBnNlcnZlchgFIAEoDEIcWhpnaXRodWIuY29tL29rem1vL255by9wcm90b2IGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message types.WSMessage
@@ -153,145 +153,989 @@ export type WSMessage = Message<"types.WSMessage"> & {
      */
     value: ChangeRoleRanking;
     case: "moveRole";
+  } | {
+    /**
+     * @generated from field: types.BroadcastReactionAdded reaction_added = 23;
+     */
+    value: BroadcastReactionAdded;
+    case: "reactionAdded";
+  } | {
+    /**
+     * @generated from field: types.BroadcastReactionRemoved reaction_removed = 24;
+     */
+    value: BroadcastReactionRemoved;
+    case: "reactionRemoved";
+  } | {
+    /**
+     * @generated from field: types.BroadcastThreadCreation thread_creation = 25;
+     */
+    value: BroadcastThreadCreation;
+    case: "threadCreation";
+  } | {
+    /**
+     * @generated from field: types.BroadcastModerationAction moderation_action = 26;
+     */
+    value: BroadcastModerationAction;
+    case: "moderationAction";
+  } | {
+    /**
+     * @generated from field: types.JoinRequest join_request = 27;
+     */
+    value: JoinRequest;
+    case: "joinRequest";
+  } | {
+    /**
+     * @generated from field: types.JoinRequestResolved join_request_resolved = 28;
+     */
+    value: JoinRequestResolved;
+    case: "joinRequestResolved";
+  } | {
+    /**
+     * @generated from field: types.Ack ack = 29;
+     */
+    value: Ack;
+    case: "ack";
+  } | {
+    /**
+     * @generated from field: types.RequestError error = 30;
+     */
+    value: RequestError;
+    case: "error";
+  } | {
+    /**
+     * @generated from field: types.Typing typing = 31;
+     */
+    value: Typing;
+    case: "typing";
+  } | {
+    /**
+     * @generated from field: types.Presence presence = 32;
+     */
+    value: Presence;
+    case: "presence";
+  } | {
+    /**
+     * @generated from field: types.ReadState read_state = 33;
+     */
+    value: ReadState;
+    case: "readState";
+  } | {
+    /**
+     * @generated from field: types.Notification notification = 34;
+     */
+    value: Notification;
+    case: "notification";
+  } | {
+    /**
+     * @generated from field: types.NotificationsRead notifications_read = 35;
+     */
+    value: NotificationsRead;
+    case: "notificationsRead";
+  } | {
+    /**
+     * @generated from field: types.MoveToCall move_to_call = 36;
+     */
+    value: MoveToCall;
+    case: "moveToCall";
+  } | {
+    /**
+     * @generated from field: types.StageState stage = 37;
+     */
+    value: StageState;
+    case: "stage";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message types.WSMessage.
+ * Use `create(WSMessageSchema)` to create a new message.
+ */
+export const WSMessageSchema: GenMessage<WSMessage> = /*@__PURE__*/
+  messageDesc(file_types, 0);
+
+/**
+ * @generated from message types.ClientMessage
+ */
+export type ClientMessage = Message<"types.ClientMessage"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from oneof types.ClientMessage.content
+   */
+  content: {
+    /**
+     * @generated from field: types.IncomingChatMessage send_message = 2;
+     */
+    value: IncomingChatMessage;
+    case: "sendMessage";
+  } | {
+    /**
+     * @generated from field: types.EditChatMessage edit_message = 3;
+     */
+    value: EditChatMessage;
+    case: "editMessage";
+  } | {
+    /**
+     * @generated from field: types.DeleteChatMessage delete_message = 4;
+     */
+    value: DeleteChatMessage;
+    case: "deleteMessage";
+  } | {
+    /**
+     * @generated from field: types.Typing typing = 5;
+     */
+    value: Typing;
+    case: "typing";
+  } | {
+    /**
+     * @generated from field: types.VoiceState voice_state = 6;
+     */
+    value: VoiceState;
+    case: "voiceState";
+  } | {
+    /**
+     * @generated from field: types.Presence set_presence = 7;
+     */
+    value: Presence;
+    case: "setPresence";
+  } | {
+    /**
+     * @generated from field: types.ReadState ack_channel = 8;
+     */
+    value: ReadState;
+    case: "ackChannel";
+  } | {
+    /**
+     * @generated from field: types.Mute mute = 9;
+     */
+    value: Mute;
+    case: "mute";
+  } | {
+    /**
+     * @generated from field: types.Deafen deafen = 10;
+     */
+    value: Deafen;
+    case: "deafen";
+  } | {
+    /**
+     * @generated from field: types.StageAction stage = 11;
+     */
+    value: StageAction;
+    case: "stage";
   } | { case: undefined; value?: undefined };
 };
 
 /**
- * Describes the message types.WSMessage.
- * Use `create(WSMessageSchema)` to create a new message.
+ * Describes the message types.ClientMessage.
+ * Use `create(ClientMessageSchema)` to create a new message.
+ */
+export const ClientMessageSchema: GenMessage<ClientMessage> = /*@__PURE__*/
+  messageDesc(file_types, 1);
+
+/**
+ * @generated from message types.Ack
+ */
+export type Ack = Message<"types.Ack"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: types.BroadcastChatMessage message = 2;
+   */
+  message?: BroadcastChatMessage;
+
+  /**
+   * @generated from field: types.BroadcastEditMessage edited_message = 3;
+   */
+  editedMessage?: BroadcastEditMessage;
+
+  /**
+   * @generated from field: string call_token = 4;
+   */
+  callToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp call_token_expires_at = 5;
+   */
+  callTokenExpiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message types.Ack.
+ * Use `create(AckSchema)` to create a new message.
+ */
+export const AckSchema: GenMessage<Ack> = /*@__PURE__*/
+  messageDesc(file_types, 2);
+
+/**
+ * @generated from message types.RequestError
+ */
+export type RequestError = Message<"types.RequestError"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string code = 2;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message types.RequestError.
+ * Use `create(RequestErrorSchema)` to create a new message.
+ */
+export const RequestErrorSchema: GenMessage<RequestError> = /*@__PURE__*/
+  messageDesc(file_types, 3);
+
+/**
+ * @generated from message types.Typing
+ */
+export type Typing = Message<"types.Typing"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 3;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: bool typing = 4;
+   */
+  typing: boolean;
+
+  /**
+   * @generated from field: string request_id = 5;
+   */
+  requestId: string;
+};
+
+/**
+ * Describes the message types.Typing.
+ * Use `create(TypingSchema)` to create a new message.
+ */
+export const TypingSchema: GenMessage<Typing> = /*@__PURE__*/
+  messageDesc(file_types, 4);
+
+/**
+ * @generated from message types.Presence
+ */
+export type Presence = Message<"types.Presence"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string status = 3;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string custom_status_text = 4;
+   */
+  customStatusText: string;
+
+  /**
+   * @generated from field: string custom_status_emoji = 5;
+   */
+  customStatusEmoji: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp custom_status_expires_at = 6;
+   */
+  customStatusExpiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message types.Presence.
+ * Use `create(PresenceSchema)` to create a new message.
+ */
+export const PresenceSchema: GenMessage<Presence> = /*@__PURE__*/
+  messageDesc(file_types, 5);
+
+/**
+ * @generated from message types.UserChangedPresence
+ */
+export type UserChangedPresence = Message<"types.UserChangedPresence"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: types.Presence presence = 2;
+   */
+  presence?: Presence;
+};
+
+/**
+ * Describes the message types.UserChangedPresence.
+ * Use `create(UserChangedPresenceSchema)` to create a new message.
+ */
+export const UserChangedPresenceSchema: GenMessage<UserChangedPresence> = /*@__PURE__*/
+  messageDesc(file_types, 6);
+
+/**
+ * @generated from message types.ReadState
+ */
+export type ReadState = Message<"types.ReadState"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string last_read_message_id = 3;
+   */
+  lastReadMessageId: string;
+
+  /**
+   * @generated from field: int32 unread_count = 4;
+   */
+  unreadCount: number;
+
+  /**
+   * @generated from field: int32 mention_count = 5;
+   */
+  mentionCount: number;
+};
+
+/**
+ * Describes the message types.ReadState.
+ * Use `create(ReadStateSchema)` to create a new message.
+ */
+export const ReadStateSchema: GenMessage<ReadState> = /*@__PURE__*/
+  messageDesc(file_types, 7);
+
+/**
+ * @generated from message types.Notification
+ */
+export type Notification = Message<"types.Notification"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string type = 3;
+   */
+  type: string;
+
+  /**
+   * @generated from field: types.BroadcastChatMessage message = 4;
+   */
+  message?: BroadcastChatMessage;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message types.Notification.
+ * Use `create(NotificationSchema)` to create a new message.
+ */
+export const NotificationSchema: GenMessage<Notification> = /*@__PURE__*/
+  messageDesc(file_types, 8);
+
+/**
+ * @generated from message types.NotificationsRead
+ */
+export type NotificationsRead = Message<"types.NotificationsRead"> & {
+  /**
+   * @generated from field: repeated string ids = 1;
+   */
+  ids: string[];
+
+  /**
+   * @generated from field: bool all = 2;
+   */
+  all: boolean;
+};
+
+/**
+ * Describes the message types.NotificationsRead.
+ * Use `create(NotificationsReadSchema)` to create a new message.
+ */
+export const NotificationsReadSchema: GenMessage<NotificationsRead> = /*@__PURE__*/
+  messageDesc(file_types, 9);
+
+/**
+ * @generated from message types.VoiceState
+ */
+export type VoiceState = Message<"types.VoiceState"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: bool connected = 3;
+   */
+  connected: boolean;
+};
+
+/**
+ * Describes the message types.VoiceState.
+ * Use `create(VoiceStateSchema)` to create a new message.
+ */
+export const VoiceStateSchema: GenMessage<VoiceState> = /*@__PURE__*/
+  messageDesc(file_types, 10);
+
+/**
+ * @generated from message types.UserLinksRow
+ */
+export type UserLinksRow = Message<"types.UserLinksRow"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message types.UserLinksRow.
+ * Use `create(UserLinksRowSchema)` to create a new message.
+ */
+export const UserLinksRowSchema: GenMessage<UserLinksRow> = /*@__PURE__*/
+  messageDesc(file_types, 11);
+
+/**
+ * @generated from message types.UserFactsRow
+ */
+export type UserFactsRow = Message<"types.UserFactsRow"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message types.UserFactsRow.
+ * Use `create(UserFactsRowSchema)` to create a new message.
+ */
+export const UserFactsRowSchema: GenMessage<UserFactsRow> = /*@__PURE__*/
+  messageDesc(file_types, 12);
+
+/**
+ * @generated from message types.User
+ */
+export type User = Message<"types.User"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string display_name = 4;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: optional string avatar = 5;
+   */
+  avatar?: string;
+
+  /**
+   * @generated from field: optional string banner = 6;
+   */
+  banner?: string;
+
+  /**
+   * @generated from field: optional string main_color = 7;
+   */
+  mainColor?: string;
+
+  /**
+   * @generated from field: optional bytes about = 8;
+   */
+  about?: Uint8Array;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: bytes links = 10;
+   */
+  links: Uint8Array;
+
+  /**
+   * @generated from field: bytes facts = 11;
+   */
+  facts: Uint8Array;
+};
+
+/**
+ * Describes the message types.User.
+ * Use `create(UserSchema)` to create a new message.
+ */
+export const UserSchema: GenMessage<User> = /*@__PURE__*/
+  messageDesc(file_types, 13);
+
+/**
+ * @generated from message types.IncomingChatMessage
+ */
+export type IncomingChatMessage = Message<"types.IncomingChatMessage"> & {
+  /**
+   * @generated from field: string author_id = 1;
+   */
+  authorId: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 3;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: bytes content = 4;
+   */
+  content: Uint8Array;
+
+  /**
+   * @generated from field: bool everyone = 5;
+   */
+  everyone: boolean;
+
+  /**
+   * @generated from field: repeated string mentions_users = 6;
+   */
+  mentionsUsers: string[];
+
+  /**
+   * @generated from field: repeated string mentions_channels = 7;
+   */
+  mentionsChannels: string[];
+
+  /**
+   * @generated from field: bytes attachments = 8;
+   */
+  attachments: Uint8Array;
+
+  /**
+   * @generated from field: string reply_to = 9;
+   */
+  replyTo: string;
+
+  /**
+   * @generated from field: string thread_id = 10;
+   */
+  threadId: string;
+
+  /**
+   * @generated from field: string request_id = 11;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: repeated string mentions_roles = 12;
+   */
+  mentionsRoles: string[];
+};
+
+/**
+ * Describes the message types.IncomingChatMessage.
+ * Use `create(IncomingChatMessageSchema)` to create a new message.
+ */
+export const IncomingChatMessageSchema: GenMessage<IncomingChatMessage> = /*@__PURE__*/
+  messageDesc(file_types, 14);
+
+/**
+ * @generated from message types.EditChatMessage
+ */
+export type EditChatMessage = Message<"types.EditChatMessage"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 3;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string message_id = 4;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: bytes content = 5;
+   */
+  content: Uint8Array;
+
+  /**
+   * @generated from field: bool everyone = 6;
+   */
+  everyone: boolean;
+
+  /**
+   * @generated from field: repeated string mentions_users = 7;
+   */
+  mentionsUsers: string[];
+
+  /**
+   * @generated from field: repeated string mentions_channels = 8;
+   */
+  mentionsChannels: string[];
+
+  /**
+   * @generated from field: string request_id = 9;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: repeated string mentions_roles = 10;
+   */
+  mentionsRoles: string[];
+};
+
+/**
+ * Describes the message types.EditChatMessage.
+ * Use `create(EditChatMessageSchema)` to create a new message.
  */
-export const WSMessageSchema: GenMessage<WSMessage> = /*@__PURE__*/
-  messageDesc(file_types, 0);
+export const EditChatMessageSchema: GenMessage<EditChatMessage> = /*@__PURE__*/
+  messageDesc(file_types, 15);
 
 /**
- * @generated from message types.UserLinksRow
+ * @generated from message types.DeleteChatMessage
  */
-export type UserLinksRow = Message<"types.UserLinksRow"> & {
+export type DeleteChatMessage = Message<"types.DeleteChatMessage"> & {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string user_id = 1;
    */
-  id: string;
+  userId: string;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: string server_id = 2;
    */
-  label: string;
+  serverId: string;
 
   /**
-   * @generated from field: string url = 3;
+   * @generated from field: string channel_id = 3;
    */
-  url: string;
+  channelId: string;
+
+  /**
+   * @generated from field: string message_id = 4;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string request_id = 5;
+   */
+  requestId: string;
 };
 
 /**
- * Describes the message types.UserLinksRow.
- * Use `create(UserLinksRowSchema)` to create a new message.
+ * Describes the message types.DeleteChatMessage.
+ * Use `create(DeleteChatMessageSchema)` to create a new message.
  */
-export const UserLinksRowSchema: GenMessage<UserLinksRow> = /*@__PURE__*/
-  messageDesc(file_types, 1);
+export const DeleteChatMessageSchema: GenMessage<DeleteChatMessage> = /*@__PURE__*/
+  messageDesc(file_types, 16);
 
 /**
- * @generated from message types.UserFactsRow
+ * @generated from message types.BroadcastChatMessage
  */
-export type UserFactsRow = Message<"types.UserFactsRow"> & {
+export type BroadcastChatMessage = Message<"types.BroadcastChatMessage"> & {
   /**
    * @generated from field: string id = 1;
    */
   id: string;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: string author_id = 2;
    */
-  label: string;
+  authorId: string;
 
   /**
-   * @generated from field: string value = 3;
+   * @generated from field: string server_id = 3;
    */
-  value: string;
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 4;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: bytes content = 5;
+   */
+  content: Uint8Array;
+
+  /**
+   * @generated from field: bool everyone = 6;
+   */
+  everyone: boolean;
+
+  /**
+   * @generated from field: repeated string mentions_users = 7;
+   */
+  mentionsUsers: string[];
+
+  /**
+   * @generated from field: repeated string mentions_channels = 8;
+   */
+  mentionsChannels: string[];
+
+  /**
+   * @generated from field: bytes attachments = 9;
+   */
+  attachments: Uint8Array;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: types.MessageReference reply_to = 11;
+   */
+  replyTo?: MessageReference;
+
+  /**
+   * @generated from field: string thread_id = 12;
+   */
+  threadId: string;
+
+  /**
+   * @generated from field: repeated string mentions_roles = 13;
+   */
+  mentionsRoles: string[];
+
+  /**
+   * @generated from field: bool unread = 14;
+   */
+  unread: boolean;
+
+  /**
+   * @generated from field: bool mention = 15;
+   */
+  mention: boolean;
 };
 
 /**
- * Describes the message types.UserFactsRow.
- * Use `create(UserFactsRowSchema)` to create a new message.
+ * Describes the message types.BroadcastChatMessage.
+ * Use `create(BroadcastChatMessageSchema)` to create a new message.
  */
-export const UserFactsRowSchema: GenMessage<UserFactsRow> = /*@__PURE__*/
-  messageDesc(file_types, 2);
+export const BroadcastChatMessageSchema: GenMessage<BroadcastChatMessage> = /*@__PURE__*/
+  messageDesc(file_types, 17);
 
 /**
- * @generated from message types.User
+ * @generated from message types.MessageReference
  */
-export type User = Message<"types.User"> & {
+export type MessageReference = Message<"types.MessageReference"> & {
   /**
    * @generated from field: string id = 1;
    */
   id: string;
 
   /**
-   * @generated from field: string email = 2;
+   * @generated from field: string author_id = 2;
    */
-  email: string;
+  authorId: string;
 
   /**
-   * @generated from field: string username = 3;
+   * @generated from field: bytes content = 3;
    */
-  username: string;
+  content: Uint8Array;
 
   /**
-   * @generated from field: string display_name = 4;
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
    */
-  displayName: string;
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message types.MessageReference.
+ * Use `create(MessageReferenceSchema)` to create a new message.
+ */
+export const MessageReferenceSchema: GenMessage<MessageReference> = /*@__PURE__*/
+  messageDesc(file_types, 18);
 
+/**
+ * @generated from message types.StartThread
+ */
+export type StartThread = Message<"types.StartThread"> & {
   /**
-   * @generated from field: optional string avatar = 5;
+   * @generated from field: string user_id = 1;
    */
-  avatar?: string;
+  userId: string;
 
   /**
-   * @generated from field: optional string banner = 6;
+   * @generated from field: string server_id = 2;
    */
-  banner?: string;
+  serverId: string;
 
   /**
-   * @generated from field: optional string main_color = 7;
+   * @generated from field: string channel_id = 3;
    */
-  mainColor?: string;
+  channelId: string;
 
   /**
-   * @generated from field: optional bytes about = 8;
+   * @generated from field: string message_id = 4;
    */
-  about?: Uint8Array;
+  messageId: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   * @generated from field: string name = 5;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string request_id = 6;
+   */
+  requestId: string;
+};
+
+/**
+ * Describes the message types.StartThread.
+ * Use `create(StartThreadSchema)` to create a new message.
+ */
+export const StartThreadSchema: GenMessage<StartThread> = /*@__PURE__*/
+  messageDesc(file_types, 19);
+
+/**
+ * @generated from message types.BroadcastThreadCreation
+ */
+export type BroadcastThreadCreation = Message<"types.BroadcastThreadCreation"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 3;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string creator_id = 4;
+   */
+  creatorId: string;
+
+  /**
+   * @generated from field: string name = 5;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
    */
   createdAt?: Timestamp;
 
   /**
-   * @generated from field: bytes links = 10;
+   * @generated from field: string actor_id = 7;
    */
-  links: Uint8Array;
+  actorId: string;
 
   /**
-   * @generated from field: bytes facts = 11;
+   * @generated from field: string actor_address = 8;
    */
-  facts: Uint8Array;
+  actorAddress: string;
 };
 
 /**
- * Describes the message types.User.
- * Use `create(UserSchema)` to create a new message.
+ * Describes the message types.BroadcastThreadCreation.
+ * Use `create(BroadcastThreadCreationSchema)` to create a new message.
  */
-export const UserSchema: GenMessage<User> = /*@__PURE__*/
-  messageDesc(file_types, 3);
+export const BroadcastThreadCreationSchema: GenMessage<BroadcastThreadCreation> = /*@__PURE__*/
+  messageDesc(file_types, 20);
 
 /**
- * @generated from message types.IncomingChatMessage
+ * @generated from message types.BroadcastEditMessage
  */
-export type IncomingChatMessage = Message<"types.IncomingChatMessage"> & {
+export type BroadcastEditMessage = Message<"types.BroadcastEditMessage"> & {
   /**
-   * @generated from field: string author_id = 1;
+   * @generated from field: string message_id = 1;
    */
-  authorId: string;
+  messageId: string;
 
   /**
    * @generated from field: string server_id = 2;
@@ -321,25 +1165,57 @@ export type IncomingChatMessage = Message<"types.IncomingChatMessage"> & {
   /**
    * @generated from field: repeated string mentions_channels = 7;
    */
-  mentionsChannels: string[];
+  mentionsChannels: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated string mentions_roles = 9;
+   */
+  mentionsRoles: string[];
+};
+
+/**
+ * Describes the message types.BroadcastEditMessage.
+ * Use `create(BroadcastEditMessageSchema)` to create a new message.
+ */
+export const BroadcastEditMessageSchema: GenMessage<BroadcastEditMessage> = /*@__PURE__*/
+  messageDesc(file_types, 21);
+
+/**
+ * @generated from message types.BroadcastDeleteChatMessage
+ */
+export type BroadcastDeleteChatMessage = Message<"types.BroadcastDeleteChatMessage"> & {
+  /**
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
 
   /**
-   * @generated from field: bytes attachments = 8;
+   * @generated from field: string channel_id = 3;
    */
-  attachments: Uint8Array;
+  channelId: string;
 };
 
 /**
- * Describes the message types.IncomingChatMessage.
- * Use `create(IncomingChatMessageSchema)` to create a new message.
+ * Describes the message types.BroadcastDeleteChatMessage.
+ * Use `create(BroadcastDeleteChatMessageSchema)` to create a new message.
  */
-export const IncomingChatMessageSchema: GenMessage<IncomingChatMessage> = /*@__PURE__*/
-  messageDesc(file_types, 4);
+export const BroadcastDeleteChatMessageSchema: GenMessage<BroadcastDeleteChatMessage> = /*@__PURE__*/
+  messageDesc(file_types, 22);
 
 /**
- * @generated from message types.EditChatMessage
+ * @generated from message types.AddReaction
  */
-export type EditChatMessage = Message<"types.EditChatMessage"> & {
+export type AddReaction = Message<"types.AddReaction"> & {
   /**
    * @generated from field: string user_id = 1;
    */
@@ -361,37 +1237,32 @@ export type EditChatMessage = Message<"types.EditChatMessage"> & {
   messageId: string;
 
   /**
-   * @generated from field: bytes content = 5;
-   */
-  content: Uint8Array;
-
-  /**
-   * @generated from field: bool everyone = 6;
+   * @generated from field: string emoji = 5;
    */
-  everyone: boolean;
+  emoji: string;
 
   /**
-   * @generated from field: repeated string mentions_users = 7;
+   * @generated from field: string emoji_id = 6;
    */
-  mentionsUsers: string[];
+  emojiId: string;
 
   /**
-   * @generated from field: repeated string mentions_channels = 8;
+   * @generated from field: string request_id = 7;
    */
-  mentionsChannels: string[];
+  requestId: string;
 };
 
 /**
- * Describes the message types.EditChatMessage.
- * Use `create(EditChatMessageSchema)` to create a new message.
+ * Describes the message types.AddReaction.
+ * Use `create(AddReactionSchema)` to create a new message.
  */
-export const EditChatMessageSchema: GenMessage<EditChatMessage> = /*@__PURE__*/
-  messageDesc(file_types, 5);
+export const AddReactionSchema: GenMessage<AddReaction> = /*@__PURE__*/
+  messageDesc(file_types, 23);
 
 /**
- * @generated from message types.DeleteChatMessage
+ * @generated from message types.RemoveReaction
  */
-export type DeleteChatMessage = Message<"types.DeleteChatMessage"> & {
+export type RemoveReaction = Message<"types.RemoveReaction"> & {
   /**
    * @generated from field: string user_id = 1;
    */
@@ -411,81 +1282,34 @@ export type DeleteChatMessage = Message<"types.DeleteChatMessage"> & {
    * @generated from field: string message_id = 4;
    */
   messageId: string;
-};
-
-/**
- * Describes the message types.DeleteChatMessage.
- * Use `create(DeleteChatMessageSchema)` to create a new message.
- */
-export const DeleteChatMessageSchema: GenMessage<DeleteChatMessage> = /*@__PURE__*/
-  messageDesc(file_types, 6);
-
-/**
- * @generated from message types.BroadcastChatMessage
- */
-export type BroadcastChatMessage = Message<"types.BroadcastChatMessage"> & {
-  /**
-   * @generated from field: string id = 1;
-   */
-  id: string;
-
-  /**
-   * @generated from field: string author_id = 2;
-   */
-  authorId: string;
-
-  /**
-   * @generated from field: string server_id = 3;
-   */
-  serverId: string;
-
-  /**
-   * @generated from field: string channel_id = 4;
-   */
-  channelId: string;
-
-  /**
-   * @generated from field: bytes content = 5;
-   */
-  content: Uint8Array;
-
-  /**
-   * @generated from field: bool everyone = 6;
-   */
-  everyone: boolean;
-
-  /**
-   * @generated from field: repeated string mentions_users = 7;
-   */
-  mentionsUsers: string[];
 
   /**
-   * @generated from field: repeated string mentions_channels = 8;
+   * @generated from field: string emoji = 5;
    */
-  mentionsChannels: string[];
+  emoji: string;
 
   /**
-   * @generated from field: bytes attachments = 9;
+   * @generated from field: string emoji_id = 6;
    */
-  attachments: Uint8Array;
+  emojiId: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   * @generated from field: string request_id = 7;
    */
-  createdAt?: Timestamp;
+  requestId: string;
 };
 
 /**
- * Describes the message types.BroadcastChatMessage.
- * Use `create(BroadcastChatMessageSchema)` to create a new message.
+ * Describes the message types.RemoveReaction.
+ * Use `create(RemoveReactionSchema)` to create a new message.
  */
-export const BroadcastChatMessageSchema: GenMessage<BroadcastChatMessage> = /*@__PURE__*/
-  messageDesc(file_types, 7);
+export const RemoveReactionSchema: GenMessage<RemoveReaction> = /*@__PURE__*/
+  messageDesc(file_types, 24);
 
 /**
- * @generated from message types.BroadcastEditMessage
+ * @generated from message types.BroadcastReactionAdded
  */
-export type BroadcastEditMessage = Message<"types.BroadcastEditMessage"> & {
+export type BroadcastReactionAdded = Message<"types.BroadcastReactionAdded"> & {
   /**
    * @generated from field: string message_id = 1;
    */
@@ -502,42 +1326,37 @@ export type BroadcastEditMessage = Message<"types.BroadcastEditMessage"> & {
   channelId: string;
 
   /**
-   * @generated from field: bytes content = 4;
-   */
-  content: Uint8Array;
-
-  /**
-   * @generated from field: bool everyone = 5;
+   * @generated from field: string user_id = 4;
    */
-  everyone: boolean;
+  userId: string;
 
   /**
-   * @generated from field: repeated string mentions_users = 6;
+   * @generated from field: string emoji = 5;
    */
-  mentionsUsers: string[];
+  emoji: string;
 
   /**
-   * @generated from field: repeated string mentions_channels = 7;
+   * @generated from field: string emoji_id = 6;
    */
-  mentionsChannels: string[];
+  emojiId: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   * @generated from field: string emoji_url = 7;
    */
-  updatedAt?: Timestamp;
+  emojiUrl: string;
 };
 
 /**
- * Describes the message types.BroadcastEditMessage.
- * Use `create(BroadcastEditMessageSchema)` to create a new message.
+ * Describes the message types.BroadcastReactionAdded.
+ * Use `create(BroadcastReactionAddedSchema)` to create a new message.
  */
-export const BroadcastEditMessageSchema: GenMessage<BroadcastEditMessage> = /*@__PURE__*/
-  messageDesc(file_types, 8);
+export const BroadcastReactionAddedSchema: GenMessage<BroadcastReactionAdded> = /*@__PURE__*/
+  messageDesc(file_types, 25);
 
 /**
- * @generated from message types.BroadcastDeleteChatMessage
+ * @generated from message types.BroadcastReactionRemoved
  */
-export type BroadcastDeleteChatMessage = Message<"types.BroadcastDeleteChatMessage"> & {
+export type BroadcastReactionRemoved = Message<"types.BroadcastReactionRemoved"> & {
   /**
    * @generated from field: string message_id = 1;
    */
@@ -552,14 +1371,29 @@ export type BroadcastDeleteChatMessage = Message<"types.BroadcastDeleteChatMessa
    * @generated from field: string channel_id = 3;
    */
   channelId: string;
+
+  /**
+   * @generated from field: string user_id = 4;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string emoji = 5;
+   */
+  emoji: string;
+
+  /**
+   * @generated from field: string emoji_id = 6;
+   */
+  emojiId: string;
 };
 
 /**
- * Describes the message types.BroadcastDeleteChatMessage.
- * Use `create(BroadcastDeleteChatMessageSchema)` to create a new message.
+ * Describes the message types.BroadcastReactionRemoved.
+ * Use `create(BroadcastReactionRemovedSchema)` to create a new message.
  */
-export const BroadcastDeleteChatMessageSchema: GenMessage<BroadcastDeleteChatMessage> = /*@__PURE__*/
-  messageDesc(file_types, 9);
+export const BroadcastReactionRemovedSchema: GenMessage<BroadcastReactionRemoved> = /*@__PURE__*/
+  messageDesc(file_types, 26);
 
 /**
  * @generated from message types.BroadcastChannelRemoved
@@ -591,7 +1425,7 @@ export type BroadcastChannelRemoved = Message<"types.BroadcastChannelRemoved"> &
  * Use `create(BroadcastChannelRemovedSchema)` to create a new message.
  */
 export const BroadcastChannelRemovedSchema: GenMessage<BroadcastChannelRemoved> = /*@__PURE__*/
-  messageDesc(file_types, 10);
+  messageDesc(file_types, 27);
 
 /**
  * @generated from message types.BroadcastNewUserInServer
@@ -613,7 +1447,7 @@ export type BroadcastNewUserInServer = Message<"types.BroadcastNewUserInServer">
  * Use `create(BroadcastNewUserInServerSchema)` to create a new message.
  */
 export const BroadcastNewUserInServerSchema: GenMessage<BroadcastNewUserInServer> = /*@__PURE__*/
-  messageDesc(file_types, 11);
+  messageDesc(file_types, 28);
 
 /**
  * @generated from message types.BroadcastServerRemoved
@@ -640,7 +1474,7 @@ export type BroadcastServerRemoved = Message<"types.BroadcastServerRemoved"> & {
  * Use `create(BroadcastServerRemovedSchema)` to create a new message.
  */
 export const BroadcastServerRemovedSchema: GenMessage<BroadcastServerRemoved> = /*@__PURE__*/
-  messageDesc(file_types, 12);
+  messageDesc(file_types, 29);
 
 /**
  * @generated from message types.BroadcastChannelCreation
@@ -710,6 +1544,11 @@ export type BroadcastChannelCreation = Message<"types.BroadcastChannelCreation">
    * @generated from field: string actor_address = 13;
    */
   actorAddress: string;
+
+  /**
+   * @generated from field: optional int32 user_limit = 14;
+   */
+  userLimit?: number;
 };
 
 /**
@@ -717,7 +1556,7 @@ export type BroadcastChannelCreation = Message<"types.BroadcastChannelCreation">
  * Use `create(BroadcastChannelCreationSchema)` to create a new message.
  */
 export const BroadcastChannelCreationSchema: GenMessage<BroadcastChannelCreation> = /*@__PURE__*/
-  messageDesc(file_types, 13);
+  messageDesc(file_types, 30);
 
 /**
  * @generated from message types.ChannelStarting
@@ -739,7 +1578,7 @@ export type ChannelStarting = Message<"types.ChannelStarting"> & {
  * Use `create(ChannelStartingSchema)` to create a new message.
  */
 export const ChannelStartingSchema: GenMessage<ChannelStarting> = /*@__PURE__*/
-  messageDesc(file_types, 14);
+  messageDesc(file_types, 31);
 
 /**
  * @generated from message types.BroadcastConnect
@@ -764,6 +1603,11 @@ export type BroadcastConnect = Message<"types.BroadcastConnect"> & {
    * @generated from field: string type = 4;
    */
   type: string;
+
+  /**
+   * @generated from field: repeated types.Presence presences = 5;
+   */
+  presences: Presence[];
 };
 
 /**
@@ -771,7 +1615,7 @@ export type BroadcastConnect = Message<"types.BroadcastConnect"> & {
  * Use `create(BroadcastConnectSchema)` to create a new message.
  */
 export const BroadcastConnectSchema: GenMessage<BroadcastConnect> = /*@__PURE__*/
-  messageDesc(file_types, 15);
+  messageDesc(file_types, 32);
 
 /**
  * @generated from message types.BroadcastDisconnect
@@ -798,7 +1642,7 @@ export type BroadcastDisconnect = Message<"types.BroadcastDisconnect"> & {
  * Use `create(BroadcastDisconnectSchema)` to create a new message.
  */
 export const BroadcastDisconnectSchema: GenMessage<BroadcastDisconnect> = /*@__PURE__*/
-  messageDesc(file_types, 16);
+  messageDesc(file_types, 33);
 
 /**
  * @generated from message types.BodyChannelCreation
@@ -853,6 +1697,11 @@ export type BodyChannelCreation = Message<"types.BodyChannelCreation"> & {
    * @generated from field: string id = 10;
    */
   id: string;
+
+  /**
+   * @generated from field: optional int32 user_limit = 11;
+   */
+  userLimit?: number;
 };
 
 /**
@@ -860,7 +1709,7 @@ export type BodyChannelCreation = Message<"types.BodyChannelCreation"> & {
  * Use `create(BodyChannelCreationSchema)` to create a new message.
  */
 export const BodyChannelCreationSchema: GenMessage<BodyChannelCreation> = /*@__PURE__*/
-  messageDesc(file_types, 17);
+  messageDesc(file_types, 34);
 
 /**
  * @generated from message types.StartChannel
@@ -887,7 +1736,7 @@ export type StartChannel = Message<"types.StartChannel"> & {
  * Use `create(StartChannelSchema)` to create a new message.
  */
 export const StartChannelSchema: GenMessage<StartChannel> = /*@__PURE__*/
-  messageDesc(file_types, 18);
+  messageDesc(file_types, 35);
 
 /**
  * @generated from message types.KillChannel
@@ -924,7 +1773,7 @@ export type KillChannel = Message<"types.KillChannel"> & {
  * Use `create(KillChannelSchema)` to create a new message.
  */
 export const KillChannelSchema: GenMessage<KillChannel> = /*@__PURE__*/
-  messageDesc(file_types, 19);
+  messageDesc(file_types, 36);
 
 /**
  * @generated from message types.BodyChannelRemoved
@@ -951,7 +1800,7 @@ export type BodyChannelRemoved = Message<"types.BodyChannelRemoved"> & {
  * Use `create(BodyChannelRemovedSchema)` to create a new message.
  */
 export const BodyChannelRemovedSchema: GenMessage<BodyChannelRemoved> = /*@__PURE__*/
-  messageDesc(file_types, 20);
+  messageDesc(file_types, 37);
 
 /**
  * @generated from message types.BodyServerRemoved
@@ -973,7 +1822,7 @@ export type BodyServerRemoved = Message<"types.BodyServerRemoved"> & {
  * Use `create(BodyServerRemovedSchema)` to create a new message.
  */
 export const BodyServerRemovedSchema: GenMessage<BodyServerRemoved> = /*@__PURE__*/
-  messageDesc(file_types, 21);
+  messageDesc(file_types, 38);
 
 /**
  * @generated from message types.BodyNewUserInServer
@@ -995,7 +1844,7 @@ export type BodyNewUserInServer = Message<"types.BodyNewUserInServer"> & {
  * Use `create(BodyNewUserInServerSchema)` to create a new message.
  */
 export const BodyNewUserInServerSchema: GenMessage<BodyNewUserInServer> = /*@__PURE__*/
-  messageDesc(file_types, 22);
+  messageDesc(file_types, 39);
 
 /**
  * @generated from message types.NewServerCreated
@@ -1017,7 +1866,7 @@ export type NewServerCreated = Message<"types.NewServerCreated"> & {
  * Use `create(NewServerCreatedSchema)` to create a new message.
  */
 export const NewServerCreatedSchema: GenMessage<NewServerCreated> = /*@__PURE__*/
-  messageDesc(file_types, 23);
+  messageDesc(file_types, 40);
 
 /**
  * @generated from message types.BroadcastAcceptFriend
@@ -1039,7 +1888,7 @@ export type BroadcastAcceptFriend = Message<"types.BroadcastAcceptFriend"> & {
  * Use `create(BroadcastAcceptFriendSchema)` to create a new message.
  */
 export const BroadcastAcceptFriendSchema: GenMessage<BroadcastAcceptFriend> = /*@__PURE__*/
-  messageDesc(file_types, 24);
+  messageDesc(file_types, 41);
 
 /**
  * @generated from message types.SendFriendInvite
@@ -1061,7 +1910,7 @@ export type SendFriendInvite = Message<"types.SendFriendInvite"> & {
  * Use `create(SendFriendInviteSchema)` to create a new message.
  */
 export const SendFriendInviteSchema: GenMessage<SendFriendInvite> = /*@__PURE__*/
-  messageDesc(file_types, 25);
+  messageDesc(file_types, 42);
 
 /**
  * @generated from message types.AcceptFriendInvite
@@ -1093,7 +1942,7 @@ export type AcceptFriendInvite = Message<"types.AcceptFriendInvite"> & {
  * Use `create(AcceptFriendInviteSchema)` to create a new message.
  */
 export const AcceptFriendInviteSchema: GenMessage<AcceptFriendInvite> = /*@__PURE__*/
-  messageDesc(file_types, 26);
+  messageDesc(file_types, 43);
 
 /**
  * @generated from message types.DeleteFriend
@@ -1102,42 +1951,158 @@ export type DeleteFriend = Message<"types.DeleteFriend"> & {
   /**
    * @generated from field: string invite_id = 1;
    */
-  inviteId: string;
+  inviteId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message types.DeleteFriend.
+ * Use `create(DeleteFriendSchema)` to create a new message.
+ */
+export const DeleteFriendSchema: GenMessage<DeleteFriend> = /*@__PURE__*/
+  messageDesc(file_types, 44);
+
+/**
+ * @generated from message types.Connect
+ */
+export type Connect = Message<"types.Connect"> & {
+  /**
+   * @generated from field: string type = 1;
+   */
+  type: string;
+
+  /**
+   * @generated from field: types.Presence presence = 2;
+   */
+  presence?: Presence;
+};
+
+/**
+ * Describes the message types.Connect.
+ * Use `create(ConnectSchema)` to create a new message.
+ */
+export const ConnectSchema: GenMessage<Connect> = /*@__PURE__*/
+  messageDesc(file_types, 45);
+
+/**
+ * @generated from message types.ConnectToCall
+ */
+export type ConnectToCall = Message<"types.ConnectToCall"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 3;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: bool mute = 4;
+   */
+  mute: boolean;
+
+  /**
+   * @generated from field: bool deafen = 5;
+   */
+  deafen: boolean;
+
+  /**
+   * @generated from field: bool server_mute = 6;
+   */
+  serverMute: boolean;
+
+  /**
+   * @generated from field: string request_id = 7;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: bool refresh = 8;
+   */
+  refresh: boolean;
+
+  /**
+   * @generated from field: string moved_from = 9;
+   */
+  movedFrom: string;
+};
+
+/**
+ * Describes the message types.ConnectToCall.
+ * Use `create(ConnectToCallSchema)` to create a new message.
+ */
+export const ConnectToCallSchema: GenMessage<ConnectToCall> = /*@__PURE__*/
+  messageDesc(file_types, 46);
+
+/**
+ * @generated from message types.CallInitialization
+ */
+export type CallInitialization = Message<"types.CallInitialization"> & {
+  /**
+   * @generated from field: repeated types.ConnectToCall call_users = 1;
+   */
+  callUsers: ConnectToCall[];
 
   /**
-   * @generated from field: string user_id = 2;
+   * @generated from field: types.StageState stage = 2;
    */
-  userId: string;
+  stage?: StageState;
 };
 
 /**
- * Describes the message types.DeleteFriend.
- * Use `create(DeleteFriendSchema)` to create a new message.
+ * Describes the message types.CallInitialization.
+ * Use `create(CallInitializationSchema)` to create a new message.
  */
-export const DeleteFriendSchema: GenMessage<DeleteFriend> = /*@__PURE__*/
-  messageDesc(file_types, 27);
+export const CallInitializationSchema: GenMessage<CallInitialization> = /*@__PURE__*/
+  messageDesc(file_types, 47);
 
 /**
- * @generated from message types.Connect
+ * @generated from message types.StageState
  */
-export type Connect = Message<"types.Connect"> & {
+export type StageState = Message<"types.StageState"> & {
   /**
-   * @generated from field: string type = 1;
+   * @generated from field: string server_id = 1;
    */
-  type: string;
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: repeated string speakers = 3;
+   */
+  speakers: string[];
+
+  /**
+   * @generated from field: repeated string raised_hands = 4;
+   */
+  raisedHands: string[];
 };
 
 /**
- * Describes the message types.Connect.
- * Use `create(ConnectSchema)` to create a new message.
+ * Describes the message types.StageState.
+ * Use `create(StageStateSchema)` to create a new message.
  */
-export const ConnectSchema: GenMessage<Connect> = /*@__PURE__*/
-  messageDesc(file_types, 28);
+export const StageStateSchema: GenMessage<StageState> = /*@__PURE__*/
+  messageDesc(file_types, 48);
 
 /**
- * @generated from message types.ConnectToCall
+ * @generated from message types.StageAction
  */
-export type ConnectToCall = Message<"types.ConnectToCall"> & {
+export type StageAction = Message<"types.StageAction"> & {
   /**
    * @generated from field: string user_id = 1;
    */
@@ -1152,31 +2117,29 @@ export type ConnectToCall = Message<"types.ConnectToCall"> & {
    * @generated from field: string channel_id = 3;
    */
   channelId: string;
-};
 
-/**
- * Describes the message types.ConnectToCall.
- * Use `create(ConnectToCallSchema)` to create a new message.
- */
-export const ConnectToCallSchema: GenMessage<ConnectToCall> = /*@__PURE__*/
-  messageDesc(file_types, 29);
+  /**
+   * @generated from field: string action = 4;
+   */
+  action: string;
 
-/**
- * @generated from message types.CallInitialization
- */
-export type CallInitialization = Message<"types.CallInitialization"> & {
   /**
-   * @generated from field: repeated types.ConnectToCall call_users = 1;
+   * @generated from field: string target_id = 5;
    */
-  callUsers: ConnectToCall[];
+  targetId: string;
+
+  /**
+   * @generated from field: string request_id = 6;
+   */
+  requestId: string;
 };
 
 /**
- * Describes the message types.CallInitialization.
- * Use `create(CallInitializationSchema)` to create a new message.
+ * Describes the message types.StageAction.
+ * Use `create(StageActionSchema)` to create a new message.
  */
-export const CallInitializationSchema: GenMessage<CallInitialization> = /*@__PURE__*/
-  messageDesc(file_types, 30);
+export const StageActionSchema: GenMessage<StageAction> = /*@__PURE__*/
+  messageDesc(file_types, 49);
 
 /**
  * @generated from message types.Disconnect
@@ -1193,7 +2156,7 @@ export type Disconnect = Message<"types.Disconnect"> & {
  * Use `create(DisconnectSchema)` to create a new message.
  */
 export const DisconnectSchema: GenMessage<Disconnect> = /*@__PURE__*/
-  messageDesc(file_types, 31);
+  messageDesc(file_types, 50);
 
 /**
  * @generated from message types.DisconnectFromCall
@@ -1213,6 +2176,11 @@ export type DisconnectFromCall = Message<"types.DisconnectFromCall"> & {
    * @generated from field: string channel_id = 3;
    */
   channelId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp left_at = 4;
+   */
+  leftAt?: Timestamp;
 };
 
 /**
@@ -1220,7 +2188,29 @@ export type DisconnectFromCall = Message<"types.DisconnectFromCall"> & {
  * Use `create(DisconnectFromCallSchema)` to create a new message.
  */
 export const DisconnectFromCallSchema: GenMessage<DisconnectFromCall> = /*@__PURE__*/
-  messageDesc(file_types, 32);
+  messageDesc(file_types, 51);
+
+/**
+ * @generated from message types.CallEnded
+ */
+export type CallEnded = Message<"types.CallEnded"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+};
+
+/**
+ * Describes the message types.CallEnded.
+ * Use `create(CallEndedSchema)` to create a new message.
+ */
+export const CallEndedSchema: GenMessage<CallEnded> = /*@__PURE__*/
+  messageDesc(file_types, 52);
 
 /**
  * @generated from message types.Mute
@@ -1245,6 +2235,21 @@ export type Mute = Message<"types.Mute"> & {
    * @generated from field: string channel_id = 4;
    */
   channelId: string;
+
+  /**
+   * @generated from field: bool server = 5;
+   */
+  server: boolean;
+
+  /**
+   * @generated from field: string moderator_id = 6;
+   */
+  moderatorId: string;
+
+  /**
+   * @generated from field: string request_id = 7;
+   */
+  requestId: string;
 };
 
 /**
@@ -1252,7 +2257,44 @@ export type Mute = Message<"types.Mute"> & {
  * Use `create(MuteSchema)` to create a new message.
  */
 export const MuteSchema: GenMessage<Mute> = /*@__PURE__*/
-  messageDesc(file_types, 33);
+  messageDesc(file_types, 53);
+
+/**
+ * @generated from message types.MoveToCall
+ */
+export type MoveToCall = Message<"types.MoveToCall"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string from_channel_id = 3;
+   */
+  fromChannelId: string;
+
+  /**
+   * @generated from field: string to_channel_id = 4;
+   */
+  toChannelId: string;
+
+  /**
+   * @generated from field: string call_token = 5;
+   */
+  callToken: string;
+};
+
+/**
+ * Describes the message types.MoveToCall.
+ * Use `create(MoveToCallSchema)` to create a new message.
+ */
+export const MoveToCallSchema: GenMessage<MoveToCall> = /*@__PURE__*/
+  messageDesc(file_types, 54);
 
 /**
  * @generated from message types.Deafen
@@ -1277,6 +2319,11 @@ export type Deafen = Message<"types.Deafen"> & {
    * @generated from field: string channel_id = 4;
    */
   channelId: string;
+
+  /**
+   * @generated from field: string request_id = 5;
+   */
+  requestId: string;
 };
 
 /**
@@ -1284,7 +2331,7 @@ export type Deafen = Message<"types.Deafen"> & {
  * Use `create(DeafenSchema)` to create a new message.
  */
 export const DeafenSchema: GenMessage<Deafen> = /*@__PURE__*/
-  messageDesc(file_types, 34);
+  messageDesc(file_types, 55);
 
 /**
  * @generated from message types.UserInformations
@@ -1336,7 +2383,7 @@ export type UserInformations = Message<"types.UserInformations"> & {
  * Use `create(UserInformationsSchema)` to create a new message.
  */
 export const UserInformationsSchema: GenMessage<UserInformations> = /*@__PURE__*/
-  messageDesc(file_types, 35);
+  messageDesc(file_types, 56);
 
 /**
  * @generated from message types.UserChangedInformations
@@ -1358,7 +2405,7 @@ export type UserChangedInformations = Message<"types.UserChangedInformations"> &
  * Use `create(UserChangedInformationsSchema)` to create a new message.
  */
 export const UserChangedInformationsSchema: GenMessage<UserChangedInformations> = /*@__PURE__*/
-  messageDesc(file_types, 36);
+  messageDesc(file_types, 57);
 
 /**
  * @generated from message types.BroadcastUserInformations
@@ -1385,7 +2432,7 @@ export type BroadcastUserInformations = Message<"types.BroadcastUserInformations
  * Use `create(BroadcastUserInformationsSchema)` to create a new message.
  */
 export const BroadcastUserInformationsSchema: GenMessage<BroadcastUserInformations> = /*@__PURE__*/
-  messageDesc(file_types, 37);
+  messageDesc(file_types, 58);
 
 /**
  * @generated from message types.ServerInformations
@@ -1422,7 +2469,7 @@ export type ServerInformations = Message<"types.ServerInformations"> & {
  * Use `create(ServerInformationsSchema)` to create a new message.
  */
 export const ServerInformationsSchema: GenMessage<ServerInformations> = /*@__PURE__*/
-  messageDesc(file_types, 38);
+  messageDesc(file_types, 59);
 
 /**
  * @generated from message types.ServerChangedInformations
@@ -1444,7 +2491,7 @@ export type ServerChangedInformations = Message<"types.ServerChangedInformations
  * Use `create(ServerChangedInformationsSchema)` to create a new message.
  */
 export const ServerChangedInformationsSchema: GenMessage<ServerChangedInformations> = /*@__PURE__*/
-  messageDesc(file_types, 39);
+  messageDesc(file_types, 60);
 
 /**
  * @generated from message types.CreateRole
@@ -1479,6 +2526,11 @@ export type CreateRole = Message<"types.CreateRole"> & {
    * @generated from field: repeated string abilities = 6;
    */
   abilities: string[];
+
+  /**
+   * @generated from field: string requester_id = 7;
+   */
+  requesterId: string;
 };
 
 /**
@@ -1486,7 +2538,7 @@ export type CreateRole = Message<"types.CreateRole"> & {
  * Use `create(CreateRoleSchema)` to create a new message.
  */
 export const CreateRoleSchema: GenMessage<CreateRole> = /*@__PURE__*/
-  messageDesc(file_types, 40);
+  messageDesc(file_types, 61);
 
 /**
  * @generated from message types.AddRoleMember
@@ -1506,6 +2558,11 @@ export type AddRoleMember = Message<"types.AddRoleMember"> & {
    * @generated from field: string server_id = 3;
    */
   serverId: string;
+
+  /**
+   * @generated from field: string requester_id = 4;
+   */
+  requesterId: string;
 };
 
 /**
@@ -1513,7 +2570,7 @@ export type AddRoleMember = Message<"types.AddRoleMember"> & {
  * Use `create(AddRoleMemberSchema)` to create a new message.
  */
 export const AddRoleMemberSchema: GenMessage<AddRoleMember> = /*@__PURE__*/
-  messageDesc(file_types, 41);
+  messageDesc(file_types, 62);
 
 /**
  * @generated from message types.RemoveRoleMember
@@ -1533,6 +2590,11 @@ export type RemoveRoleMember = Message<"types.RemoveRoleMember"> & {
    * @generated from field: string server_id = 3;
    */
   serverId: string;
+
+  /**
+   * @generated from field: string requester_id = 4;
+   */
+  requesterId: string;
 };
 
 /**
@@ -1540,7 +2602,7 @@ export type RemoveRoleMember = Message<"types.RemoveRoleMember"> & {
  * Use `create(RemoveRoleMemberSchema)` to create a new message.
  */
 export const RemoveRoleMemberSchema: GenMessage<RemoveRoleMember> = /*@__PURE__*/
-  messageDesc(file_types, 42);
+  messageDesc(file_types, 63);
 
 /**
  * @generated from message types.ChangeRoleRanking
@@ -1565,6 +2627,11 @@ export type ChangeRoleRanking = Message<"types.ChangeRoleRanking"> & {
    * @generated from field: string server_id = 4;
    */
   serverId: string;
+
+  /**
+   * @generated from field: string requester_id = 5;
+   */
+  requesterId: string;
 };
 
 /**
@@ -1572,5 +2639,170 @@ export type ChangeRoleRanking = Message<"types.ChangeRoleRanking"> & {
  * Use `create(ChangeRoleRankingSchema)` to create a new message.
  */
 export const ChangeRoleRankingSchema: GenMessage<ChangeRoleRanking> = /*@__PURE__*/
-  messageDesc(file_types, 43);
+  messageDesc(file_types, 64);
+
+/**
+ * @generated from message types.RefreshChannelPermissions
+ */
+export type RefreshChannelPermissions = Message<"types.RefreshChannelPermissions"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+};
+
+/**
+ * Describes the message types.RefreshChannelPermissions.
+ * Use `create(RefreshChannelPermissionsSchema)` to create a new message.
+ */
+export const RefreshChannelPermissionsSchema: GenMessage<RefreshChannelPermissions> = /*@__PURE__*/
+  messageDesc(file_types, 65);
+
+/**
+ * @generated from message types.ChannelAccessChanged
+ */
+export type ChannelAccessChanged = Message<"types.ChannelAccessChanged"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: bool visible = 3;
+   */
+  visible: boolean;
+
+  /**
+   * @generated from field: types.BroadcastChannelCreation channel = 4;
+   */
+  channel?: BroadcastChannelCreation;
+};
+
+/**
+ * Describes the message types.ChannelAccessChanged.
+ * Use `create(ChannelAccessChangedSchema)` to create a new message.
+ */
+export const ChannelAccessChangedSchema: GenMessage<ChannelAccessChanged> = /*@__PURE__*/
+  messageDesc(file_types, 66);
+
+/**
+ * @generated from message types.BroadcastModerationAction
+ */
+export type BroadcastModerationAction = Message<"types.BroadcastModerationAction"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string moderator_id = 3;
+   */
+  moderatorId: string;
+
+  /**
+   * @generated from field: string action = 4;
+   */
+  action: string;
+
+  /**
+   * @generated from field: string reason = 5;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message types.BroadcastModerationAction.
+ * Use `create(BroadcastModerationActionSchema)` to create a new message.
+ */
+export const BroadcastModerationActionSchema: GenMessage<BroadcastModerationAction> = /*@__PURE__*/
+  messageDesc(file_types, 67);
+
+/**
+ * @generated from message types.JoinRequest
+ */
+export type JoinRequest = Message<"types.JoinRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: types.User user = 3;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message types.JoinRequest.
+ * Use `create(JoinRequestSchema)` to create a new message.
+ */
+export const JoinRequestSchema: GenMessage<JoinRequest> = /*@__PURE__*/
+  messageDesc(file_types, 68);
+
+/**
+ * @generated from message types.JoinRequestResolved
+ */
+export type JoinRequestResolved = Message<"types.JoinRequestResolved"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string user_id = 3;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: bool approved = 4;
+   */
+  approved: boolean;
+
+  /**
+   * @generated from field: bytes server = 5;
+   */
+  server: Uint8Array;
+};
+
+/**
+ * Describes the message types.JoinRequestResolved.
+ * Use `create(JoinRequestResolvedSchema)` to create a new message.
+ */
+export const JoinRequestResolvedSchema: GenMessage<JoinRequestResolved> = /*@__PURE__*/
+  messageDesc(file_types, 69);
 
@@ -52,6 +52,8 @@ import type {
   LastState,
   Member,
   Message,
+  MessagesCursor,
+  MessagesPage,
   Role,
   Server,
   Setup,
@@ -73,8 +75,8 @@ const client = ky.create({
 class Backend {
   wsConn = $state<WebSocket>();
 
-  setupWebsocket(userId: string) {
-    const ws = new WebSocket(`ws://localhost:3000/v1/authenticated/connect/${userId}`);
+  setupWebsocket() {
+    const ws = new WebSocket(`ws://localhost:3000/v1/authenticated/connect`);
     if (!ws) return;
 
     this.wsConn = ws;
@@ -115,18 +117,12 @@ class Backend {
               created_at: timestampDate(value.createdAt!).toISOString()
             };
 
-            serversStore.addMessage(value?.serverId, message);
+            serversStore.addMessage(value?.serverId, message, value.unread, value.mention);
 
-            const isAMention =
-              message.mentions_users.includes(userStore.user!.id) || message.everyone;
             const isADM = message.server_id === 'global';
             const windowIsActive = windows.getActiveWindow()?.channelId === message.channel_id;
 
-            if (
-              (isAMention || isADM) &&
-              message.author_id !== userStore.user!.id &&
-              !windowIsActive
-            ) {
+            if ((value.mention || (isADM && value.unread)) && !windowIsActive) {
               sounds.playSound('notification');
             }
           }
@@ -688,11 +684,20 @@ class Backend {
     }
   }
 
-  async getMessages(channelId: string): Promise<Result<Message[], MessagesErrors>> {
+  async getMessages(
+    channelId: string,
+    cursor: MessagesCursor = {}
+  ): Promise<Result<MessagesPage, MessagesErrors>> {
     try {
-      const res = await client.get(`messages/${channelId}`);
+      const searchParams: Record<string, string> = {};
+      if (cursor.before) searchParams.before = cursor.before;
+      if (cursor.after) searchParams.after = cursor.after;
+      if (cursor.around) searchParams.around = cursor.around;
+      if (cursor.limit) searchParams.limit = String(cursor.limit);
+
+      const res = await client.get(`messages/${channelId}`, { searchParams });
 
-      const data = (await res.json()) as Message[];
+      const data = (await res.json()) as MessagesPage;
       if (!res.ok) {
         return err({ code: 'ERR_UNKNOWN', error: '', cause: data });
       }
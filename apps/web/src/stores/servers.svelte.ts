@@ -1,6 +1,5 @@
 import type { Channel, LastState, Member, Message, Role, Server, User } from '../types/types';
 import { backend } from './backend.svelte';
-import { userStore } from './user.svelte';
 import { windows } from './windows.svelte';
 
 class Servers {
@@ -44,7 +43,7 @@ class Servers {
     if (!messages) {
       const res = await backend.getMessages(channelId);
       if (res.isOk()) {
-        this.servers[serverId].channels[channelId].messages = res.value || [];
+        this.servers[serverId].channels[channelId].messages = res.value.messages || [];
         return this.servers[serverId].channels[channelId].messages;
       }
     }
@@ -78,7 +77,9 @@ class Servers {
     }
   }
 
-  addMessage(serverId: string, message: Message) {
+  // unread and mention come from the backend, which applies the notification
+  // settings of the user to the message
+  addMessage(serverId: string, message: Message, unread: boolean, mention: boolean) {
     const messages = this.servers[serverId]?.channels[message.channel_id]?.messages;
     const channel = this.getChannel(serverId, message.channel_id);
 
@@ -87,9 +88,9 @@ class Servers {
     }
 
     if (!windows.getWindow({ channelId: message.channel_id })) {
-      channel.last_message_sent = message.id;
+      if (unread) channel.last_message_sent = message.id;
 
-      if (message.mentions_users.find((id) => id === userStore.user!.id) || message.everyone) {
+      if (mention) {
         if (Array.isArray(channel.last_mentions))
           channel.last_mentions = [...channel.last_mentions, message.id];
         else channel.last_mentions = [message.id];
@@ -5,7 +5,7 @@ export const ChannelTypes = {
 } as const;
 export type ChannelTypes = (typeof ChannelTypes)[keyof typeof ChannelTypes];
 
-export const ABILITIES = ['ADMIN', 'MANAGE_CHANNELS', 'MANAGE_ROLES', 'MANAGE_SERVER', 'MANAGE_EXPRESSIONS', 'CHANGE_NICKNAME', 'MANAGE_NICKNAMES', 'BAN', 'KICK', 'MUTE', 'ATTACH_FILES', 'MANAGE_MESSAGES'] as const
+export const ABILITIES = ['ADMIN', 'MANAGE_CHANNELS', 'MANAGE_ROLES', 'MANAGE_SERVER', 'MANAGE_EXPRESSIONS', 'CHANGE_NICKNAME', 'MANAGE_NICKNAMES', 'BAN', 'KICK', 'MUTE', 'ATTACH_FILES', 'MANAGE_MESSAGES', 'VIEW_AUDIT_LOG', 'CONNECT', 'SPEAK', 'VIDEO', 'SCREENSHARE', 'PRIORITY_SPEAKER'] as const
 export type AbilitiesType = typeof ABILITIES[number]
 
 export const contextMenuTargets = [
@@ -147,6 +147,19 @@ export interface Message {
   created_at: string;
 }
 
+export interface MessagesPage {
+  messages: Message[];
+  has_more_before: boolean;
+  has_more_after: boolean;
+}
+
+export interface MessagesCursor {
+  before?: string;
+  after?: string;
+  around?: string;
+  limit?: number;
+}
+
 export interface LastState {
   channel_ids: string[];
   last_message_ids: string[];